@@ -0,0 +1,72 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/epg-sync/epgsync/internal/config"
+)
+
+const configUsage = `usage: epg-sync config <command>
+
+commands:
+  validate [file]   check the config file, with the EPGSYNC_ environment
+                    overrides applied, and list every problem; the file
+                    defaults to CONFIG_PATH or config/config.yaml`
+
+// runConfig handles "epg-sync config ..." and returns the exit code. It runs
+// before the config is loaded, so that a broken config can be checked.
+func runConfig(args []string) int {
+	if len(args) == 0 || args[0] != "validate" || len(args) > 2 {
+		fmt.Fprintln(os.Stderr, configUsage)
+		return 2
+	}
+
+	var cfg *config.AppConfig
+	var err error
+	if len(args) == 2 {
+		cfg, err = config.LoadFile(args[1])
+	} else {
+		cfg, err = config.LoadConfig()
+	}
+
+	if err != nil {
+		printConfigError(err)
+		return 1
+	}
+
+	fmt.Printf("%s is valid\n", cfg.Path())
+	for _, path := range cfg.EnvOverrides() {
+		fmt.Printf("  %s is set from %s\n", path, cfg.Source(path))
+	}
+	if cfg.InsecureJWTSecret() {
+		fmt.Println("  warning: server.jwt_secret is empty or the published default")
+	}
+	return 0
+}
+
+// loadConfig loads the config the server and migrate commands run with. A
+// config that does not load is reported as by "config validate" and exits,
+// rather than panicking with a stack trace.
+func loadConfig() *config.AppConfig {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		printConfigError(err)
+		os.Exit(1)
+	}
+	return cfg
+}
+
+// printConfigError lists every problem of an invalid config on stderr.
+func printConfigError(err error) {
+	var validationErr *config.ValidationError
+	if !errors.As(err, &validationErr) {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "config is invalid, %d problem(s):\n", len(validationErr.Problems))
+	for _, problem := range validationErr.Problems {
+		fmt.Fprintf(os.Stderr, "  %v\n", problem)
+	}
+}
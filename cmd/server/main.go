@@ -7,7 +7,6 @@ import (
 	"syscall"
 
 	"github.com/epg-sync/epgsync/internal/app"
-	"github.com/epg-sync/epgsync/internal/config"
 	_ "github.com/epg-sync/epgsync/internal/provider/providers/bfgd"
 	_ "github.com/epg-sync/epgsync/internal/provider/providers/btzx"
 	_ "github.com/epg-sync/epgsync/internal/provider/providers/cctv"
@@ -29,13 +28,21 @@ import (
 )
 
 func main() {
-	configs := config.MustLoad("config/config.yaml")
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		os.Exit(runConfig(os.Args[2:]))
+	}
+
+	configs := loadConfig()
 
 	err := logger.Init(&configs.Logger)
 	if err != nil {
 		log.Fatalf("Failed to initialize logger: %v", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(configs, os.Args[2:]))
+	}
+
 	application, err := app.New(configs)
 	if err != nil {
 		logger.Fatal("Failed to create application", logger.Err(err))
@@ -45,8 +52,16 @@ func main() {
 		logger.Fatal("Failed to start application", logger.Err(err))
 	}
 	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	for sig := range quit {
+		if sig != syscall.SIGHUP {
+			break
+		}
+		logger.Info("Received SIGHUP, reloading config")
+		if err := application.Reload(); err != nil {
+			logger.Error("Failed to reload config, keeping the running config", logger.Err(err))
+		}
+	}
 
 	logger.Info("Shutting down server...")
 
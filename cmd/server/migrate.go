@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/epg-sync/epgsync/internal/app"
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/epg-sync/epgsync/internal/migration"
+)
+
+const migrateUsage = `usage: epg-sync migrate <command>
+
+commands:
+  status          list migrations and whether they are applied
+  up              apply all pending migrations
+  down [n]        revert the last n migrations (default 1)
+  to <version>    migrate up or down to the given version, 0 reverts all`
+
+// runMigrate handles "epg-sync migrate ..." and returns the exit code.
+func runMigrate(cfg *config.AppConfig, args []string) int {
+	if len(args) == 0 || !validMigrateCommand(args[0]) {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	db, err := app.OpenDatabase(cfg.Database)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if sqlDB, err := db.DB(); err == nil {
+		defer sqlDB.Close()
+	}
+
+	migrator, err := migration.New(db, cfg.Database.Driver)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	ctx := context.Background()
+	var count int
+
+	switch args[0] {
+	case "status":
+		err = printMigrationStatus(ctx, migrator)
+	case "up":
+		count, err = migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				fmt.Fprintf(os.Stderr, "invalid step count %q\n", args[1])
+				return 2
+			}
+		}
+		count, err = migrator.Down(ctx, steps)
+	case "to":
+		if len(args) < 2 {
+			fmt.Fprintln(os.Stderr, migrateUsage)
+			return 2
+		}
+		version, perr := strconv.ParseInt(args[1], 10, 64)
+		if perr != nil || version < 0 {
+			fmt.Fprintf(os.Stderr, "invalid version %q\n", args[1])
+			return 2
+		}
+		count, err = migrator.To(ctx, version)
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if args[0] != "status" {
+		version, err := migrator.Version(ctx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("%d migration(s) run, schema is at version %d\n", count, version)
+	}
+	return 0
+}
+
+func validMigrateCommand(command string) bool {
+	switch command {
+	case "status", "up", "down", "to":
+		return true
+	}
+	return false
+}
+
+func printMigrationStatus(ctx context.Context, migrator *migration.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, s := range statuses {
+		state, appliedAt := "pending", ""
+		if s.Applied {
+			state = "applied"
+			appliedAt = s.AppliedAt.Local().Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", s.Version, s.Name, state, appliedAt)
+	}
+	return w.Flush()
+}
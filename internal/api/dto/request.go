@@ -1,12 +1,13 @@
 package dto
 
+import "time"
+
 type CreateChannelRequest struct {
 	ChannelID   string `json:"channel_id" binding:"required"`
 	DisplayName string `json:"display_name" binding:"required"`
 	Category    string `json:"category"`
 	Area        string `json:"area"`
 	LogoURL     string `json:"logo_url"`
-	Regexp      string `json:"regexp"`
 	Timezone    string `json:"timezone"`
 }
 
@@ -16,6 +17,11 @@ type UpdateChannelRequest struct {
 	CreateChannelRequest
 }
 
+type ChannelAliasRequest struct {
+	Alias     string `json:"alias" binding:"required"`
+	MatchType string `json:"match_type" binding:"omitempty,oneof=exact normalized regex"`
+}
+
 type BatchCreateChannelRequest struct {
 	Channels []CreateChannelRequest `json:"channels" binding:"required,dive,required"`
 }
@@ -44,3 +50,130 @@ type DIYPProgramRequest struct {
 	Ch   string `form:"ch" binding:"required"`
 	Date string `form:"date" binding:"required,datetime=2006-01-02"`
 }
+
+type ListChangesRequest struct {
+	ChannelID  string `form:"channel_id" binding:"omitempty"`
+	ProviderID string `form:"provider_id" binding:"omitempty"`
+	ChangeType string `form:"change_type" binding:"omitempty,oneof=added removed title_changed time_shifted"`
+	Since      string `form:"since" binding:"omitempty,datetime=2006-01-02"`
+	Page       int    `form:"page" binding:"omitempty,min=1"`
+	PageSize   int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type CreateWebhookRequest struct {
+	Name       string   `json:"name" binding:"required"`
+	URL        string   `json:"url" binding:"required,url"`
+	Secret     string   `json:"secret"`
+	Events     []string `json:"events" binding:"omitempty,dive,oneof=added removed title_changed time_shifted"`
+	ChannelIDs []string `json:"channel_ids"`
+}
+
+type UpdateWebhookRequest struct {
+	IsActive int `json:"is_active"`
+	CreateWebhookRequest
+}
+
+type ListQualityReportsRequest struct {
+	ChannelID  string `form:"channel_id" binding:"omitempty"`
+	ProviderID string `form:"provider_id" binding:"omitempty"`
+	Date       string `form:"date" binding:"omitempty,datetime=2006-01-02"`
+	OnlyIssues bool   `form:"only_issues"`
+	Page       int    `form:"page" binding:"omitempty,min=1"`
+	PageSize   int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type TitleRuleRequest struct {
+	Name    string `json:"name"`
+	Type    string `json:"type" binding:"required,oneof=regex_replace trim half_width simplified episode"`
+	Pattern string `json:"pattern"`
+	Replace string `json:"replace"`
+}
+
+type TitleDryRunRequest struct {
+	ProviderID string             `json:"provider_id"`
+	Titles     []string           `json:"titles"`
+	Rules      []TitleRuleRequest `json:"rules" binding:"omitempty,dive"`
+	Limit      int                `json:"limit" binding:"omitempty,min=1,max=500"`
+}
+
+type CreateCategoryRuleRequest struct {
+	Category  string `json:"category" binding:"required,oneof=news sports movie series kids documentary variety music education"`
+	MatchType string `json:"match_type" binding:"required,oneof=keyword regex"`
+	Pattern   string `json:"pattern" binding:"required"`
+	ChannelID string `json:"channel_id"`
+	Priority  int    `json:"priority"`
+}
+
+type UpdateCategoryRuleRequest struct {
+	IsActive int `json:"is_active"`
+	CreateCategoryRuleRequest
+}
+
+type MappingReviewRequest struct {
+	ProviderID string `form:"provider_id"`
+}
+
+type CreateChannelMappingRequest struct {
+	ProviderID        string `json:"provider_id" binding:"required"`
+	ProviderChannelID string `json:"provider_channel_id" binding:"required"`
+	CanonicalID       string `json:"canonical_id" binding:"required"`
+	Priority          int    `json:"priority" binding:"omitempty,min=0"`
+}
+
+type UpdateChannelMappingRequest struct {
+	ProviderChannelID string `json:"provider_channel_id"`
+	CanonicalID       string `json:"canonical_id"`
+	Priority          *int   `json:"priority" binding:"omitempty,min=0"`
+}
+
+type ChannelMappingFormatRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=json csv"`
+}
+
+type ListUsersRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=viewer operator admin"`
+}
+
+type CreateUserRequest struct {
+	Username string `json:"username" binding:"required,min=3,max=50"`
+	Password string `json:"password" binding:"required,min=6,max=100"`
+	Email    string `json:"email" binding:"omitempty,email"`
+	Role     string `json:"role" binding:"required,oneof=viewer operator admin"`
+}
+
+type UpdateUserRequest struct {
+	Email    *string `json:"email" binding:"omitempty,email"`
+	Role     *string `json:"role" binding:"omitempty,oneof=viewer operator admin"`
+	IsActive *int    `json:"is_active" binding:"omitempty,oneof=0 1"`
+}
+
+type ResetPasswordRequest struct {
+	Password string `json:"password" binding:"required,min=6,max=100"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1,dive,oneof=epg:read sync admin"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type ListAPIKeysRequest struct {
+	UserID int64 `form:"user_id" binding:"omitempty,min=1"`
+}
+
+type ListAuditLogsRequest struct {
+	UserID       int64  `form:"user_id" binding:"omitempty,min=1"`
+	Username     string `form:"username" binding:"omitempty"`
+	Action       string `form:"action" binding:"omitempty"`
+	ResourceType string `form:"resource_type" binding:"omitempty"`
+	ResourceID   string `form:"resource_id" binding:"omitempty"`
+	Since        string `form:"since" binding:"omitempty,datetime=2006-01-02"`
+	Until        string `form:"until" binding:"omitempty,datetime=2006-01-02"`
+	Page         int    `form:"page" binding:"omitempty,min=1"`
+	PageSize     int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
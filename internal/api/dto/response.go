@@ -1,5 +1,7 @@
 package dto
 
+import "github.com/epg-sync/epgsync/internal/model"
+
 type Response struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
@@ -84,3 +86,10 @@ func NotFound(message string) ErrorResponse {
 func InternalServerError(message string, err error) ErrorResponse {
 	return Error(500, message, err)
 }
+
+// APIKeyCreatedResponse carries the plaintext key, which is only ever
+// returned here.
+type APIKeyCreatedResponse struct {
+	*model.APIKey
+	Key string `json:"key"`
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/epg-sync/epgsync/internal/api/dto"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHandler serves the API keys of the signed-in user under
+// /admin/api-keys.
+type APIKeyHandler struct {
+	apiKeyService *service.APIKeyService
+}
+
+func NewAPIKeyHandler(apiKeyService *service.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+	}
+}
+
+// ListKeys returns the caller's keys. Roles that manage users may pass
+// user_id to see the keys of someone else.
+func (h *APIKeyHandler) ListKeys(c *gin.Context) {
+	var req dto.ListAPIKeysRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	userID := c.GetInt64("user_id")
+	if req.UserID != 0 && req.UserID != userID {
+		if !model.RoleHasPermission(c.GetString("role"), model.PermissionManageUsers) {
+			c.JSON(http.StatusForbidden, dto.Error(http.StatusForbidden, "Failed to list API keys",
+				errors.New(errors.ErrCodeForbidden, "cannot list the API keys of another user")))
+			return
+		}
+		userID = req.UserID
+	}
+
+	keys, err := h.apiKeyService.ListKeys(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to list API keys", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(keys))
+}
+
+func (h *APIKeyHandler) CreateKey(c *gin.Context) {
+	var req dto.CreateAPIKeyRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	key, plaintext, err := h.apiKeyService.CreateKey(c.Request.Context(), c.GetInt64("user_id"), req.Name, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to create API key", err))
+		return
+	}
+
+	logger.Info("API key created",
+		logger.String("by", c.GetString("username")),
+		logger.Int64("id", key.ID),
+		logger.String("prefix", key.Prefix),
+		logger.String("scopes", key.Scopes),
+	)
+
+	c.JSON(http.StatusCreated, dto.Success(dto.APIKeyCreatedResponse{APIKey: key, Key: plaintext}))
+}
+
+func (h *APIKeyHandler) RevokeKey(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid API key id", err))
+		return
+	}
+
+	if err := h.apiKeyService.RevokeKey(c.Request.Context(), c.GetInt64("user_id"), c.GetString("role"), id); err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to revoke API key", err))
+		return
+	}
+
+	logger.Info("API key revoked",
+		logger.String("by", c.GetString("username")),
+		logger.Int64("id", id),
+	)
+
+	c.JSON(http.StatusOK, dto.Success(gin.H{"message": "API key revoked successfully"}))
+}
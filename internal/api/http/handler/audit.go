@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/api/dto"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandler struct {
+	auditService *service.AuditService
+}
+
+func NewAuditHandler(auditService *service.AuditService) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+	}
+}
+
+// ListEntries returns audit log entries, newest first. until is inclusive of
+// the whole day.
+func (h *AuditHandler) ListEntries(c *gin.Context) {
+	var req dto.ListAuditLogsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.PageSize == 0 {
+		req.PageSize = 50
+	}
+
+	filter := &repository.AuditLogFilter{
+		UserID:       req.UserID,
+		Username:     req.Username,
+		Action:       req.Action,
+		ResourceType: req.ResourceType,
+		ResourceID:   req.ResourceID,
+	}
+	if req.Since != "" {
+		since, err := time.ParseInLocation("2006-01-02", req.Since, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid since format", err))
+			return
+		}
+		filter.Since = since
+	}
+	if req.Until != "" {
+		until, err := time.ParseInLocation("2006-01-02", req.Until, time.Local)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid until format", err))
+			return
+		}
+		filter.Until = until.AddDate(0, 0, 1)
+	}
+
+	entries, total, err := h.auditService.ListEntries(c.Request.Context(), filter, req.Page, req.PageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.InternalServerError("Failed to list audit logs", err))
+		return
+	}
+
+	items := make([]any, len(entries))
+	for i, entry := range entries {
+		items[i] = entry
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessPaginated(items, total, req.Page, req.PageSize))
+}
@@ -1,21 +1,45 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
 
+	"github.com/epg-sync/epgsync/internal/model"
 	"github.com/epg-sync/epgsync/internal/service"
 	"github.com/epg-sync/epgsync/pkg/errors"
 	"github.com/epg-sync/epgsync/pkg/logger"
+	"github.com/epg-sync/epgsync/pkg/utils"
 	"github.com/gin-gonic/gin"
 )
 
 type AuthHandler struct {
-	userService *service.UserService
+	userService  *service.UserService
+	tokenService *service.TokenService
+	oidcService  *service.OIDCService
 }
 
-func NewAuthHandler(userService *service.UserService) *AuthHandler {
+func NewAuthHandler(userService *service.UserService, tokenService *service.TokenService, oidcService *service.OIDCService) *AuthHandler {
 	return &AuthHandler{
-		userService: userService,
+		userService:  userService,
+		tokenService: tokenService,
+		oidcService:  oidcService,
+	}
+}
+
+// sessionData is the body of a successful login or refresh.
+func sessionData(tokens *service.TokenPair, user *model.User) gin.H {
+	return gin.H{
+		"token":              tokens.AccessToken,
+		"expires_at":         tokens.ExpiresAt,
+		"refresh_token":      tokens.RefreshToken,
+		"refresh_expires_at": tokens.RefreshExpiresAt,
+		"user": gin.H{
+			"id":       user.ID,
+			"username": user.Username,
+			"email":    user.Email,
+			"role":     user.Role,
+		},
 	}
 }
 
@@ -71,14 +95,19 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	token, user, err := h.userService.Login(c.Request.Context(), req.Username, req.Password)
+	tokens, user, err := h.userService.Login(c.Request.Context(), req.Username, req.Password, c.ClientIP())
 	if err != nil {
 		logger.Warn("Login failed",
 			logger.String("username", req.Username),
 			logger.String("ip", c.ClientIP()),
 			logger.Err(err),
 		)
-		c.JSON(http.StatusUnauthorized, gin.H{
+		status := http.StatusUnauthorized
+		if errors.Is(err, errors.ErrCodeTooManyRequests) {
+			status = http.StatusTooManyRequests
+			c.Header("Retry-After", fmt.Sprint(errors.GetDetails(err)["retry_after"]))
+		}
+		c.JSON(status, gin.H{
 			"error": err.Error(),
 			"code":  errors.GetCode(err),
 		})
@@ -92,18 +121,189 @@ func (h *AuthHandler) Login(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"message": "login successful",
+		"data":    sessionData(tokens, user),
+	})
+}
+
+// OIDCStatus tells the login page whether to offer single sign-on.
+func (h *AuthHandler) OIDCStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
 		"data": gin.H{
-			"token": token,
-			"user": gin.H{
-				"id":       user.ID,
-				"username": user.Username,
-				"email":    user.Email,
-				"role":     user.Role,
-			},
+			"enabled": h.oidcService.Enabled(),
 		},
 	})
 }
 
+// OIDCLogin sends the browser to the OIDC provider.
+func (h *AuthHandler) OIDCLogin(c *gin.Context) {
+	authURL, err := h.oidcService.AuthCodeURL(c.Request.Context())
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), gin.H{
+			"error": err.Error(),
+			"code":  errors.GetCode(err),
+		})
+		return
+	}
+
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// OIDCCallback is where the provider sends the browser back to. With a
+// frontend URL configured the browser goes on to the login page with a
+// one-time ticket or the error; otherwise the tokens are returned as JSON.
+func (h *AuthHandler) OIDCCallback(c *gin.Context) {
+	var (
+		result *service.OIDCResult
+		err    error
+	)
+	if providerError := c.Query("error"); providerError != "" {
+		err = errors.New(errors.ErrCodeUnauthorized, "OIDC login failed: "+providerError)
+	} else {
+		result, err = h.oidcService.Callback(c.Request.Context(), c.Query("state"), c.Query("code"), c.ClientIP())
+	}
+
+	if err != nil {
+		logger.Warn("OIDC login failed",
+			logger.String("ip", c.ClientIP()),
+			logger.Err(err),
+		)
+		if frontend := h.oidcService.FrontendURL(); frontend != "" {
+			c.Redirect(http.StatusFound, withQuery(frontend, "oidc_error", errors.GetMessage(err)))
+			return
+		}
+		c.JSON(errors.HTTPStatus(err), gin.H{
+			"error": err.Error(),
+			"code":  errors.GetCode(err),
+		})
+		return
+	}
+
+	logger.Info("User logged in through OIDC",
+		logger.String("username", result.User.Username),
+		logger.String("ip", c.ClientIP()),
+	)
+
+	if result.Ticket != "" {
+		c.Redirect(http.StatusFound, withQuery(h.oidcService.FrontendURL(), "oidc_ticket", result.Ticket))
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message": "login successful",
+		"data":    sessionData(result.Tokens, result.User),
+	})
+}
+
+// OIDCToken trades the ticket from the callback redirect for the tokens.
+func (h *AuthHandler) OIDCToken(c *gin.Context) {
+	var req struct {
+		Ticket string `json:"ticket" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+			"code":  errors.ErrCodeInvalidParam,
+		})
+		return
+	}
+
+	tokens, user, err := h.oidcService.RedeemTicket(c.Request.Context(), req.Ticket)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), gin.H{
+			"error": err.Error(),
+			"code":  errors.GetCode(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "login successful",
+		"data":    sessionData(tokens, user),
+	})
+}
+
+// withQuery adds a query parameter to a URL, keeping the ones it has.
+func withQuery(rawURL, key, value string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	query := u.Query()
+	query.Set(key, value)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// Refresh trades a refresh token for a new token pair. The refresh token
+// presented is spent.
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refresh_token" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+			"code":  errors.ErrCodeInvalidParam,
+		})
+		return
+	}
+
+	tokens, user, err := h.tokenService.Refresh(c.Request.Context(), req.RefreshToken, c.ClientIP())
+	if err != nil {
+		logger.Warn("Token refresh failed",
+			logger.String("ip", c.ClientIP()),
+			logger.Err(err),
+		)
+		c.JSON(errors.HTTPStatus(err), gin.H{
+			"error": err.Error(),
+			"code":  errors.GetCode(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "token refreshed",
+		"data":    sessionData(tokens, user),
+	})
+}
+
+// Logout ends the current session, or every session of the user with
+// ?all=true.
+func (h *AuthHandler) Logout(c *gin.Context) {
+	claims := c.MustGet("claims").(*utils.Claims)
+
+	var err error
+	if c.Query("all") == "true" {
+		err = h.tokenService.RevokeUser(c.Request.Context(), claims.UserID)
+		if err == nil {
+			err = h.tokenService.Logout(c.Request.Context(), claims)
+		}
+	} else {
+		err = h.tokenService.Logout(c.Request.Context(), claims)
+	}
+	if err != nil {
+		logger.Error("Logout failed",
+			logger.String("username", claims.Username),
+			logger.Err(err),
+		)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+			"code":  errors.GetCode(err),
+		})
+		return
+	}
+
+	logger.Info("User logged out",
+		logger.String("username", claims.Username),
+		logger.String("ip", c.ClientIP()),
+	)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "logged out",
+	})
+}
+
 func (h *AuthHandler) GetCurrentUser(c *gin.Context) {
 	userID, exists := c.Get("user_id")
 	if !exists {
@@ -149,7 +349,8 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 		return
 	}
 
-	if err := h.userService.ChangePassword(c.Request.Context(), userID.(int64), req.OldPassword, req.NewPassword); err != nil {
+	tokens, err := h.userService.ChangePassword(c.Request.Context(), userID.(int64), req.OldPassword, req.NewPassword, c.ClientIP())
+	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
 			"code":  errors.GetCode(err),
@@ -157,7 +358,14 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 		return
 	}
 
+	// every other session was signed out, this one continues with new tokens
 	c.JSON(http.StatusOK, gin.H{
 		"message": "password changed successfully",
+		"data": gin.H{
+			"token":              tokens.AccessToken,
+			"expires_at":         tokens.ExpiresAt,
+			"refresh_token":      tokens.RefreshToken,
+			"refresh_expires_at": tokens.RefreshExpiresAt,
+		},
 	})
 }
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/epg-sync/epgsync/internal/api/dto"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryHandler struct {
+	categoryService *service.CategoryService
+}
+
+func NewCategoryHandler(categoryService *service.CategoryService) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: categoryService,
+	}
+}
+
+func (h *CategoryHandler) ListRules(c *gin.Context) {
+	rules, err := h.categoryService.ListRules(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.InternalServerError("Failed to list category rules", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(rules))
+}
+
+func (h *CategoryHandler) CreateRule(c *gin.Context) {
+	var req dto.CreateCategoryRuleRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	rule := &model.CategoryRule{
+		Category:  req.Category,
+		MatchType: req.MatchType,
+		Pattern:   req.Pattern,
+		ChannelID: req.ChannelID,
+		Priority:  req.Priority,
+		IsActive:  1,
+	}
+
+	if err := h.categoryService.CreateRule(c.Request.Context(), rule); err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to create category rule", err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.Success(rule))
+}
+
+func (h *CategoryHandler) UpdateRule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid category rule id", err))
+		return
+	}
+
+	var req dto.UpdateCategoryRuleRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	rule, err := h.categoryService.GetRule(ctx, id)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to get category rule", err))
+		return
+	}
+
+	rule.Category = req.Category
+	rule.MatchType = req.MatchType
+	rule.Pattern = req.Pattern
+	rule.ChannelID = req.ChannelID
+	rule.Priority = req.Priority
+	rule.IsActive = req.IsActive
+
+	if err := h.categoryService.UpdateRule(ctx, rule); err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to update category rule", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(rule))
+}
+
+func (h *CategoryHandler) DeleteRule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid category rule id", err))
+		return
+	}
+
+	if err := h.categoryService.DeleteRule(c.Request.Context(), id); err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to delete category rule", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(gin.H{"message": "Category rule deleted successfully"}))
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/api/dto"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ChangeHandler struct {
+	changeFeedService *service.ChangeFeedService
+}
+
+func NewChangeHandler(changeFeedService *service.ChangeFeedService) *ChangeHandler {
+	return &ChangeHandler{
+		changeFeedService: changeFeedService,
+	}
+}
+
+func (h *ChangeHandler) ListChanges(c *gin.Context) {
+	var req dto.ListChangesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.PageSize == 0 {
+		req.PageSize = 50
+	}
+
+	filter := &repository.ProgramChangeFilter{
+		ChannelID:  req.ChannelID,
+		ProviderID: req.ProviderID,
+		ChangeType: req.ChangeType,
+	}
+	if req.Since != "" {
+		since, err := time.Parse("2006-01-02", req.Since)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid since format", err))
+			return
+		}
+		filter.Since = since
+	}
+
+	changes, total, err := h.changeFeedService.ListChanges(c.Request.Context(), filter, req.Page, req.PageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.InternalServerError("Failed to list changes", err))
+		return
+	}
+
+	items := make([]any, len(changes))
+	for i, change := range changes {
+		items[i] = change
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessPaginated(items, total, req.Page, req.PageSize))
+}
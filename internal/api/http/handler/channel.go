@@ -2,10 +2,12 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/epg-sync/epgsync/internal/api/dto"
 	"github.com/epg-sync/epgsync/internal/model"
 	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/epg-sync/epgsync/pkg/errors"
 	"github.com/gin-gonic/gin"
 )
 
@@ -32,7 +34,6 @@ func (h *ChannelHandler) CreateChannel(c *gin.Context) {
 		DisplayName: channel.DisplayName,
 		Category:    channel.Category,
 		Area:        channel.Area,
-		Regexp:      channel.Regexp,
 		LogoURL:     channel.LogoURL,
 		Timezone:    channel.Timezone,
 	}
@@ -61,7 +62,6 @@ func (h *ChannelHandler) BatchCreateChannel(c *gin.Context) {
 		newChannels = append(newChannels, &model.Channel{
 			ChannelID:   channel.ChannelID,
 			DisplayName: channel.DisplayName,
-			Regexp:      channel.Regexp,
 			Category:    channel.Category,
 			Area:        channel.Area,
 			LogoURL:     channel.LogoURL,
@@ -120,7 +120,6 @@ func (h *ChannelHandler) UpdateChannel(c *gin.Context) {
 		IsActive:    req.IsActive,
 		Area:        req.Area,
 		LogoURL:     req.LogoURL,
-		Regexp:      req.Regexp,
 		Timezone:    req.Timezone,
 	}
 
@@ -168,3 +167,75 @@ func (h *ChannelHandler) GetChannelMappings(c *gin.Context) {
 
 	c.JSON(http.StatusOK, dto.Success(mappings))
 }
+
+func (h *ChannelHandler) ListAliases(c *gin.Context) {
+	aliases, err := h.channelService.ListAliases(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to list channel aliases", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(aliases))
+}
+
+func (h *ChannelHandler) CreateAlias(c *gin.Context) {
+	var req dto.ChannelAliasRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	alias, err := h.channelService.CreateAlias(c.Request.Context(), &model.ChannelAlias{
+		ChannelID: c.Param("id"),
+		Alias:     req.Alias,
+		MatchType: req.MatchType,
+	})
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to create channel alias", err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.Success(alias))
+}
+
+func (h *ChannelHandler) UpdateAlias(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("alias_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid channel alias id", err))
+		return
+	}
+
+	var req dto.ChannelAliasRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	alias, err := h.channelService.UpdateAlias(c.Request.Context(), &model.ChannelAlias{
+		ID:        id,
+		ChannelID: c.Param("id"),
+		Alias:     req.Alias,
+		MatchType: req.MatchType,
+	})
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to update channel alias", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(alias))
+}
+
+func (h *ChannelHandler) DeleteAlias(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("alias_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid channel alias id", err))
+		return
+	}
+
+	if err := h.channelService.DeleteAlias(c.Request.Context(), c.Param("id"), id); err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to delete channel alias", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(nil))
+}
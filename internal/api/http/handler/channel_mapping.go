@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/epg-sync/epgsync/internal/api/dto"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type ChannelMappingHandler struct {
+	channelMappingService *service.ChannelMappingService
+}
+
+func NewChannelMappingHandler(channelMappingService *service.ChannelMappingService) *ChannelMappingHandler {
+	return &ChannelMappingHandler{
+		channelMappingService: channelMappingService,
+	}
+}
+
+func (h *ChannelMappingHandler) ReviewQueue(c *gin.Context) {
+	var req dto.MappingReviewRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	items, err := h.channelMappingService.ReviewQueue(c.Request.Context(), req.ProviderID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.InternalServerError("Failed to build mapping review queue", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(items))
+}
+
+func (h *ChannelMappingHandler) CreateMapping(c *gin.Context) {
+	var req dto.CreateChannelMappingRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	mapping, err := h.channelMappingService.CreateMapping(c.Request.Context(), req.ProviderID, req.ProviderChannelID, req.CanonicalID, req.Priority)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to create channel mapping", err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.Success(mapping))
+}
+
+func (h *ChannelMappingHandler) ApproveMapping(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid channel mapping id", err))
+		return
+	}
+
+	mapping, err := h.channelMappingService.ApproveMapping(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to approve channel mapping", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(mapping))
+}
+
+func (h *ChannelMappingHandler) RejectMapping(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid channel mapping id", err))
+		return
+	}
+
+	mapping, err := h.channelMappingService.RejectMapping(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to reject channel mapping", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(mapping))
+}
+
+func (h *ChannelMappingHandler) UpdateMapping(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid channel mapping id", err))
+		return
+	}
+
+	var req dto.UpdateChannelMappingRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	mapping, err := h.channelMappingService.UpdateMapping(c.Request.Context(), id, req.CanonicalID, req.ProviderChannelID, req.Priority)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to update channel mapping", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(mapping))
+}
+
+func (h *ChannelMappingHandler) DeleteMapping(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid channel mapping id", err))
+		return
+	}
+
+	if err := h.channelMappingService.DeleteMapping(c.Request.Context(), id); err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to delete channel mapping", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(nil))
+}
+
+func (h *ChannelMappingHandler) ExportMappings(c *gin.Context) {
+	var req dto.ChannelMappingFormatRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+	if req.Format == "" {
+		req.Format = service.MappingFormatJSON
+	}
+
+	var buf bytes.Buffer
+	if err := h.channelMappingService.ExportMappings(c.Request.Context(), &buf, req.Format); err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to export channel mappings", err))
+		return
+	}
+
+	contentType := "application/json"
+	if req.Format == service.MappingFormatCSV {
+		contentType = "text/csv; charset=utf-8"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=channel_mappings.%s", req.Format))
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
+
+// ImportMappings accepts the file either as a multipart "file" field or as
+// the raw request body.
+func (h *ChannelMappingHandler) ImportMappings(c *gin.Context) {
+	var req dto.ChannelMappingFormatRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	var body io.Reader = c.Request.Body
+	if c.ContentType() == "multipart/form-data" {
+		file, err := c.FormFile("file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid upload", err))
+			return
+		}
+		f, err := file.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid upload", err))
+			return
+		}
+		defer f.Close()
+		body = f
+	}
+
+	result, err := h.channelMappingService.ImportMappings(c.Request.Context(), body, req.Format)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to import channel mappings", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(result))
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/epg-sync/epgsync/internal/api/dto"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ConfigHandler struct {
+	configService *service.ConfigService
+}
+
+func NewConfigHandler(configService *service.ConfigService) *ConfigHandler {
+	return &ConfigHandler{
+		configService: configService,
+	}
+}
+
+// GetConfig returns the config the server runs with, secrets redacted.
+func (h *ConfigHandler) GetConfig(c *gin.Context) {
+	effective, err := h.configService.Effective()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.InternalServerError("Failed to read config", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(effective))
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/epg-sync/epgsync/internal/api/dto"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type QualityHandler struct {
+	qualityService *service.QualityService
+}
+
+func NewQualityHandler(qualityService *service.QualityService) *QualityHandler {
+	return &QualityHandler{
+		qualityService: qualityService,
+	}
+}
+
+func (h *QualityHandler) ListReports(c *gin.Context) {
+	var req dto.ListQualityReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.PageSize == 0 {
+		req.PageSize = 50
+	}
+
+	filter := &repository.ScheduleReportFilter{
+		ChannelID:  req.ChannelID,
+		ProviderID: req.ProviderID,
+		Date:       req.Date,
+		OnlyIssues: req.OnlyIssues,
+	}
+
+	reports, total, err := h.qualityService.ListReports(c.Request.Context(), filter, req.Page, req.PageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.InternalServerError("Failed to list quality reports", err))
+		return
+	}
+
+	items := make([]any, len(reports))
+	for i, report := range reports {
+		items[i] = report
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessPaginated(items, total, req.Page, req.PageSize))
+}
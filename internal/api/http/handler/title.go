@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/epg-sync/epgsync/internal/api/dto"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type TitleHandler struct {
+	titleService *service.TitleService
+}
+
+func NewTitleHandler(titleService *service.TitleService) *TitleHandler {
+	return &TitleHandler{
+		titleService: titleService,
+	}
+}
+
+func (h *TitleHandler) DryRun(c *gin.Context) {
+	var req dto.TitleDryRunRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+	if req.Limit == 0 {
+		req.Limit = 100
+	}
+
+	rules := make([]model.TitleRule, len(req.Rules))
+	for i, rule := range req.Rules {
+		rules[i] = model.TitleRule{
+			Name:    rule.Name,
+			Type:    rule.Type,
+			Pattern: rule.Pattern,
+			Replace: rule.Replace,
+		}
+	}
+
+	results, err := h.titleService.DryRun(c.Request.Context(), req.ProviderID, req.Titles, rules, req.Limit)
+	if err != nil {
+		status := errors.HTTPStatus(err)
+		c.JSON(status, dto.Error(status, "Failed to run title rules", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(results))
+}
@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/epg-sync/epgsync/internal/api/dto"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// UserHandler serves user administration under /admin/users.
+type UserHandler struct {
+	userService *service.UserService
+}
+
+func NewUserHandler(userService *service.UserService) *UserHandler {
+	return &UserHandler{
+		userService: userService,
+	}
+}
+
+func (h *UserHandler) ListUsers(c *gin.Context) {
+	var req dto.ListUsersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.PageSize == 0 {
+		req.PageSize = 20
+	}
+
+	users, total, err := h.userService.ListUsers(c.Request.Context(), req.Page, req.PageSize)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to list users", err))
+		return
+	}
+
+	items := make([]any, len(users))
+	for i, user := range users {
+		items[i] = user
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessPaginated(items, total, req.Page, req.PageSize))
+}
+
+func (h *UserHandler) CreateUser(c *gin.Context) {
+	var req dto.CreateUserRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	user, err := h.userService.CreateUser(c.Request.Context(), req.Username, req.Password, req.Email, req.Role)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to create user", err))
+		return
+	}
+
+	logger.Info("User created",
+		logger.String("by", c.GetString("username")),
+		logger.String("username", user.Username),
+		logger.String("role", user.Role),
+	)
+
+	c.JSON(http.StatusCreated, dto.Success(user))
+}
+
+func (h *UserHandler) GetUser(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid user id", err))
+		return
+	}
+
+	user, err := h.userService.GetUserByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to get user", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(user))
+}
+
+func (h *UserHandler) UpdateUser(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid user id", err))
+		return
+	}
+
+	var req dto.UpdateUserRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	user, err := h.userService.UpdateUserFields(c.Request.Context(), c.GetInt64("user_id"), id, service.UserUpdate{
+		Email:    req.Email,
+		Role:     req.Role,
+		IsActive: req.IsActive,
+	})
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to update user", err))
+		return
+	}
+
+	logger.Info("User updated",
+		logger.String("by", c.GetString("username")),
+		logger.String("username", user.Username),
+		logger.String("role", user.Role),
+		logger.Int("is_active", user.IsActive),
+	)
+
+	c.JSON(http.StatusOK, dto.Success(user))
+}
+
+func (h *UserHandler) ResetPassword(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid user id", err))
+		return
+	}
+
+	var req dto.ResetPasswordRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	if err := h.userService.ResetPassword(c.Request.Context(), id, req.Password); err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to reset password", err))
+		return
+	}
+
+	logger.Info("User password reset",
+		logger.String("by", c.GetString("username")),
+		logger.Int64("id", id),
+	)
+
+	c.JSON(http.StatusOK, dto.Success(gin.H{"message": "Password reset successfully"}))
+}
+
+func (h *UserHandler) UpdateRole(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid user id", err))
+		return
+	}
+
+	var req dto.UpdateUserRoleRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	user, err := h.userService.UpdateRole(c.Request.Context(), c.GetInt64("user_id"), id, req.Role)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to update user role", err))
+		return
+	}
+
+	logger.Info("User role changed",
+		logger.String("by", c.GetString("username")),
+		logger.String("username", user.Username),
+		logger.String("role", user.Role),
+	)
+
+	c.JSON(http.StatusOK, dto.Success(user))
+}
+
+func (h *UserHandler) DeleteUser(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid user id", err))
+		return
+	}
+
+	if err := h.userService.DeleteUser(c.Request.Context(), c.GetInt64("user_id"), id); err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to delete user", err))
+		return
+	}
+
+	logger.Info("User deleted",
+		logger.String("by", c.GetString("username")),
+		logger.Int64("id", id),
+	)
+
+	c.JSON(http.StatusOK, dto.Success(gin.H{"message": "User deleted successfully"}))
+}
+
+// ListRoles returns each role with the permissions it grants.
+func (h *UserHandler) ListRoles(c *gin.Context) {
+	roles := make([]gin.H, 0, len(model.Roles()))
+	for _, role := range model.Roles() {
+		roles = append(roles, gin.H{
+			"role":        role,
+			"permissions": model.RolePermissions(role),
+		})
+	}
+
+	c.JSON(http.StatusOK, dto.Success(roles))
+}
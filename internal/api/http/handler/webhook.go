@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/epg-sync/epgsync/internal/api/dto"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	webhookService *service.WebhookService
+}
+
+func NewWebhookHandler(webhookService *service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+func (h *WebhookHandler) ListWebhooks(c *gin.Context) {
+	webhooks, err := h.webhookService.ListWebhooks(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.InternalServerError("Failed to list webhooks", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(webhooks))
+}
+
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	webhook := &model.Webhook{
+		Name:       req.Name,
+		URL:        req.URL,
+		Secret:     req.Secret,
+		Events:     strings.Join(req.Events, ","),
+		ChannelIDs: strings.Join(req.ChannelIDs, ","),
+		IsActive:   1,
+	}
+
+	created, err := h.webhookService.CreateWebhook(c.Request.Context(), webhook)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.InternalServerError("Failed to create webhook", err))
+		return
+	}
+
+	// the secret is only ever returned here, store it on the receiving side
+	c.JSON(http.StatusCreated, dto.Success(gin.H{
+		"webhook": created,
+		"secret":  created.Secret,
+	}))
+}
+
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid webhook id", err))
+		return
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid request parameters", err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	webhook, err := h.webhookService.GetWebhook(ctx, id)
+	if err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to get webhook", err))
+		return
+	}
+
+	webhook.Name = req.Name
+	webhook.URL = req.URL
+	webhook.Events = strings.Join(req.Events, ",")
+	webhook.ChannelIDs = strings.Join(req.ChannelIDs, ",")
+	webhook.IsActive = req.IsActive
+	if req.Secret != "" {
+		webhook.Secret = req.Secret
+	}
+
+	if err := h.webhookService.UpdateWebhook(ctx, webhook); err != nil {
+		c.JSON(http.StatusInternalServerError, dto.InternalServerError("Failed to update webhook", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(webhook))
+}
+
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid webhook id", err))
+		return
+	}
+
+	if err := h.webhookService.DeleteWebhook(c.Request.Context(), id); err != nil {
+		c.JSON(errors.HTTPStatus(err), dto.Error(errors.HTTPStatus(err), "Failed to delete webhook", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(gin.H{"message": "Webhook deleted successfully"}))
+}
+
+func (h *WebhookHandler) TestWebhook(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.BadRequest("Invalid webhook id", err))
+		return
+	}
+
+	status, err := h.webhookService.TestWebhook(c.Request.Context(), id)
+	if errors.Is(err, errors.ErrCodeNotFound) {
+		c.JSON(http.StatusNotFound, dto.NotFound("Webhook not found"))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadGateway, dto.Error(http.StatusBadGateway, "Webhook delivery failed", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Success(gin.H{"status_code": status}))
+}
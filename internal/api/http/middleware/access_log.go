@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+// maskedQueryParams are query parameters whose values never reach the
+// access log. API keys may be passed as ?token= by players that cannot set
+// headers.
+var maskedQueryParams = []string{"token"}
+
+// AccessLogger logs every request like gin.Logger, with the values of
+// maskedQueryParams replaced in the logged path.
+func AccessLogger() gin.HandlerFunc {
+	return gin.LoggerWithConfig(gin.LoggerConfig{Formatter: accessLogFormatter})
+}
+
+// accessLogFormatter is the default format of gin.Logger.
+func accessLogFormatter(param gin.LogFormatterParams) string {
+	var statusColor, methodColor, resetColor string
+	if param.IsOutputColor() {
+		statusColor = param.StatusCodeColor()
+		methodColor = param.MethodColor()
+		resetColor = param.ResetColor()
+	}
+
+	if param.Latency > time.Minute {
+		param.Latency = param.Latency.Truncate(time.Second)
+	}
+	return fmt.Sprintf("[GIN] %v |%s %3d %s| %13v | %15s |%s %-7s %s %#v\n%s",
+		param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+		statusColor, param.StatusCode, resetColor,
+		param.Latency,
+		param.ClientIP,
+		methodColor, param.Method, resetColor,
+		maskQuery(param.Path),
+		param.ErrorMessage,
+	)
+}
+
+// maskQuery replaces the values of maskedQueryParams in a path with its raw
+// query, leaving the rest of the query as it was sent.
+func maskQuery(path string) string {
+	base, rawQuery, ok := strings.Cut(path, "?")
+	if !ok {
+		return path
+	}
+
+	pairs := strings.Split(rawQuery, "&")
+	for i, pair := range pairs {
+		key, _, _ := strings.Cut(pair, "=")
+		if name, err := url.QueryUnescape(key); err == nil && slices.Contains(maskedQueryParams, name) {
+			pairs[i] = key + "=" + config.Redacted
+		}
+	}
+	return base + "?" + strings.Join(pairs, "&")
+}
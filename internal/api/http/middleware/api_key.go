@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// apiKeyFromRequest returns the API key from the X-API-Key header, an
+// Authorization bearer token starting with the key prefix, or the token
+// query parameter, in that order.
+func apiKeyFromRequest(c *gin.Context) string {
+	if key := c.GetHeader("X-API-Key"); key != "" {
+		return key
+	}
+	if bearer := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer "); strings.HasPrefix(bearer, model.APIKeyPrefix) {
+		return bearer
+	}
+	return c.Query("token")
+}
+
+// authenticateAPIKey sets the same user keys as JWTAuthMiddleware plus the
+// key id and scopes, or aborts the request.
+func authenticateAPIKey(c *gin.Context, apiKeys *service.APIKeyService, plaintext string) bool {
+	key, user, err := apiKeys.Authenticate(c.Request.Context(), plaintext, c.ClientIP())
+	if err != nil {
+		logger.Warn("Invalid API key",
+			logger.Err(err),
+			logger.String("path", c.Request.URL.Path),
+			logger.String("ip", c.ClientIP()),
+		)
+		message := "invalid API key"
+		if errors.Is(err, errors.ErrCodeForbidden) {
+			message = "user account is disabled"
+		}
+		c.JSON(errors.HTTPStatus(err), gin.H{
+			"error": message,
+			"code":  errors.GetCode(err),
+		})
+		c.Abort()
+		return false
+	}
+
+	c.Set("user_id", user.ID)
+	c.Set("username", user.Username)
+	c.Set("role", user.Role)
+	c.Set("api_key_id", key.ID)
+	c.Set("api_key_scopes", key.ScopeList())
+	return true
+}
+
+// AuthMiddleware accepts either a login token or an API key. Requests made
+// with a key are further limited to its scopes by RequirePermission.
+func AuthMiddleware(tokens *service.TokenService, apiKeys *service.APIKeyService) gin.HandlerFunc {
+	jwtAuth := JWTAuthMiddleware(tokens)
+	return func(c *gin.Context) {
+		plaintext := apiKeyFromRequest(c)
+		if plaintext == "" {
+			jwtAuth(c)
+			return
+		}
+
+		if authenticateAPIKey(c, apiKeys, plaintext) {
+			c.Next()
+		}
+	}
+}
+
+// RequireSession rejects requests made with an API key, so that a key
+// cannot be used to mint or revoke keys.
+func RequireSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := c.Get("api_key_id"); ok {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": "API keys cannot be used for this endpoint",
+				"code":  errors.ErrCodeForbidden,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireAPIKey guards a public output. When required is false it lets
+// every request through; otherwise the request needs an active key with the
+// epg:read scope whose owner may still read.
+func RequireAPIKey(apiKeys *service.APIKeyService, required bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !required {
+			c.Next()
+			return
+		}
+
+		plaintext := apiKeyFromRequest(c)
+		if plaintext == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "missing API key",
+				"code":  errors.ErrCodeUnauthorized,
+			})
+			c.Abort()
+			return
+		}
+		if !authenticateAPIKey(c, apiKeys, plaintext) {
+			return
+		}
+
+		if !hasPermission(c, model.PermissionRead) {
+			denyPermission(c, model.PermissionRead)
+			return
+		}
+
+		c.Next()
+	}
+}
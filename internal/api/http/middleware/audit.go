@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// AuditActor records the authenticated user and client IP in the request
+// context, so changes made by the services are audited against them. It
+// must run after the authentication middleware.
+func AuditActor() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(service.WithAuditActor(c.Request.Context(), service.AuditActor{
+			UserID:   c.GetInt64("user_id"),
+			Username: c.GetString("username"),
+			IP:       c.ClientIP(),
+		}))
+		c.Next()
+	}
+}
@@ -4,13 +4,15 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/epg-sync/epgsync/internal/service"
 	"github.com/epg-sync/epgsync/pkg/errors"
 	"github.com/epg-sync/epgsync/pkg/logger"
-	"github.com/epg-sync/epgsync/pkg/utils"
 	"github.com/gin-gonic/gin"
 )
 
-func JWTAuthMiddleware(jwtSecret string) gin.HandlerFunc {
+// JWTAuthMiddleware accepts access tokens that have not expired, been
+// logged out or had their session revoked.
+func JWTAuthMiddleware(tokens *service.TokenService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 
 		tokenString := c.GetHeader("Authorization")
@@ -30,7 +32,7 @@ func JWTAuthMiddleware(jwtSecret string) gin.HandlerFunc {
 			return
 		}
 
-		claims, err := utils.ParseToken(tokenString, jwtSecret)
+		claims, err := tokens.Validate(c.Request.Context(), tokenString)
 		if err != nil {
 			logger.Warn("Invalid JWT token",
 				logger.Err(err),
@@ -48,6 +50,7 @@ func JWTAuthMiddleware(jwtSecret string) gin.HandlerFunc {
 		c.Set("user_id", claims.UserID)
 		c.Set("username", claims.Username)
 		c.Set("role", claims.Role)
+		c.Set("claims", claims)
 
 		c.Next()
 	}
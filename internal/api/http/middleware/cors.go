@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+// CORS applies a CORS policy. Requests from origins the policy does not
+// allow get no CORS headers, so the browser keeps the response from the
+// page, and their preflights are refused. Preflights are answered here and
+// never reach the handlers.
+func CORS(policy config.CORSPolicy) gin.HandlerFunc {
+	anyOrigin := slices.Contains(policy.AllowedOrigins, "*")
+	methods := strings.Join(policy.AllowedMethods, ", ")
+	headers := strings.Join(policy.AllowedHeaders, ", ")
+	exposed := strings.Join(policy.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(policy.MaxAge.Seconds()))
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		if origin == "" {
+			c.Next()
+			return
+		}
+
+		header := c.Writer.Header()
+		header.Add("Vary", "Origin")
+		preflight := c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != ""
+
+		allowed := anyOrigin || slices.ContainsFunc(policy.AllowedOrigins, func(o string) bool {
+			return strings.EqualFold(o, origin)
+		})
+		if !allowed {
+			if preflight {
+				c.AbortWithStatus(http.StatusForbidden)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		if anyOrigin && !policy.AllowCredentials {
+			header.Set("Access-Control-Allow-Origin", "*")
+		} else {
+			header.Set("Access-Control-Allow-Origin", origin)
+		}
+		if policy.AllowCredentials {
+			header.Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		if preflight {
+			header.Set("Access-Control-Allow-Methods", methods)
+			header.Set("Access-Control-Allow-Headers", headers)
+			header.Set("Access-Control-Max-Age", maxAge)
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		if exposed != "" {
+			header.Set("Access-Control-Expose-Headers", exposed)
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission rejects requests whose role, as set by
+// JWTAuthMiddleware or AuthMiddleware, does not grant the permission.
+// Requests made with an API key also need a scope covering it.
+func RequirePermission(permission model.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !hasPermission(c, permission) {
+			denyPermission(c, permission)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func hasPermission(c *gin.Context, permission model.Permission) bool {
+	if !model.RoleHasPermission(c.GetString("role"), permission) {
+		return false
+	}
+	if scopes, ok := c.Get("api_key_scopes"); ok {
+		return model.ScopesAllow(scopes.([]string), permission)
+	}
+	return true
+}
+
+func denyPermission(c *gin.Context, permission model.Permission) {
+	logger.Warn("Permission denied",
+		logger.String("username", c.GetString("username")),
+		logger.String("role", c.GetString("role")),
+		logger.String("permission", string(permission)),
+		logger.String("path", c.Request.URL.Path),
+	)
+	c.JSON(http.StatusForbidden, gin.H{
+		"error": "permission denied",
+		"code":  errors.ErrCodeForbidden,
+	})
+	c.Abort()
+}
@@ -0,0 +1,15 @@
+package middleware
+
+import (
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// ReplicaReads lets the database reads of the request go to a read replica
+// when replicas are configured.
+func ReplicaReads() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(repository.WithReplicaReads(c.Request.Context()))
+		c.Next()
+	}
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+// SecurityHeaders sets the security headers of every response.
+func SecurityHeaders(cfg config.SecurityHeadersConfig) gin.HandlerFunc {
+	frameOptions := strings.ToUpper(cfg.FrameOptions)
+	if frameOptions == "OFF" {
+		frameOptions = ""
+	}
+	referrerPolicy := cfg.ReferrerPolicy
+	if strings.EqualFold(referrerPolicy, "off") {
+		referrerPolicy = ""
+	}
+	hsts := ""
+	if cfg.HSTSMaxAge > 0 {
+		hsts = "max-age=" + strconv.Itoa(int(cfg.HSTSMaxAge.Seconds()))
+		if cfg.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+
+	return func(c *gin.Context) {
+		header := c.Writer.Header()
+		header.Set("X-Content-Type-Options", "nosniff")
+		if frameOptions != "" {
+			header.Set("X-Frame-Options", frameOptions)
+		}
+		if referrerPolicy != "" {
+			header.Set("Referrer-Policy", referrerPolicy)
+		}
+		if hsts != "" {
+			header.Set("Strict-Transport-Security", hsts)
+		}
+		c.Next()
+	}
+}
@@ -1,11 +1,16 @@
 package router
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/epg-sync/epgsync/internal/api/http/handler"
 	"github.com/epg-sync/epgsync/internal/api/http/middleware"
 	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/epg-sync/epgsync/pkg/logger"
 )
 
 func SetupRouter(
@@ -14,62 +19,150 @@ func SetupRouter(
 	epgHandler *handler.EPGHandler,
 	schedulerHandler *handler.SchedulerHandler,
 	authHandler *handler.AuthHandler,
+	changeHandler *handler.ChangeHandler,
+	webhookHandler *handler.WebhookHandler,
+	qualityHandler *handler.QualityHandler,
+	titleHandler *handler.TitleHandler,
+	categoryHandler *handler.CategoryHandler,
+	channelMappingHandler *handler.ChannelMappingHandler,
+	userHandler *handler.UserHandler,
+	apiKeyHandler *handler.APIKeyHandler,
+	apiKeyService *service.APIKeyService,
+	tokenService *service.TokenService,
+	auditHandler *handler.AuditHandler,
+	configHandler *handler.ConfigHandler,
 ) *gin.Engine {
 
 	router := gin.New()
-	router.Use(gin.Logger())
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		logger.Error("Invalid trusted proxies, client IPs are taken from the connection", logger.Err(err))
+		_ = router.SetTrustedProxies(nil)
+	}
+	router.Use(middleware.AccessLogger())
 	router.Use(gin.Recovery())
-	router.Use(corsMiddleware())
+	router.Use(middleware.SecurityHeaders(cfg.Server.SecurityHeaders))
 
-	auth := router.Group("/auth")
+	auth := corsGroup(router, "/auth", cfg.Server.CORS.Auth)
 	{
 		auth.POST("/login", authHandler.Login)
-		auth.GET("/me", middleware.JWTAuthMiddleware(cfg.Server.JWTSecret), authHandler.GetCurrentUser)
-		auth.PUT("/password", middleware.JWTAuthMiddleware(cfg.Server.JWTSecret), authHandler.ChangePassword)
+		auth.POST("/refresh", authHandler.Refresh)
+		auth.GET("/oidc", authHandler.OIDCStatus)
+		auth.GET("/oidc/login", authHandler.OIDCLogin)
+		auth.GET("/oidc/callback", authHandler.OIDCCallback)
+		auth.POST("/oidc/token", authHandler.OIDCToken)
+		auth.POST("/logout", middleware.JWTAuthMiddleware(tokenService), authHandler.Logout)
+		auth.GET("/me", middleware.JWTAuthMiddleware(tokenService), authHandler.GetCurrentUser)
+		auth.PUT("/password", middleware.JWTAuthMiddleware(tokenService), middleware.AuditActor(), authHandler.ChangePassword)
 	}
 
-	admin := router.Group("/admin")
-	admin.Use(middleware.JWTAuthMiddleware(cfg.Server.JWTSecret))
+	admin := corsGroup(router, "/admin", cfg.Server.CORS.Admin)
+	admin.Use(middleware.AuthMiddleware(tokenService, apiKeyService), middleware.AuditActor())
+
+	read := admin.Group("", middleware.RequirePermission(model.PermissionRead))
 	{
-		admin.GET("/channels", channelHandler.ListChannels)
-		admin.POST("/channels", channelHandler.CreateChannel)
-		admin.POST("/channels/batch", channelHandler.BatchCreateChannel)
-		admin.GET("/channels/:id", channelHandler.GetChannel)
-		admin.PUT("/channels/:id", channelHandler.UpdateChannel)
-		admin.DELETE("/channels/:id", channelHandler.DeleteChannel)
+		read.GET("/channels", channelHandler.ListChannels)
+		read.GET("/channels/:id", channelHandler.GetChannel)
+		read.GET("/channels/:id/aliases", channelHandler.ListAliases)
+		read.GET("/channels/:id/mappings", channelHandler.GetChannelMappings)
 
-		admin.GET("/channel-mappings", channelHandler.ListChannelMappings)
-		admin.GET("/channels/:id/mappings", channelHandler.GetChannelMappings)
+		read.GET("/channel-mappings", channelHandler.ListChannelMappings)
+		read.GET("/channel-mappings/review", channelMappingHandler.ReviewQueue)
+		read.GET("/channel-mappings/export", channelMappingHandler.ExportMappings)
 
-		admin.GET("/programs/search", epgHandler.GetEPGByChannelAndDate)
+		read.GET("/programs/search", epgHandler.GetEPGByChannelAndDate)
 
-		admin.POST("/epg/sync", epgHandler.SyncEPGByChannelAndDateRange)
+		read.GET("/changes", changeHandler.ListChanges)
+		read.GET("/reports/quality", qualityHandler.ListReports)
 
-		admin.POST("/job/sync", schedulerHandler.SyncAllEPG)
+		read.POST("/titles/dry-run", titleHandler.DryRun)
 
+		read.GET("/category-rules", categoryHandler.ListRules)
+		read.GET("/webhooks", webhookHandler.ListWebhooks)
+
+		read.GET("/roles", userHandler.ListRoles)
 	}
 
-	api := router.Group("/api")
+	sync := admin.Group("", middleware.RequirePermission(model.PermissionSync))
 	{
-		api.GET("/diyp", epgHandler.GenerateDIYPProgram)
-		api.GET("/xmltv", epgHandler.GenerateXMLTVProgram)
+		sync.POST("/epg/sync", epgHandler.SyncEPGByChannelAndDateRange)
+		sync.POST("/job/sync", schedulerHandler.SyncAllEPG)
 	}
 
-	return router
-}
+	mappings := admin.Group("/channel-mappings", middleware.RequirePermission(model.PermissionMappings))
+	{
+		mappings.POST("", channelMappingHandler.CreateMapping)
+		mappings.POST("/import", channelMappingHandler.ImportMappings)
+		mappings.PUT("/:id", channelMappingHandler.UpdateMapping)
+		mappings.DELETE("/:id", channelMappingHandler.DeleteMapping)
+		mappings.POST("/:id/approve", channelMappingHandler.ApproveMapping)
+		mappings.POST("/:id/reject", channelMappingHandler.RejectMapping)
+	}
 
-func corsMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
-		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE, PATCH")
+	configure := admin.Group("", middleware.RequirePermission(model.PermissionConfigure))
+	{
+		configure.POST("/channels", channelHandler.CreateChannel)
+		configure.POST("/channels/batch", channelHandler.BatchCreateChannel)
+		configure.PUT("/channels/:id", channelHandler.UpdateChannel)
+		configure.DELETE("/channels/:id", channelHandler.DeleteChannel)
+		configure.POST("/channels/:id/aliases", channelHandler.CreateAlias)
+		configure.PUT("/channels/:id/aliases/:alias_id", channelHandler.UpdateAlias)
+		configure.DELETE("/channels/:id/aliases/:alias_id", channelHandler.DeleteAlias)
+
+		configure.POST("/category-rules", categoryHandler.CreateRule)
+		configure.PUT("/category-rules/:id", categoryHandler.UpdateRule)
+		configure.DELETE("/category-rules/:id", categoryHandler.DeleteRule)
+
+		configure.POST("/webhooks", webhookHandler.CreateWebhook)
+		configure.PUT("/webhooks/:id", webhookHandler.UpdateWebhook)
+		configure.DELETE("/webhooks/:id", webhookHandler.DeleteWebhook)
+		configure.POST("/webhooks/:id/test", webhookHandler.TestWebhook)
+
+		// the effective config, secrets redacted
+		configure.GET("/config", configHandler.GetConfig)
+	}
 
-		if c.Request.Method == "OPTIONS" {
-			c.AbortWithStatus(204)
-			return
-		}
+	users := admin.Group("/users", middleware.RequirePermission(model.PermissionManageUsers))
+	{
+		users.GET("", userHandler.ListUsers)
+		users.POST("", userHandler.CreateUser)
+		users.GET("/:id", userHandler.GetUser)
+		users.PUT("/:id", userHandler.UpdateUser)
+		users.PUT("/:id/role", userHandler.UpdateRole)
+		users.PUT("/:id/password", userHandler.ResetPassword)
+		users.DELETE("/:id", userHandler.DeleteUser)
+	}
 
-		c.Next()
+	audit := admin.Group("/audit", middleware.RequirePermission(model.PermissionManageUsers))
+	{
+		audit.GET("", auditHandler.ListEntries)
+	}
+
+	// every role may manage its own keys, but only from a login session
+	apiKeys := admin.Group("/api-keys", middleware.RequireSession())
+	{
+		apiKeys.GET("", apiKeyHandler.ListKeys)
+		apiKeys.POST("", apiKeyHandler.CreateKey)
+		apiKeys.DELETE("/:id", apiKeyHandler.RevokeKey)
 	}
+
+	api := corsGroup(router, "/api", cfg.Server.CORS.API)
+	api.Use(middleware.ReplicaReads())
+	{
+		api.GET("/diyp", middleware.RequireAPIKey(apiKeyService, cfg.API.DIYP.RequireKey), epgHandler.GenerateDIYPProgram)
+		api.GET("/xmltv", middleware.RequireAPIKey(apiKeyService, cfg.API.XMLTV.RequireKey), epgHandler.GenerateXMLTVProgram)
+	}
+
+	return router
+}
+
+// corsGroup creates a route group with its own CORS policy. The catch-all
+// OPTIONS route is registered before the caller adds authentication to the
+// group, so preflights, which carry no credentials, are answered by the CORS
+// middleware alone.
+func corsGroup(router *gin.Engine, path string, policy config.CORSPolicy) *gin.RouterGroup {
+	group := router.Group(path, middleware.CORS(policy))
+	group.OPTIONS("/*path", func(c *gin.Context) {
+		c.AbortWithStatus(http.StatusNoContent)
+	})
+	return group
 }
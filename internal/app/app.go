@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/epg-sync/epgsync/internal/api/http/handler"
@@ -26,14 +27,26 @@ type App struct {
 	services      *Services
 	providerChain *provider.Chain
 	server        *http.Server
+
+	reloadMu sync.Mutex    // serializes config reloads
+	done     chan struct{} // closed by Stop
 }
 
 type Repositories struct {
 	Channel         repository.ChannelRepository
 	Program         repository.ProgramRepository
 	ChannelMappings repository.ChannelMappingsRepository
+	ChannelAlias    repository.ChannelAliasRepository
 	Timezone        repository.TimezoneRepository
 	User            repository.UserRepository
+	ProgramChange   repository.ProgramChangeRepository
+	Webhook         repository.WebhookRepository
+	ScheduleReport  repository.ScheduleReportRepository
+	CategoryRule    repository.CategoryRuleRepository
+	APIKey          repository.APIKeyRepository
+	RefreshToken    repository.RefreshTokenRepository
+	AuditLog        repository.AuditLogRepository
+	UserIdentity    repository.UserIdentityRepository
 }
 
 type Services struct {
@@ -42,11 +55,22 @@ type Services struct {
 	ChannelMapping *service.ChannelMappingService
 	Scheduler      *service.SchedulerService
 	User           *service.UserService
+	ChangeFeed     *service.ChangeFeedService
+	Webhook        *service.WebhookService
+	Quality        *service.QualityService
+	Title          *service.TitleService
+	Category       *service.CategoryService
+	APIKey         *service.APIKeyService
+	Token          *service.TokenService
+	Audit          *service.AuditService
+	OIDC           *service.OIDCService
+	Config         *service.ConfigService
 }
 
 func New(cfg *config.AppConfig) (*App, error) {
 	app := &App{
-		cfg: cfg,
+		cfg:  cfg,
+		done: make(chan struct{}),
 	}
 
 	if err := app.initialize(); err != nil {
@@ -57,6 +81,12 @@ func New(cfg *config.AppConfig) (*App, error) {
 }
 
 func (app *App) initialize() error {
+	for _, path := range app.cfg.EnvOverrides() {
+		logger.Info("Config value overridden", logger.String("key", path), logger.String("source", app.cfg.Source(path)))
+	}
+	if app.cfg.InsecureJWTSecret() {
+		logger.Warn("server.jwt_secret is empty or the published default, anyone can sign tokens; set your own before exposing the service")
+	}
 
 	if err := app.initializeDatabase(); err != nil {
 		return fmt.Errorf("init database: %w", err)
@@ -81,7 +111,7 @@ func (app *App) initialize() error {
 		return fmt.Errorf("init provider chain: %w", err)
 	}
 
-	if err := app.autoMapProviderChannels(); err != nil {
+	if err := app.autoMapProviderChannels(app.providerChain.GetProviders()); err != nil {
 		return fmt.Errorf("auto map provider channels: %w", err)
 	}
 
@@ -97,7 +127,17 @@ func (app *App) Start() error {
 		channelHandler := handler.NewChannelHandler(app.services.Channel)
 		epgHandler := handler.NewEPGHandler(app.services.EPG)
 		schedulerHandler := handler.NewSchedulerHandler(app.services.Scheduler)
-		authHandler := handler.NewAuthHandler(app.services.User)
+		authHandler := handler.NewAuthHandler(app.services.User, app.services.Token, app.services.OIDC)
+		changeHandler := handler.NewChangeHandler(app.services.ChangeFeed)
+		webhookHandler := handler.NewWebhookHandler(app.services.Webhook)
+		qualityHandler := handler.NewQualityHandler(app.services.Quality)
+		titleHandler := handler.NewTitleHandler(app.services.Title)
+		categoryHandler := handler.NewCategoryHandler(app.services.Category)
+		channelMappingHandler := handler.NewChannelMappingHandler(app.services.ChannelMapping)
+		userHandler := handler.NewUserHandler(app.services.User)
+		apiKeyHandler := handler.NewAPIKeyHandler(app.services.APIKey)
+		auditHandler := handler.NewAuditHandler(app.services.Audit)
+		configHandler := handler.NewConfigHandler(app.services.Config)
 
 		if app.cfg.Server.Mode == "release" {
 			gin.SetMode(gin.ReleaseMode)
@@ -109,9 +149,22 @@ func (app *App) Start() error {
 			epgHandler,
 			schedulerHandler,
 			authHandler,
+			changeHandler,
+			webhookHandler,
+			qualityHandler,
+			titleHandler,
+			categoryHandler,
+			channelMappingHandler,
+			userHandler,
+			apiKeyHandler,
+			app.services.APIKey,
+			app.services.Token,
+			auditHandler,
+			configHandler,
 		)
 
 		app.services.Scheduler.Start()
+		go app.watchConfig()
 
 		app.server = &http.Server{
 			Addr:         fmt.Sprintf(":%d", app.cfg.Server.Port),
@@ -134,6 +187,7 @@ func (app *App) Start() error {
 }
 
 func (app *App) Stop() {
+	close(app.done)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -3,14 +3,13 @@ package app
 import (
 	"context"
 
+	"github.com/epg-sync/epgsync/internal/provider"
 	"github.com/epg-sync/epgsync/pkg/logger"
 )
 
-func (a *App) autoMapProviderChannels() error {
+func (a *App) autoMapProviderChannels(providers []provider.Provider) error {
 	ctx := context.Background()
 
-	providers := a.providerChain.GetProviders()
-
 	for _, provider := range providers {
 
 		channels := provider.ListChannels()
@@ -1,24 +1,55 @@
 package app
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/epg-sync/epgsync/internal/migration"
 	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
 	"github.com/epg-sync/epgsync/pkg/logger"
 	"github.com/epg-sync/epgsync/pkg/utils"
 	"github.com/glebarez/sqlite"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
+	"gorm.io/plugin/dbresolver"
 )
 
 func (app *App) initializeDatabase() error {
+	db, err := OpenDatabase(app.cfg.Database)
+	if err != nil {
+		return err
+	}
+	app.db = db
+
+	if err := migrateDatabase(app.db, app.cfg.Database); err != nil {
+		return err
+	}
+
+	if err := seedDefaultData(app.db, app.cfg.Server); err != nil {
+		logger.Error("Failed to seed default data", logger.Err(err))
+	}
+
+	logger.Debug("Database initialized successfully")
+
+	return nil
+}
+
+// OpenDatabase connects to the configured database and applies the pool
+// settings. It does not touch the schema.
+func OpenDatabase(databaseCfg config.DatabaseConfig) (*gorm.DB, error) {
 	logger.Debug("Initializing database...",
-		logger.String("driver", app.cfg.Database.Driver),
-		logger.String("host", app.cfg.Database.Host),
-		logger.Int("port", app.cfg.Database.Port),
+		logger.String("driver", databaseCfg.Driver),
+		logger.String("host", databaseCfg.Host),
+		logger.Int("port", databaseCfg.Port),
 	)
 	gormConfig := &gorm.Config{
 		NamingStrategy: schema.NamingStrategy{
@@ -26,9 +57,47 @@ func (app *App) initializeDatabase() error {
 		},
 	}
 
-	var dialector gorm.Dialector
-	databaseCfg := app.cfg.Database
+	dialector, err := openDialector(databaseCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := gorm.Open(dialector, gormConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
 
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database handle: %w", err)
+	}
+	if databaseCfg.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(databaseCfg.MaxOpenConns)
+	}
+	if databaseCfg.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(databaseCfg.MaxIdleConns)
+	}
+	if databaseCfg.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(databaseCfg.ConnMaxLifetime)
+	}
+	if databaseCfg.ConnMaxIdleTime > 0 {
+		sqlDB.SetConnMaxIdleTime(databaseCfg.ConnMaxIdleTime)
+	}
+
+	if len(databaseCfg.Replicas) > 0 {
+		if err := useReplicas(db, databaseCfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if databaseCfg.Debug {
+		db = db.Debug()
+	}
+
+	return db, nil
+}
+
+func openDialector(databaseCfg config.DatabaseConfig) (gorm.Dialector, error) {
 	switch databaseCfg.Driver {
 	case "mysql":
 		logger.Debug("Connecting to MySQL...",
@@ -37,7 +106,19 @@ func (app *App) initializeDatabase() error {
 
 		dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true&timeout=10s&readTimeout=10s&writeTimeout=10s",
 			databaseCfg.User, databaseCfg.Password, databaseCfg.Host, databaseCfg.Port, databaseCfg.Name)
-		dialector = mysql.Open(dsn)
+		if databaseCfg.StatementTimeout > 0 {
+			// unknown DSN parameters are set as session variables; MySQL
+			// only enforces this one on SELECT statements
+			dsn += fmt.Sprintf("&max_execution_time=%d", databaseCfg.StatementTimeout.Milliseconds())
+		}
+		return mysql.Open(dsn), nil
+	case "postgres":
+		logger.Debug("Connecting to PostgreSQL...",
+			logger.String("host", databaseCfg.Host),
+			logger.Int("port", databaseCfg.Port),
+			logger.String("ssl_mode", databaseCfg.SSLMode))
+
+		return postgres.Open(postgresDSN(databaseCfg)), nil
 	case "sqlite":
 		logger.Debug("Connecting to SQLite...",
 			logger.String("filepath", databaseCfg.Name))
@@ -49,58 +130,171 @@ func (app *App) initializeDatabase() error {
 		dir := filepath.Dir(dbPath)
 		if dir != "." && dir != "/" {
 			if err := os.MkdirAll(dir, 0755); err != nil {
-				return fmt.Errorf("failed to create database directory: %w", err)
+				return nil, fmt.Errorf("failed to create database directory: %w", err)
 			}
 		}
 		logger.Info("Connecting to SQLite...", logger.String("file", dbPath))
-		dialector = sqlite.Open(dbPath)
-	default:
-		return fmt.Errorf("unsupported database driver: %s", databaseCfg.Driver)
+		return sqlite.Open(dbPath), nil
 	}
+	return nil, fmt.Errorf("unsupported database driver: %s", databaseCfg.Driver)
 
-	db, err := gorm.Open(dialector, gormConfig)
+}
 
-	if err != nil {
-		return fmt.Errorf("failed to connect to database: %w", err)
+// useReplicas sends reads to the configured replicas through dbresolver.
+// Only reads from requests marked with repository.WithReplicaReads go there;
+// everything else, including the sync jobs and the admin API, keeps reading
+// from the primary so it sees its own writes.
+func useReplicas(db *gorm.DB, databaseCfg config.DatabaseConfig) error {
+	replicas := make([]gorm.Dialector, 0, len(databaseCfg.Replicas))
+	for _, replica := range databaseCfg.Replicas {
+		dialector, err := openDialector(databaseCfg.Replica(replica))
+		if err != nil {
+			return err
+		}
+		replicas = append(replicas, dialector)
 	}
 
-	if app.cfg.Database.Debug {
-		app.db = db.Debug()
-	} else {
-		app.db = db
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   dbresolver.RandomPolicy{},
+	})
+	if databaseCfg.MaxOpenConns > 0 {
+		resolver.SetMaxOpenConns(databaseCfg.MaxOpenConns)
+	}
+	if databaseCfg.MaxIdleConns > 0 {
+		resolver.SetMaxIdleConns(databaseCfg.MaxIdleConns)
+	}
+	if databaseCfg.ConnMaxLifetime > 0 {
+		resolver.SetConnMaxLifetime(databaseCfg.ConnMaxLifetime)
+	}
+	if databaseCfg.ConnMaxIdleTime > 0 {
+		resolver.SetConnMaxIdleTime(databaseCfg.ConnMaxIdleTime)
 	}
 
-	if err := seedDefaultData(app.db); err != nil {
-		logger.Error("Failed to seed default data", logger.Err(err))
+	if err := db.Use(resolver); err != nil {
+		return fmt.Errorf("failed to register read replicas: %w", err)
 	}
 
-	logger.Debug("Database initialized successfully")
+	// dbresolver picks a replica for every read unless the statement asks
+	// for the primary. Its callbacks are registered before "*" too, and a
+	// later registration is sorted ahead of an earlier one, so this has to
+	// come after db.Use.
+	preferPrimary := func(tx *gorm.DB) {
+		if !repository.ReplicaReadsAllowed(tx.Statement.Context) {
+			dbresolver.Write.ModifyStatement(tx.Statement)
+		}
+	}
+	callbacks := db.Callback()
+	if err := callbacks.Query().Before("*").Register("epg:prefer_primary", preferPrimary); err != nil {
+		return err
+	}
+	if err := callbacks.Row().Before("*").Register("epg:prefer_primary", preferPrimary); err != nil {
+		return err
+	}
+	if err := callbacks.Raw().Before("*").Register("epg:prefer_primary", preferPrimary); err != nil {
+		return err
+	}
 
+	logger.Info("Read replicas enabled", logger.Int("replicas", len(replicas)))
 	return nil
 }
 
-func seedDefaultData(db *gorm.DB) error {
+// migrateDatabase applies pending migrations when auto_migrate is on, and
+// otherwise only warns about them.
+func migrateDatabase(db *gorm.DB, databaseCfg config.DatabaseConfig) error {
+	migrator, err := migration.New(db, databaseCfg.Driver)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if !databaseCfg.AutoMigrate {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			logger.Warn("Database schema is behind, run the migrate up command or enable database.auto_migrate",
+				logger.Int("pending", len(pending)),
+				logger.Int64("latest", migrator.Latest()),
+			)
+		}
+		return nil
+	}
+
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate database: %w", err)
+	}
+	if applied > 0 {
+		logger.Info("Database migrated",
+			logger.Int("applied", applied),
+			logger.Int64("version", migrator.Latest()),
+		)
+	}
+	return nil
+}
+
+// postgresDSN builds a URL style DSN so that passwords and search paths with
+// spaces or special characters need no extra quoting.
+func postgresDSN(cfg config.DatabaseConfig) string {
+	query := url.Values{}
+	query.Set("sslmode", cfg.SSLMode)
+	if cfg.SearchPath != "" {
+		query.Set("search_path", cfg.SearchPath)
+	}
+	if cfg.Timezone != "" {
+		query.Set("TimeZone", cfg.Timezone)
+	}
+	if cfg.StatementTimeout > 0 {
+		query.Set("statement_timeout", strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10))
+	}
+
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		Path:     "/" + cfg.Name,
+		RawQuery: query.Encode(),
+	}
+	return dsn.String()
+}
+
+func seedDefaultData(db *gorm.DB, serverCfg config.ServerConfig) error {
 	var count int64
 	db.Model(&model.User{}).Count(&count)
 
 	if count == 0 {
 		logger.Info("No users found, creating default admin user...")
 
-		randomPassword, err := utils.GenerateRandomString(14)
+		password := serverCfg.AdminPassword
+		generated := password == ""
+		if generated {
+			randomPassword, err := utils.GenerateRandomString(14)
+			if err != nil {
+				return err
+			}
+			password = string(randomPassword)
+		}
+
+		hashedPassword, err := utils.HashPassword(password)
 		if err != nil {
 			return err
 		}
-		hashedPassword, _ := utils.HashPassword(string(randomPassword))
 		admin := model.User{
 			Username: "admin",
 			Password: hashedPassword,
-			Role:     "admin",
+			Role:     model.RoleAdmin,
 			IsActive: 1,
 		}
 		if err := db.Create(&admin).Error; err != nil {
 			return err
 		}
-		logger.Info(fmt.Sprintf("Default admin created. User: admin, Pass: %s", randomPassword))
+		if generated {
+			logger.Info(fmt.Sprintf("Default admin created. User: admin, Pass: %s", password))
+		} else {
+			logger.Info("Default admin created with the configured password. User: admin")
+		}
 	}
 	return nil
 }
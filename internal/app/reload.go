@@ -0,0 +1,165 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/epg-sync/epgsync/internal/provider"
+	"github.com/epg-sync/epgsync/internal/service"
+	"github.com/epg-sync/epgsync/pkg/logger"
+)
+
+// configWatchInterval is how often the config file is checked for changes.
+// Polling also notices files replaced through a symlink, as with Kubernetes
+// ConfigMaps, which file events miss.
+const configWatchInterval = 5 * time.Second
+
+// Reload reads the config file again and applies the keys listed in
+// config.Reloadable: providers are rebuilt, the log level changes and jobs
+// are rescheduled. An invalid file is rejected as a whole and the running
+// config stays. Other changed keys are logged and wait for a restart.
+func (app *App) Reload() error {
+	app.reloadMu.Lock()
+	defer app.reloadMu.Unlock()
+
+	current := app.services.Config.Current()
+	next, err := config.LoadConfig(current.Path())
+	if err != nil {
+		return err
+	}
+
+	// the effective config takes the reloadable keys from the file and
+	// keeps everything else as started
+	effective := *current
+	effective.Providers = next.Providers
+	effective.Scheduler = next.Scheduler
+	effective.Logger.Level = next.Logger.Level
+
+	applied := config.Changed(current, &effective)
+	var restartRequired []string
+	for _, path := range config.Changed(&effective, next) {
+		if !config.IsReloadable(path) {
+			restartRequired = append(restartRequired, path)
+		}
+	}
+
+	if len(applied) > 0 {
+		if err := app.applyConfig(current, &effective); err != nil {
+			return err
+		}
+	}
+	app.services.Config.Update(&effective, restartRequired)
+
+	if len(restartRequired) > 0 {
+		logger.Warn("Some config changes take effect only after a restart",
+			logger.Strings("keys", restartRequired))
+	}
+	logger.Info("Config reloaded", logger.Strings("applied", applied))
+	return nil
+}
+
+// applyConfig switches the running services over to the reloadable keys of
+// next. Title rules, providers and job specs are all prepared first, so a
+// reload that fails leaves the running services as they were.
+func (app *App) applyConfig(current, next *config.AppConfig) error {
+	providersChanged := !reflect.DeepEqual(current.Providers, next.Providers)
+	schedulerChanged := !reflect.DeepEqual(current.Scheduler, next.Scheduler)
+
+	var titleRules *service.ProviderTitleRules
+	var providers []provider.Provider
+	if providersChanged {
+		var err error
+		titleRules, err = service.CompileProviderTitleRules(next.Providers)
+		if err != nil {
+			return fmt.Errorf("provider title rules: %w", err)
+		}
+		providers, err = provider.GlobalFactory().BuildProviders(next.Providers)
+		if err != nil {
+			return fmt.Errorf("build providers: %w", err)
+		}
+	}
+
+	var schedule *service.Schedule
+	if schedulerChanged {
+		var err error
+		schedule, err = service.ParseSchedule(next.Scheduler)
+		if err != nil {
+			return fmt.Errorf("reschedule jobs: %w", err)
+		}
+	}
+
+	if providersChanged {
+		known := make(map[string]bool)
+		for _, p := range app.providerChain.GetProviders() {
+			known[p.GetID()] = true
+		}
+
+		app.services.Title.SetProviderRules(titleRules)
+		provider.GlobalFactory().SetProviders(providers)
+		app.providerChain.Replace(app.cache, providers...)
+
+		var added []provider.Provider
+		for _, p := range app.providerChain.GetProviders() {
+			if !known[p.GetID()] {
+				added = append(added, p)
+			}
+		}
+		if err := app.autoMapProviderChannels(added); err != nil {
+			logger.Warn("Failed to auto map channels of added providers", logger.Err(err))
+		}
+	}
+
+	if current.Logger.Level != next.Logger.Level {
+		logger.SetLevel(next.Logger.Level)
+	}
+
+	if schedulerChanged {
+		app.services.Scheduler.Reschedule(schedule)
+	}
+	return nil
+}
+
+// watchConfig reloads the config whenever the content of the file changes,
+// until Stop is called.
+func (app *App) watchConfig() {
+	path := app.cfg.Path()
+	last := fileHash(path)
+
+	ticker := time.NewTicker(configWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-app.done:
+			return
+		case <-ticker.C:
+		}
+
+		hash := fileHash(path)
+		if hash == nil || bytes.Equal(hash, last) {
+			// a missing file is usually being replaced; keep the old hash
+			// so the new file counts as a change
+			continue
+		}
+		last = hash
+
+		logger.Info("Config file changed, reloading", logger.String("path", path))
+		if err := app.Reload(); err != nil {
+			logger.Error("Failed to reload config, keeping the running config", logger.Err(err))
+		}
+	}
+}
+
+func fileHash(path string) []byte {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
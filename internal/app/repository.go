@@ -12,8 +12,17 @@ func (app *App) initializeRepositories() error {
 		Channel:         mysql.NewChannelRepository(app.db),
 		Program:         mysql.NewProgramRepository(app.db),
 		ChannelMappings: mysql.NewChannelMappingsRepository(app.db),
+		ChannelAlias:    mysql.NewChannelAliasRepository(app.db),
 		Timezone:        mysql.NewTimezoneRepository(app.db),
 		User:            mysql.NewUserRepository(app.db),
+		ProgramChange:   mysql.NewProgramChangeRepository(app.db),
+		Webhook:         mysql.NewWebhookRepository(app.db),
+		ScheduleReport:  mysql.NewScheduleReportRepository(app.db),
+		CategoryRule:    mysql.NewCategoryRuleRepository(app.db),
+		APIKey:          mysql.NewAPIKeyRepository(app.db),
+		RefreshToken:    mysql.NewRefreshTokenRepository(app.db),
+		AuditLog:        mysql.NewAuditLogRepository(app.db),
+		UserIdentity:    mysql.NewUserIdentityRepository(app.db),
 	}
 
 	return nil
@@ -8,14 +8,38 @@ import (
 func (app *App) initializeServices() error {
 	logger.Debug("Initializing services...")
 
+	webhookService := service.NewWebhookService(app.repos.Webhook)
+	changeFeedService := service.NewChangeFeedService(app.repos.ProgramChange, webhookService)
+	qualityService := service.NewQualityService(app.repos.ScheduleReport, app.cfg.Quality)
+	titleService, err := service.NewTitleService(app.repos.Program, app.cfg.Titles, app.cfg.Providers)
+	if err != nil {
+		return err
+	}
+	channelIndex := service.NewChannelIndex(app.repos.Channel, app.repos.ChannelAlias)
+	categoryService := service.NewCategoryService(app.repos.CategoryRule, channelIndex)
+	detailService := service.NewDetailService(app.repos.Program, app.cache, app.providerChain)
+	tokenService := service.NewTokenService(app.repos.RefreshToken, app.repos.User, app.cache, app.cfg.Server)
+	auditService := service.NewAuditService(app.repos.AuditLog)
+	loginGuard := service.NewLoginGuard(app.cache, app.cfg.Server.LoginLimit)
+
 	app.services = &Services{
-		EPG:            service.NewEPGService(app.repos.Program, app.repos.Channel, app.repos.ChannelMappings, app.cache, app.providerChain),
-		Channel:        service.NewChannelService(app.repos.Channel, app.repos.ChannelMappings, app.cache, app.providerChain),
-		ChannelMapping: service.NewChannelMappingService(app.repos.ChannelMappings, app.repos.Channel),
-		User:           service.NewUserService(app.repos.User, app.cfg.Server.JWTSecret),
+		EPG:            service.NewEPGService(app.repos.Program, app.repos.Channel, app.repos.ChannelMappings, app.cache, app.providerChain, changeFeedService, qualityService, titleService, categoryService, detailService, channelIndex, app.cfg.Mapping),
+		Channel:        service.NewChannelService(app.repos.Channel, app.repos.ChannelMappings, app.repos.ChannelAlias, channelIndex, app.cache, app.providerChain, auditService),
+		ChannelMapping: service.NewChannelMappingService(app.repos.ChannelMappings, app.repos.Channel, app.providerChain, channelIndex, app.cfg.Mapping, auditService),
+		User:           service.NewUserService(app.repos.User, tokenService, loginGuard, auditService),
+		ChangeFeed:     changeFeedService,
+		Webhook:        webhookService,
+		Quality:        qualityService,
+		Title:          titleService,
+		Category:       categoryService,
+		Token:          tokenService,
+		Audit:          auditService,
+		APIKey:         service.NewAPIKeyService(app.repos.APIKey, app.repos.User),
+		Config:         service.NewConfigService(app.cfg),
+		OIDC:           service.NewOIDCService(app.cfg.Server.OIDC, app.repos.User, app.repos.UserIdentity, tokenService, app.cache, auditService),
 	}
 
-	app.services.Scheduler = service.NewSchedulerService(app.services.EPG, app.services.Channel, app.services.ChannelMapping, app.providerChain, app.cache)
+	app.services.Scheduler = service.NewSchedulerService(app.services.EPG, app.services.Channel, app.services.ChannelMapping, app.providerChain, app.cache, app.cfg.Scheduler)
 
 	return nil
 }
@@ -2,13 +2,20 @@ package config
 
 import (
 	"fmt"
+	"maps"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/epg-sync/epgsync/internal/model"
 	"github.com/epg-sync/epgsync/pkg/logger"
+	"github.com/robfig/cron/v3"
 	"gopkg.in/yaml.v2"
 )
 
@@ -23,7 +30,15 @@ type AppConfig struct {
 	Providers []model.ProviderConfig `yaml:"providers"`
 	Database  DatabaseConfig         `yaml:"database"`
 	Scheduler SchedulerConfig        `yaml:"scheduler"`
+	Quality   QualityConfig          `yaml:"quality"`
+	Titles    TitleConfig            `yaml:"titles"`
+	Mapping   MappingConfig          `yaml:"mapping"`
+	API       APIConfig              `yaml:"api"`
 	Logger    logger.Config          `yaml:"logger"`
+
+	path     string            // absolute path of the config file
+	sources  map[string]string // keys set from the environment
+	fileKeys map[string]bool   // keys present in the config file
 }
 
 type ServerConfig struct {
@@ -32,7 +47,97 @@ type ServerConfig struct {
 	Mode           string `yaml:"mode"`
 	Timeout        int    `yaml:"timeout"`
 	JWTSecret      string `yaml:"jwt_secret"`
-	JWTExpireHours int    `yaml:"jwt_expire_hours"`
+	JWTExpireHours int    `yaml:"jwt_expire_hours"` // access token lifetime
+	// RefreshExpireHours is how long a login can be kept alive through
+	// refresh tokens without signing in again
+	RefreshExpireHours int `yaml:"refresh_expire_hours"`
+	// AdminPassword is used for the admin account created on first start,
+	// usually set through EPGSYNC_SERVER_ADMIN_PASSWORD(_FILE). Without it a
+	// random password is generated and logged once.
+	AdminPassword string `yaml:"admin_password"`
+
+	LoginLimit LoginLimitConfig `yaml:"login_limit"`
+	OIDC       OIDCConfig       `yaml:"oidc"`
+
+	CORS            CORSConfig            `yaml:"cors"`
+	SecurityHeaders SecurityHeadersConfig `yaml:"security_headers"`
+	// TrustedProxies lists the proxy addresses or CIDR ranges whose
+	// X-Forwarded-For and X-Real-IP headers are believed when working out the
+	// client IP. The default trusts only loopback, where the bundled nginx
+	// runs; an empty list trusts no proxy.
+	TrustedProxies []string `yaml:"trusted_proxies"`
+}
+
+// CORSConfig holds one CORS policy per route group. The admin panel served
+// by the bundled nginx is same-origin and needs none; the EPG outputs under
+// /api are open to any origin by default so that web players can load them.
+type CORSConfig struct {
+	Auth  CORSPolicy `yaml:"auth"`
+	Admin CORSPolicy `yaml:"admin"`
+	API   CORSPolicy `yaml:"api"`
+}
+
+type CORSPolicy struct {
+	// AllowedOrigins are exact origins such as https://epg.example.com, or
+	// "*" for any origin. Requests from other origins get no CORS headers.
+	AllowedOrigins   []string      `yaml:"allowed_origins"`
+	AllowedMethods   []string      `yaml:"allowed_methods"`
+	AllowedHeaders   []string      `yaml:"allowed_headers"`
+	ExposedHeaders   []string      `yaml:"exposed_headers"`
+	AllowCredentials bool          `yaml:"allow_credentials"` // not allowed together with "*"
+	MaxAge           time.Duration `yaml:"max_age"`           // how long browsers may cache a preflight
+}
+
+// SecurityHeadersConfig controls the security headers sent with every
+// response. X-Content-Type-Options: nosniff is always sent.
+type SecurityHeadersConfig struct {
+	FrameOptions   string `yaml:"frame_options"`   // DENY, SAMEORIGIN or off
+	ReferrerPolicy string `yaml:"referrer_policy"` // off leaves the header out
+	// HSTSMaxAge enables Strict-Transport-Security. Only turn it on when the
+	// service is reached over HTTPS, browsers remember it for that long.
+	HSTSMaxAge            time.Duration `yaml:"hsts_max_age"`
+	HSTSIncludeSubdomains bool          `yaml:"hsts_include_subdomains"`
+}
+
+// LoginLimitConfig slows down and then locks out password guessing. Failures
+// are counted per username and per client IP; each failure doubles the wait
+// before the next attempt, starting at BaseDelay and capped at MaxDelay.
+type LoginLimitConfig struct {
+	MaxAttemptsPerUser int           `yaml:"max_attempts_per_user"`
+	MaxAttemptsPerIP   int           `yaml:"max_attempts_per_ip"`
+	Window             time.Duration `yaml:"window"`  // failures older than this are forgotten
+	Lockout            time.Duration `yaml:"lockout"` // how long a username or IP stays locked
+	BaseDelay          time.Duration `yaml:"base_delay"`
+	MaxDelay           time.Duration `yaml:"max_delay"`
+}
+
+// OIDCConfig enables single sign-on through an OpenID Connect provider such
+// as Keycloak or Authentik, next to the local password login. Users are
+// created on their first login and get their role from RoleClaim on every
+// login.
+type OIDCConfig struct {
+	Enabled      bool   `yaml:"enabled"`
+	Issuer       string `yaml:"issuer"` // discovery is done at <issuer>/.well-known/openid-configuration
+	ClientID     string `yaml:"client_id"`
+	ClientSecret string `yaml:"client_secret"` // empty for a public client, PKCE is used either way
+	// RedirectURL is the callback registered at the provider, i.e.
+	// https://epg.example.com/auth/oidc/callback
+	RedirectURL string   `yaml:"redirect_url"`
+	Scopes      []string `yaml:"scopes"` // openid is always requested
+	// FrontendURL is the login page the browser is sent back to with a
+	// one-time ticket. Without it the callback answers with the tokens as
+	// JSON.
+	FrontendURL   string `yaml:"frontend_url"`
+	UsernameClaim string `yaml:"username_claim"`
+	// RoleClaim names the claim holding the groups or roles of the user, a
+	// string or a list of strings. Nested claims are written with dots, e.g.
+	// realm_access.roles for Keycloak realm roles.
+	RoleClaim string `yaml:"role_claim"`
+	// RoleMapping maps claim values to viewer, operator or admin; the most
+	// privileged match wins and DefaultRole applies when nothing matches.
+	// Without a default role such users cannot log in.
+	RoleMapping map[string]string `yaml:"role_mapping"`
+	DefaultRole string            `yaml:"default_role"`
 }
 
 type CacheConfig struct {
@@ -52,13 +157,117 @@ type DatabaseConfig struct {
 	Name     string `yaml:"name"`
 	Timezone string `yaml:"timezone"`
 	Debug    bool   `yaml:"debug"`
+
+	// AutoMigrate applies pending schema migrations at startup; without it
+	// they are only reported and have to be run with the migrate command
+	AutoMigrate bool `yaml:"auto_migrate"`
+
+	SSLMode    string `yaml:"ssl_mode"`    // postgres only: disable, require, verify-ca, verify-full
+	SearchPath string `yaml:"search_path"` // postgres only: schema(s) to use, e.g. "epg,public"
+
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+	// StatementTimeout cancels long running statements on the server side;
+	// mysql and postgres only, and mysql only limits SELECTs
+	StatementTimeout time.Duration `yaml:"statement_timeout"`
+
+	// Replicas serve the reads of the public /api endpoints; everything else
+	// uses the primary above
+	Replicas []DatabaseReplicaConfig `yaml:"replicas"`
+}
+
+// DatabaseReplicaConfig describes a read replica. Empty fields are taken
+// from the primary.
+type DatabaseReplicaConfig struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Name     string `yaml:"name"`
+}
+
+// Replica returns the connection settings for a replica, filling the gaps
+// from the primary.
+func (c DatabaseConfig) Replica(replica DatabaseReplicaConfig) DatabaseConfig {
+	cfg := c
+	cfg.Replicas = nil
+	cfg.Host = replica.Host
+	if replica.Port != 0 {
+		cfg.Port = replica.Port
+	}
+	if replica.User != "" {
+		cfg.User = replica.User
+	}
+	if replica.Password != "" {
+		cfg.Password = replica.Password
+	}
+	if replica.Name != "" {
+		cfg.Name = replica.Name
+	}
+	return cfg
 }
 
+// Scheduler job names, the keys of SchedulerConfig.Jobs.
+const (
+	JobSyncMidnight = "sync_epg_midnight" // syncs channels that have no schedule yet
+	JobSyncMorning  = "sync_epg_morning"  // refreshes every channel
+	JobCleanup      = "cleanup_old_epg"   // deletes old programs
+)
+
+// JobOff as the spec of a job disables it.
+const JobOff = "off"
+
+var defaultJobSpecs = map[string]string{
+	JobSyncMidnight: "1 0 * * *",
+	JobSyncMorning:  "0 8 * * *",
+	JobCleanup:      "0 4 * * *",
+}
+
+// SchedulerConfig sets when the background jobs run, as five field cron
+// specs by job name. Jobs left out keep their default schedule.
 type SchedulerConfig struct {
-	Enabled    bool   `yaml:"enabled"`
-	UpdateCron string `yaml:"update_cron"`
+	Jobs map[string]string `yaml:"jobs"`
 }
 
+type QualityConfig struct {
+	Enabled      bool          `yaml:"enabled"`
+	AutoRepair   bool          `yaml:"auto_repair"`
+	MinDuration  time.Duration `yaml:"min_duration"`
+	MaxDuration  time.Duration `yaml:"max_duration"`
+	GapTolerance time.Duration `yaml:"gap_tolerance"`
+	MinCoverage  float64       `yaml:"min_coverage"`
+}
+
+type MappingConfig struct {
+	VerifiedOnly bool    `yaml:"verified_only"` // sync only mappings approved by an admin
+	MinScore     float64 `yaml:"min_score"`     // automatic mappings need at least this score
+	Candidates   int     `yaml:"candidates"`    // candidates shown per review item
+}
+
+// APIConfig controls access to the public outputs under /api.
+type APIConfig struct {
+	XMLTV OutputConfig `yaml:"xmltv"`
+	DIYP  OutputConfig `yaml:"diyp"`
+}
+
+type OutputConfig struct {
+	// RequireKey rejects requests without an API key holding the epg:read
+	// scope, passed in the X-API-Key header or the token query parameter
+	RequireKey bool `yaml:"require_key"`
+}
+
+// TitleConfig holds the global title normalization chain. It runs after the
+// provider specific title_rules of the provider that produced the program.
+// Enabled switches the global chain only; title_rules always apply.
+type TitleConfig struct {
+	Enabled bool              `yaml:"enabled"`
+	Rules   []model.TitleRule `yaml:"rules"`
+}
+
+// LoadConfig loads the file named by CONFIG_PATH, the given path or
+// config/config.yaml, in that order.
 func LoadConfig(configPath ...string) (*AppConfig, error) {
 	var path string
 	if envPath := os.Getenv("CONFIG_PATH"); envPath != "" {
@@ -68,7 +277,13 @@ func LoadConfig(configPath ...string) (*AppConfig, error) {
 	} else {
 		path = "config/config.yaml"
 	}
+	return LoadFile(path)
+}
 
+// LoadFile loads and validates the config at path with the EPGSYNC_
+// environment overrides applied. Unknown keys, values of the wrong type and
+// invalid settings are all reported together in a *ValidationError.
+func LoadFile(path string) (*AppConfig, error) {
 	absPath, err := filepath.Abs(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve config path: %w", err)
@@ -79,14 +294,34 @@ func LoadConfig(configPath ...string) (*AppConfig, error) {
 		return nil, fmt.Errorf("failed to read config file %s: %w", absPath, err)
 	}
 
-	var config AppConfig
-	if err := yaml.Unmarshal(data, &config); err != nil {
+	var document any
+	if err := yaml.Unmarshal(data, &document); err != nil {
 		return nil, fmt.Errorf("failed to parse config file: %w", err)
 	}
+	var found problems
+	checkDocument(document, reflect.TypeOf(AppConfig{}), "", &found)
+
+	// strict decoding also catches duplicate keys; with problems already
+	// found it only fills what it can so validation can report the rest
+	var config AppConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		if len(found) == 0 {
+			return nil, fmt.Errorf("failed to parse config file: %w", err)
+		}
+		decodeSections(document, &config)
+	}
+	config.path = absPath
+	config.fileKeys = make(map[string]bool)
+	yamlKeys(document, "", config.fileKeys)
+
+	if config.sources, err = applyEnv(&config, os.Environ()); err != nil {
+		return nil, fmt.Errorf("invalid environment override: %w", err)
+	}
 
 	config.setDefaults()
 
-	if err := config.Validate(); err != nil {
+	config.validate(&found)
+	if err := found.err(&config); err != nil {
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
@@ -113,6 +348,11 @@ func MustLoad(configPath ...string) *AppConfig {
 	return config
 }
 
+// Path returns the absolute path of the file the config was loaded from.
+func (c *AppConfig) Path() string {
+	return c.path
+}
+
 func (c *AppConfig) setDefaults() {
 	if c.Server.Port == 0 {
 		c.Server.Port = 8080
@@ -133,62 +373,306 @@ func (c *AppConfig) setDefaults() {
 		c.Logger.Output = "stdout"
 	}
 	if c.Server.JWTExpireHours == 0 {
-		c.Server.JWTExpireHours = 24
+		c.Server.JWTExpireHours = 1
+	}
+	if c.Server.RefreshExpireHours == 0 {
+		c.Server.RefreshExpireHours = 24 * 30
+	}
+	if c.Server.LoginLimit.MaxAttemptsPerUser == 0 {
+		c.Server.LoginLimit.MaxAttemptsPerUser = 5
+	}
+	if c.Server.LoginLimit.MaxAttemptsPerIP == 0 {
+		c.Server.LoginLimit.MaxAttemptsPerIP = 20
+	}
+	if c.Server.LoginLimit.Window == 0 {
+		c.Server.LoginLimit.Window = 15 * time.Minute
+	}
+	if c.Server.LoginLimit.Lockout == 0 {
+		c.Server.LoginLimit.Lockout = 15 * time.Minute
+	}
+	if c.Server.LoginLimit.BaseDelay == 0 {
+		c.Server.LoginLimit.BaseDelay = time.Second
+	}
+	if c.Server.LoginLimit.MaxDelay == 0 {
+		c.Server.LoginLimit.MaxDelay = 30 * time.Second
+	}
+	c.Server.CORS.Auth.setDefaults(nil)
+	c.Server.CORS.Admin.setDefaults(nil)
+	c.Server.CORS.API.setDefaults([]string{"*"})
+	if c.Server.SecurityHeaders.FrameOptions == "" {
+		c.Server.SecurityHeaders.FrameOptions = "DENY"
+	}
+	if c.Server.SecurityHeaders.ReferrerPolicy == "" {
+		c.Server.SecurityHeaders.ReferrerPolicy = "no-referrer"
+	}
+	if c.Server.TrustedProxies == nil {
+		c.Server.TrustedProxies = []string{"127.0.0.1", "::1"}
+	}
+	if c.Server.OIDC.UsernameClaim == "" {
+		c.Server.OIDC.UsernameClaim = "preferred_username"
+	}
+	if len(c.Server.OIDC.Scopes) == 0 {
+		c.Server.OIDC.Scopes = []string{"profile", "email"}
+	}
+	if c.Quality.MinDuration == 0 {
+		c.Quality.MinDuration = time.Minute
+	}
+	if c.Quality.MaxDuration == 0 {
+		c.Quality.MaxDuration = 8 * time.Hour
+	}
+	if c.Quality.GapTolerance == 0 {
+		c.Quality.GapTolerance = time.Minute
+	}
+	if c.Quality.MinCoverage == 0 {
+		c.Quality.MinCoverage = 0.8
+	}
+	if c.Mapping.MinScore == 0 {
+		c.Mapping.MinScore = 0.8
+	}
+	if c.Mapping.Candidates == 0 {
+		c.Mapping.Candidates = 5
+	}
+	if c.Scheduler.Jobs == nil {
+		c.Scheduler.Jobs = make(map[string]string, len(defaultJobSpecs))
+	}
+	for name, spec := range defaultJobSpecs {
+		if c.Scheduler.Jobs[name] == "" {
+			c.Scheduler.Jobs[name] = spec
+		}
+	}
+	if c.Database.Driver == "postgres" {
+		if c.Database.Port == 0 {
+			c.Database.Port = 5432
+		}
+		if c.Database.SSLMode == "" {
+			c.Database.SSLMode = "disable"
+		}
 	}
 }
 
+// Validate checks the config and reports every problem it finds in one
+// *ValidationError, each naming the key and where its value came from.
 func (c *AppConfig) Validate() error {
+	var p problems
+	c.validate(&p)
+	return p.err(c)
+}
 
+func (c *AppConfig) validate(p *problems) {
 	if c.Server.Port < 1 || c.Server.Port > 65535 {
-		return fmt.Errorf("invalid server port: %d", c.Server.Port)
+		p.addf("server.port", "invalid server port: %d", c.Server.Port)
+	}
+	c.validateJWTSecret(p)
+	if c.Server.AdminPassword != "" && len(c.Server.AdminPassword) < 6 {
+		p.addf("server.admin_password", "server admin_password must be at least 6 characters")
+	}
+	if c.Server.JWTExpireHours < 0 || c.Server.RefreshExpireHours < c.Server.JWTExpireHours {
+		p.addf("server.refresh_expire_hours", "server refresh_expire_hours must be at least jwt_expire_hours")
+	}
+	if limit := c.Server.LoginLimit; limit.MaxAttemptsPerUser < 0 || limit.MaxAttemptsPerIP < 0 ||
+		limit.Window < 0 || limit.Lockout < 0 || limit.BaseDelay < 0 || limit.MaxDelay < limit.BaseDelay {
+		p.addf("server.login_limit", "server login_limit values must be positive and max_delay at least base_delay")
+	}
+
+	c.Server.OIDC.validate(p)
+	c.Server.CORS.Auth.validate("server.cors.auth", p)
+	c.Server.CORS.Admin.validate("server.cors.admin", p)
+	c.Server.CORS.API.validate("server.cors.api", p)
+	switch strings.ToUpper(c.Server.SecurityHeaders.FrameOptions) {
+	case "DENY", "SAMEORIGIN", "OFF":
+	default:
+		p.addf("server.security_headers.frame_options", "server security_headers frame_options must be DENY, SAMEORIGIN or off: %s", c.Server.SecurityHeaders.FrameOptions)
+	}
+	if c.Server.SecurityHeaders.HSTSMaxAge < 0 {
+		p.addf("server.security_headers.hsts_max_age", "server security_headers hsts_max_age must not be negative")
+	}
+	for _, proxy := range c.Server.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				p.addf("server.trusted_proxies", "%q is not an IP address or CIDR range", proxy)
+			}
+		}
 	}
 
 	if c.Cache.Type != "" && c.Cache.Type != "memory" && c.Cache.Type != "redis" {
-		return fmt.Errorf("unsupported cache type: %s", c.Cache.Type)
+		p.addf("cache.type", "unsupported cache type: %s", c.Cache.Type)
 	}
 	if c.Cache.Type == "redis" && c.Cache.Addr == "" {
-		return fmt.Errorf("redis address is required when cache type is redis")
+		p.addf("cache.addr", "redis address is required when cache type is redis")
 	}
 	if _, err := time.ParseDuration(c.Cache.TTL); err != nil {
-		return fmt.Errorf("invalid cache TTL: %s", c.Cache.TTL)
+		p.addf("cache.ttl", "invalid cache TTL: %s", c.Cache.TTL)
 	}
 
-	if c.Database.Driver == "" {
-		return fmt.Errorf("database driver is required")
+	if c.Quality.MinDuration >= c.Quality.MaxDuration {
+		p.addf("quality.min_duration", "quality min_duration must be less than max_duration")
+	}
+	if c.Quality.MinCoverage < 0 || c.Quality.MinCoverage > 1 {
+		p.addf("quality.min_coverage", "quality min_coverage must be between 0 and 1: %v", c.Quality.MinCoverage)
+	}
+
+	if c.Mapping.MinScore < 0 {
+		p.addf("mapping.min_score", "mapping min_score must not be negative: %v", c.Mapping.MinScore)
+	}
+	if c.Mapping.Candidates < 0 {
+		p.addf("mapping.candidates", "mapping candidates must not be negative: %d", c.Mapping.Candidates)
 	}
+
+	for _, name := range slices.Sorted(maps.Keys(c.Scheduler.Jobs)) {
+		spec := c.Scheduler.Jobs[name]
+		if _, ok := defaultJobSpecs[name]; !ok {
+			p.addf("scheduler.jobs."+name, "unknown scheduler job: %s", name)
+		}
+		if spec == JobOff {
+			continue
+		}
+		if _, err := cron.ParseStandard(spec); err != nil {
+			p.addf("scheduler.jobs."+name, "invalid cron spec %q: %v", spec, err)
+		}
+	}
+
+	switch c.Logger.Level {
+	case "", "debug", "info", "warn", "error":
+	default:
+		p.addf("logger.level", "unsupported log level: %s", c.Logger.Level)
+	}
+
+	validateTitleRules("titles.rules", c.Titles.Rules, p)
+	c.validateProviders(p)
+
 	switch c.Database.Driver {
+	case "":
+		p.addf("database.driver", "database driver is required")
 	case "mysql":
 		if c.Database.Host == "" {
-			return fmt.Errorf("database host is required")
+			p.addf("database.host", "database host is required")
 		}
 		if c.Database.Port == 0 {
-			return fmt.Errorf("database port is required")
+			p.addf("database.port", "database port is required")
 		}
 		if c.Database.User == "" {
-			return fmt.Errorf("database user is required")
+			p.addf("database.user", "database user is required")
 		}
 		if c.Database.Password == "" {
-			return fmt.Errorf("database password is required")
+			p.addf("database.password", "database password is required")
+		}
+	case "postgres":
+		if c.Database.Host == "" {
+			p.addf("database.host", "database host is required")
+		}
+		if c.Database.User == "" {
+			p.addf("database.user", "database user is required")
+		}
+		if c.Database.Name == "" {
+			p.addf("database.name", "database name is required for postgres")
+		}
+		switch c.Database.SSLMode {
+		case "disable", "allow", "prefer", "require", "verify-ca", "verify-full":
+		default:
+			p.addf("database.ssl_mode", "unsupported database ssl_mode: %s", c.Database.SSLMode)
 		}
 	case "sqlite":
 		if c.Database.Name == "" {
-			return fmt.Errorf("database name (filepath) is required for sqlite")
+			p.addf("database.name", "database name (filepath) is required for sqlite")
 		}
 	default:
-		return fmt.Errorf("unsupported database driver: %s", c.Database.Driver)
+		p.addf("database.driver", "unsupported database driver: %s", c.Database.Driver)
 	}
-	if c.Database.Host == "" {
-		return fmt.Errorf("database host is required")
+	if c.Database.MaxOpenConns < 0 || c.Database.MaxIdleConns < 0 ||
+		c.Database.ConnMaxLifetime < 0 || c.Database.ConnMaxIdleTime < 0 {
+		p.addf("database", "database pool settings must not be negative")
 	}
-	if c.Database.Port == 0 {
-		return fmt.Errorf("database port is required")
+	if c.Database.StatementTimeout < 0 {
+		p.addf("database.statement_timeout", "database statement_timeout must not be negative")
 	}
-	if c.Database.User == "" {
-		return fmt.Errorf("database user is required")
+	if len(c.Database.Replicas) > 0 && c.Database.Driver == "sqlite" {
+		p.addf("database.replicas", "database replicas are not supported for sqlite")
+	}
+	for i, replica := range c.Database.Replicas {
+		if replica.Host == "" {
+			p.addf(fmt.Sprintf("database.replicas[%d].host", i), "database replica host is required")
+		}
+	}
+}
+
+// InsecureJWTSecret reports whether the JWT secret is empty or the one from
+// the example config. Only release mode refuses to start with it.
+func (c *AppConfig) InsecureJWTSecret() bool {
+	return c.Server.JWTSecret == "" || c.Server.JWTSecret == DefaultJWTSecret
+}
+
+// validateJWTSecret refuses to run a release build with no JWT secret or with
+// the one from the example config, since anyone could sign tokens with it.
+func (c *AppConfig) validateJWTSecret(p *problems) {
+	if !c.InsecureJWTSecret() || c.Server.Mode != "release" {
+		return
 	}
-	if c.Database.Password == "" {
-		return fmt.Errorf("database password is required")
+	if c.Server.JWTSecret == "" {
+		p.addf("server.jwt_secret", "server jwt_secret is required in release mode, set a random value, e.g. through %s_FILE", envName([]string{"server", "jwt_secret"}))
+		return
 	}
+	p.addf("server.jwt_secret", "server jwt_secret is the published default, set a random value, e.g. through %s_FILE", envName([]string{"server", "jwt_secret"}))
+}
 
-	return nil
+func (p *CORSPolicy) setDefaults(origins []string) {
+	if p.AllowedOrigins == nil {
+		p.AllowedOrigins = origins
+	}
+	if len(p.AllowedMethods) == 0 {
+		p.AllowedMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+	}
+	if len(p.AllowedHeaders) == 0 {
+		p.AllowedHeaders = []string{"Authorization", "Content-Type", "X-API-Key", "X-Requested-With", "Cache-Control"}
+	}
+	if p.MaxAge == 0 {
+		p.MaxAge = 10 * time.Minute
+	}
+}
+
+func (c CORSPolicy) validate(path string, p *problems) {
+	for _, origin := range c.AllowedOrigins {
+		if origin == "*" {
+			if c.AllowCredentials {
+				p.addf(path+".allow_credentials", "allow_credentials cannot be combined with the \"*\" origin")
+			}
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.Path != "" || u.RawQuery != "" {
+			p.addf(path+".allowed_origins", "origin %q must look like https://host[:port]", origin)
+		}
+	}
+	if c.MaxAge < 0 {
+		p.addf(path+".max_age", "max_age must not be negative")
+	}
+}
+
+func (c OIDCConfig) validate(p *problems) {
+	if !c.Enabled {
+		return
+	}
+	if c.Issuer == "" || c.ClientID == "" || c.RedirectURL == "" {
+		p.addf("server.oidc", "server oidc needs issuer, client_id and redirect_url")
+	}
+	if c.RoleClaim == "" && c.DefaultRole == "" {
+		p.addf("server.oidc.role_claim", "server oidc needs a role_claim or a default_role")
+	}
+	if c.DefaultRole != "" && !model.IsValidRole(c.DefaultRole) {
+		p.addf("server.oidc.default_role", "server oidc default_role must be one of viewer, operator, admin: %s", c.DefaultRole)
+	}
+	for _, value := range slices.Sorted(maps.Keys(c.RoleMapping)) {
+		role := c.RoleMapping[value]
+		if !model.IsValidRole(role) {
+			p.addf("server.oidc.role_mapping."+value, "role must be one of viewer, operator, admin: %s", role)
+		}
+	}
+}
+
+func validateTitleRules(path string, rules []model.TitleRule, p *problems) {
+	for i, rule := range rules {
+		if _, err := rule.Compile(); err != nil {
+			ruleErr := err.(*model.TitleRuleError)
+			p.addf(fmt.Sprintf("%s[%d].%s", path, i, ruleErr.Field), "%s", ruleErr.Reason)
+		}
+	}
 }
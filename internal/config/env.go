@@ -0,0 +1,316 @@
+package config
+
+import (
+	"encoding"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvPrefix starts the environment variable of every config key. The rest
+// is the YAML path in upper case joined with underscores, so database.password
+// is EPGSYNC_DATABASE_PASSWORD and providers[0].timeout is
+// EPGSYNC_PROVIDERS_0_TIMEOUT. Appending _FILE reads the value from a file
+// instead, as with Docker secrets.
+const EnvPrefix = "EPGSYNC"
+
+// DefaultJWTSecret is the jwt_secret of the example config. It is public, so
+// release mode refuses to start with it.
+const DefaultJWTSecret = "a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6"
+
+const envFileSuffix = "_FILE"
+
+const (
+	sourceFile    = "config file"
+	sourceDefault = "default"
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// fieldError is a validation error about the value at a YAML path.
+type fieldError struct {
+	path    string
+	message string
+	source  string
+}
+
+func fieldErrorf(path, format string, args ...any) *fieldError {
+	return &fieldError{path: path, message: fmt.Sprintf(format, args...)}
+}
+
+func (e *fieldError) Error() string {
+	if e.source == "" {
+		return e.path + ": " + e.message
+	}
+	return fmt.Sprintf("%s: %s (from %s)", e.path, e.message, e.source)
+}
+
+// Source tells where the value at a YAML path came from: an environment
+// variable, the config file or the built-in default. A path that is not a
+// single key, such as a whole section, reports the config file.
+func (c *AppConfig) Source(path string) string {
+	if source, ok := c.sources[path]; ok {
+		return source
+	}
+	if c.fileKeys == nil || c.fileKeys[path] || !c.isKey(path) {
+		return sourceFile
+	}
+	return sourceDefault
+}
+
+// isKey reports whether path names a single value rather than a section.
+func (c *AppConfig) isKey(path string) bool {
+	for key := range c.fileKeys {
+		if strings.HasPrefix(key, path+".") || strings.HasPrefix(key, path+"[") {
+			return false
+		}
+	}
+	return true
+}
+
+// EnvOverrides lists the paths of the keys set from the environment.
+func (c *AppConfig) EnvOverrides() []string {
+	paths := make([]string, 0, len(c.sources))
+	for path := range c.sources {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func envName(path []string) string {
+	return EnvPrefix + "_" + strings.ToUpper(strings.Join(path, "_"))
+}
+
+// applyEnv overrides the config with the EPGSYNC_ environment variables and
+// returns the source of every key it set. Lists of sections, such as
+// providers, can only be changed for the entries the file has; maps take
+// one variable per entry, e.g. EPGSYNC_PROVIDERS_0_SETTINGS_TOKEN.
+func applyEnv(cfg *AppConfig, environ []string) (map[string]string, error) {
+	env := make(map[string]string, len(environ))
+	for _, kv := range environ {
+		if name, value, ok := strings.Cut(kv, "="); ok && strings.HasPrefix(name, EnvPrefix+"_") {
+			env[name] = value
+		}
+	}
+
+	sources := make(map[string]string)
+	if len(env) == 0 {
+		return sources, nil
+	}
+	err := applyEnvValue(reflect.ValueOf(cfg).Elem(), nil, "", env, sources)
+	return sources, err
+}
+
+func applyEnvValue(v reflect.Value, names []string, path string, env, sources map[string]string) error {
+	if isLeaf(v.Type()) {
+		value, source, ok, err := lookupEnv(env, envName(names))
+		if err != nil || !ok {
+			return err
+		}
+		if err := setValue(v, value); err != nil {
+			return fmt.Errorf("%s from %s: %w", path, source, err)
+		}
+		sources[path] = source
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name := yamlName(t.Field(i))
+			if name == "" {
+				continue
+			}
+			fieldPath := name
+			if path != "" {
+				fieldPath = path + "." + name
+			}
+			if err := applyEnvValue(v.Field(i), append(names[:len(names):len(names)], name), fieldPath, env, sources); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			index := strconv.Itoa(i)
+			if err := applyEnvValue(v.Index(i), append(names[:len(names):len(names)], index), fmt.Sprintf("%s[%d]", path, i), env, sources); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		return applyEnvMap(v, envName(names), path, env, sources)
+	}
+	return nil
+}
+
+// applyEnvMap sets one entry per variable below prefix. The rest of the
+// variable name, in lower case, is the key.
+func applyEnvMap(v reflect.Value, prefix, path string, env, sources map[string]string) error {
+	if v.Type().Key().Kind() != reflect.String || !isLeaf(v.Type().Elem()) {
+		return nil
+	}
+
+	keys := make(map[string]bool)
+	for name := range env {
+		if key, ok := strings.CutPrefix(name, prefix+"_"); ok && key != "" {
+			keys[strings.ToLower(strings.TrimSuffix(key, envFileSuffix))] = true
+		}
+	}
+	for key := range keys {
+		value, source, ok, err := lookupEnv(env, prefix+"_"+strings.ToUpper(key))
+		if err != nil || !ok {
+			return err
+		}
+		elem := reflect.New(v.Type().Elem()).Elem()
+		if err := setValue(elem, value); err != nil {
+			return fmt.Errorf("%s.%s from %s: %w", path, key, source, err)
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		v.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem)
+		sources[path+"."+key] = source
+	}
+	return nil
+}
+
+// lookupEnv reads name, or the file named by name_FILE. Setting both is an
+// error rather than a silent choice.
+func lookupEnv(env map[string]string, name string) (value, source string, ok bool, err error) {
+	value, plain := env[name]
+	file, fromFile := env[name+envFileSuffix]
+	switch {
+	case plain && fromFile:
+		return "", "", false, fmt.Errorf("both %s and %s%s are set", name, name, envFileSuffix)
+	case plain:
+		return value, "environment variable " + name, true, nil
+	case fromFile:
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", "", false, fmt.Errorf("%s%s: %w", name, envFileSuffix, err)
+		}
+		// secrets files usually end with a newline that is not part of the value
+		return strings.TrimRight(string(data), "\r\n"), fmt.Sprintf("file %s (%s%s)", file, name, envFileSuffix), true, nil
+	}
+	return "", "", false, nil
+}
+
+func yamlName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
+
+func isLeaf(t reflect.Type) bool {
+	if t == durationType || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map:
+		return false
+	case reflect.Slice:
+		return isLeaf(t.Elem()) && t.Elem().Kind() != reflect.Slice
+	}
+	return true
+}
+
+// setValue parses s into v. Lists are comma separated.
+func setValue(v reflect.Value, s string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+	if v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(s))
+	case reflect.Slice:
+		var parts []string
+		for _, part := range strings.Split(s, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				parts = append(parts, part)
+			}
+		}
+		list := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := setValue(list.Index(i), part); err != nil {
+				return err
+			}
+		}
+		v.Set(list)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// yamlKeys lists the paths of the keys present in a YAML document, in the
+// form Source takes.
+func yamlKeys(node any, path string, keys map[string]bool) {
+	switch n := node.(type) {
+	case map[any]any:
+		for k, child := range n {
+			childPath := fmt.Sprint(k)
+			if path != "" {
+				childPath = path + "." + childPath
+			}
+			keys[childPath] = true
+			yamlKeys(child, childPath, keys)
+		}
+	case []any:
+		for i, child := range n {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			keys[childPath] = true
+			yamlKeys(child, childPath, keys)
+		}
+	}
+}
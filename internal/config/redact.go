@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Redacted replaces non-empty secret values.
+const Redacted = "[redacted]"
+
+// secretKeyParts mark a key as secret when its name contains one of them,
+// which also covers provider settings such as api_token.
+var secretKeyParts = []string{"password", "secret", "token", "api_key"}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range secretKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactedMap returns the config as a tree of YAML keys with passwords,
+// secrets and tokens replaced, fit to be shown in the admin UI.
+func (c *AppConfig) RedactedMap() (map[string]any, error) {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var document map[any]any
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return redactMap(document), nil
+}
+
+// redactMap also turns the map[any]any of yaml.v2 into maps that encode to
+// JSON.
+func redactMap(m map[any]any) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		key := fmt.Sprint(k)
+		if isSecretKey(key) && isSet(v) {
+			out[key] = Redacted
+			continue
+		}
+		out[key] = redactValue(v)
+	}
+	return out
+}
+
+// isSet reports whether v is a non-empty single value.
+func isSet(v any) bool {
+	switch v := v.(type) {
+	case nil, map[any]any, []any:
+		return false
+	case string:
+		return v != ""
+	}
+	return true
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[any]any:
+		return redactMap(v)
+	case []any:
+		list := make([]any, len(v))
+		for i, item := range v {
+			list[i] = redactValue(item)
+		}
+		return list
+	}
+	return v
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Reloadable lists the keys a running server picks up when the config file
+// changes or on SIGHUP. Everything else needs a restart.
+var Reloadable = []string{"providers", "scheduler", "logger.level"}
+
+// IsReloadable reports whether the key at path is, or lies below, one of
+// the Reloadable keys.
+func IsReloadable(path string) bool {
+	for _, key := range Reloadable {
+		if path == key || strings.HasPrefix(path, key+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Changed lists the YAML paths whose values differ between two configs.
+// Sections are compared key by key; lists and maps are reported as a whole.
+func Changed(before, after *AppConfig) []string {
+	var paths []string
+	changedValues(reflect.ValueOf(before).Elem(), reflect.ValueOf(after).Elem(), "", &paths)
+	return paths
+}
+
+func changedValues(before, after reflect.Value, path string, paths *[]string) {
+	if before.Kind() != reflect.Struct || isLeaf(before.Type()) {
+		if !reflect.DeepEqual(before.Interface(), after.Interface()) {
+			*paths = append(*paths, path)
+		}
+		return
+	}
+
+	t := before.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := yamlName(t.Field(i))
+		if name == "" {
+			continue
+		}
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+		changedValues(before.Field(i), after.Field(i), fieldPath, paths)
+	}
+}
@@ -0,0 +1,245 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/provider"
+	apperrors "github.com/epg-sync/epgsync/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// ValidationError lists every problem found in a config, each naming the
+// YAML path of the key it is about.
+type ValidationError struct {
+	Problems []error
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Problems) == 1 {
+		return e.Problems[0].Error()
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d problems:", len(e.Problems))
+	for _, problem := range e.Problems {
+		b.WriteString("\n  - ")
+		b.WriteString(problem.Error())
+	}
+	return b.String()
+}
+
+// problems collects validation errors so they can be reported together.
+type problems []*fieldError
+
+func (p *problems) addf(path, format string, args ...any) {
+	*p = append(*p, fieldErrorf(path, format, args...))
+}
+
+// err returns the collected problems, with the source of each value, as a
+// *ValidationError, or nil when there are none.
+func (p problems) err(c *AppConfig) error {
+	if len(p) == 0 {
+		return nil
+	}
+	errs := make([]error, len(p))
+	for i, problem := range p {
+		problem.source = c.Source(problem.path)
+		errs[i] = problem
+	}
+	return &ValidationError{Problems: errs}
+}
+
+// checkDocument compares a parsed YAML document with the type it is decoded
+// into and reports keys that no field takes and values that do not fit
+// their field, which yaml.v2 would otherwise drop or only report by line.
+func checkDocument(node any, t reflect.Type, path string, p *problems) {
+	if node == nil {
+		return
+	}
+	if isLeaf(t) {
+		checkValue(node, t, path, p)
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := node.(map[any]any)
+		if !ok {
+			p.addf(path, "expected a section of keys, got %v", node)
+			return
+		}
+		fields := make(map[string]reflect.Type, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			if name := yamlName(t.Field(i)); name != "" {
+				fields[name] = t.Field(i).Type
+			}
+		}
+		for _, entry := range sortedEntries(m) {
+			childPath := joinPath(path, entry.key)
+			fieldType, ok := fields[entry.key]
+			if !ok {
+				p.addf(childPath, "unknown key")
+				continue
+			}
+			checkDocument(entry.value, fieldType, childPath, p)
+		}
+	case reflect.Slice:
+		list, ok := node.([]any)
+		if !ok {
+			p.addf(path, "expected a list, got %v", node)
+			return
+		}
+		for i, item := range list {
+			checkDocument(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i), p)
+		}
+	case reflect.Map:
+		m, ok := node.(map[any]any)
+		if !ok {
+			p.addf(path, "expected a map, got %v", node)
+			return
+		}
+		for _, entry := range sortedEntries(m) {
+			checkDocument(entry.value, t.Elem(), joinPath(path, entry.key), p)
+		}
+	}
+}
+
+type yamlEntry struct {
+	key   string
+	value any
+}
+
+// sortedEntries returns the entries of a YAML map by key, so problems come
+// out in a stable order.
+func sortedEntries(m map[any]any) []yamlEntry {
+	entries := make([]yamlEntry, 0, len(m))
+	for k, v := range m {
+		entries = append(entries, yamlEntry{key: fmt.Sprint(k), value: v})
+	}
+	slices.SortFunc(entries, func(a, b yamlEntry) int {
+		return strings.Compare(a.key, b.key)
+	})
+	return entries
+}
+
+// checkValue decodes a single value on its own to see whether it fits t.
+func checkValue(node any, t reflect.Type, path string, p *problems) {
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return
+	}
+	if err := yaml.Unmarshal(data, reflect.New(t).Interface()); err != nil {
+		p.addf(path, "expected %s, got %v", describeType(t, err), node)
+	}
+}
+
+func describeType(t reflect.Type, err error) string {
+	if t == durationType {
+		return "a duration such as 30s or 1h"
+	}
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		// text unmarshalers explain themselves
+		return fmt.Sprintf("a valid %s (%v)", t.Name(), err)
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "true or false"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "a whole number"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.String:
+		return "a string"
+	case reflect.Slice:
+		return "a list"
+	}
+	return "a " + t.String()
+}
+
+// decodeSections decodes each top-level section on its own. yaml.v2 stops at
+// the first value it cannot unmarshal, and this way the sections after it are
+// still filled in and validated.
+func decodeSections(document any, config *AppConfig) {
+	sections, ok := document.(map[any]any)
+	if !ok {
+		return
+	}
+	v := reflect.ValueOf(config).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		section, ok := sections[yamlName(v.Type().Field(i))]
+		if !ok {
+			continue
+		}
+		data, err := yaml.Marshal(section)
+		if err != nil {
+			continue
+		}
+		// problems are already reported by checkDocument
+		_ = yaml.Unmarshal(data, v.Field(i).Addr().Interface())
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// validateProviders checks the fields every provider shares and then the
+// config against the provider type registered under its id.
+func (c *AppConfig) validateProviders(p *problems) {
+	seen := make(map[string]int, len(c.Providers))
+	for i := range c.Providers {
+		cfg := &c.Providers[i]
+		path := fmt.Sprintf("providers[%d]", i)
+
+		validateTitleRules(path+".title_rules", cfg.TitleRules, p)
+
+		if cfg.ID == "" {
+			p.addf(path+".id", "provider id is required")
+			continue
+		}
+		if first, ok := seen[cfg.ID]; ok {
+			p.addf(path+".id", "duplicate provider id %s, also used by providers[%d]", cfg.ID, first)
+		} else {
+			seen[cfg.ID] = i
+		}
+
+		if cfg.BaseURL != "" {
+			u, err := url.Parse(cfg.BaseURL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				p.addf(path+".base_url", "base_url must be an http or https URL: %s", cfg.BaseURL)
+			}
+		}
+		if cfg.Timeout < 0 || (cfg.Timeout > 0 && cfg.Timeout < time.Millisecond) {
+			// yaml reads a bare number as nanoseconds
+			p.addf(path+".timeout", "timeout must be a duration such as 10s: %v", cfg.Timeout)
+		}
+		if cfg.Priority < 0 {
+			p.addf(path+".priority", "priority must not be negative: %d", cfg.Priority)
+		}
+		if cfg.RateLimit < 0 {
+			p.addf(path+".rate_limit", "rate_limit must not be negative: %d", cfg.RateLimit)
+		}
+		if cfg.MaxRetries < 0 {
+			p.addf(path+".max_retries", "max_retries must not be negative: %d", cfg.MaxRetries)
+		}
+
+		if err := provider.GlobalRegistry().ValidateConfig(cfg); err != nil {
+			details := apperrors.GetDetails(err)
+			if param, ok := details["param"].(string); ok {
+				p.addf(path+"."+param, "%v", details["reason"])
+			} else if apperrors.Is(err, apperrors.ErrCodeProviderNotFound) {
+				p.addf(path+".id", "%s", apperrors.GetMessage(err))
+			} else {
+				p.addf(path, "%s", apperrors.GetMessage(err))
+			}
+		}
+	}
+}
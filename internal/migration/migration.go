@@ -0,0 +1,394 @@
+// Package migration applies the versioned schema scripts embedded under
+// sql/<driver>. Each version has an up and a down script named
+// <version>_<name>.up.sql and <version>_<name>.down.sql, and the versions
+// applied so far are recorded in the schema_migrations table.
+package migration
+
+import (
+	"bufio"
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"gorm.io/gorm"
+)
+
+//go:embed sql
+var scripts embed.FS
+
+const (
+	migrationTable = "schema_migrations"
+	// a database with this table but no schema_migrations was created from
+	// the old SQL dumps and already matches the baseline
+	legacyMarkerTable = "channel"
+	baselineVersion   = 1
+)
+
+var scriptName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is one schema version.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status reports whether a migration has been applied.
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+}
+
+type appliedMigration struct {
+	Version   int64     `gorm:"column:version;primaryKey"`
+	Name      string    `gorm:"column:name"`
+	AppliedAt time.Time `gorm:"column:applied_at"`
+}
+
+func (appliedMigration) TableName() string {
+	return migrationTable
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	driver     string
+	migrations []*Migration
+}
+
+// New loads the scripts for the driver. The schema_migrations table is
+// created on first use, not here.
+func New(db *gorm.DB, driver string) (*Migrator, error) {
+	migrations, err := load(driver)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, driver: driver, migrations: migrations}, nil
+}
+
+func load(driver string) ([]*Migration, error) {
+	dir := path.Join("sql", driver)
+	entries, err := fs.ReadDir(scripts, dir)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for driver %s", driver)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		match := scriptName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected migration file %s", entry.Name())
+		}
+		version, _ := strconv.ParseInt(match[1], 10, 64)
+
+		content, err := fs.ReadFile(scripts, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has conflicting names %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down script", m.Version, m.Name)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Latest returns the newest version available for the driver.
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the newest applied version, or 0 for an empty database.
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+	for v := range applied {
+		version = max(version, v)
+	}
+	return version, nil
+}
+
+// Status lists every known migration, plus any applied version that no
+// longer has a script.
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*Status, 0, len(m.migrations))
+	known := make(map[int64]bool, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+		status := &Status{Version: migration.Version, Name: migration.Name}
+		if row, ok := applied[migration.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = &row.AppliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	for version, row := range applied {
+		if !known[version] {
+			statuses = append(statuses, &Status{
+				Version:   version,
+				Name:      row.Name,
+				Applied:   true,
+				AppliedAt: &row.AppliedAt,
+			})
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+
+	return statuses, nil
+}
+
+// Pending returns the migrations that have not been applied yet.
+func (m *Migrator) Pending(ctx context.Context) ([]*Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []*Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies every pending migration in order and returns how many ran.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	return m.To(ctx, m.Latest())
+}
+
+// Down reverts the newest steps applied migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	if steps <= 0 {
+		return 0, nil
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	versions := sortedVersions(applied)
+	if steps >= len(versions) {
+		return m.To(ctx, 0)
+	}
+	return m.To(ctx, versions[len(versions)-steps-1])
+}
+
+// To migrates up or down until version is the newest applied one. Version 0
+// reverts everything.
+func (m *Migrator) To(ctx context.Context, version int64) (int, error) {
+	if version != 0 && m.find(version) == nil {
+		return 0, fmt.Errorf("unknown migration version %d", version)
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok || migration.Version > version {
+			continue
+		}
+		if err := m.apply(ctx, migration); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	versions := sortedVersions(applied)
+	for i := len(versions) - 1; i >= 0 && versions[i] > version; i-- {
+		migration := m.find(versions[i])
+		if migration == nil {
+			return count, fmt.Errorf("migration %d is applied but has no script to revert it", versions[i])
+		}
+		if err := m.revert(ctx, migration); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, migration *Migration) error {
+	logger.Info("Applying migration",
+		logger.Int64("version", migration.Version),
+		logger.String("name", migration.Name),
+	)
+
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := execScript(tx, migration.Up); err != nil {
+			return err
+		}
+		return tx.Create(&appliedMigration{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			AppliedAt: time.Now(),
+		}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+func (m *Migrator) revert(ctx context.Context, migration *Migration) error {
+	logger.Info("Reverting migration",
+		logger.Int64("version", migration.Version),
+		logger.String("name", migration.Name),
+	)
+
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := execScript(tx, migration.Down); err != nil {
+			return err
+		}
+		return tx.Delete(&appliedMigration{}, "version = ?", migration.Version).Error
+	})
+	if err != nil {
+		return fmt.Errorf("reverting migration %d_%s failed: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+// applied creates schema_migrations if needed and returns its rows by
+// version. A database that predates the table but already has the baseline
+// tables is recorded at the baseline instead of being migrated from scratch.
+func (m *Migrator) applied(ctx context.Context) (map[int64]*appliedMigration, error) {
+	db := m.db.WithContext(ctx)
+
+	if !db.Migrator().HasTable(migrationTable) {
+		legacy := db.Migrator().HasTable(legacyMarkerTable)
+
+		err := db.Exec(`CREATE TABLE IF NOT EXISTS ` + migrationTable + ` (
+	version BIGINT NOT NULL PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	applied_at TIMESTAMP NOT NULL
+)`).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", migrationTable, err)
+		}
+
+		if baseline := m.find(baselineVersion); legacy && baseline != nil {
+			if err := db.Create(&appliedMigration{
+				Version:   baseline.Version,
+				Name:      baseline.Name,
+				AppliedAt: time.Now(),
+			}).Error; err != nil {
+				return nil, fmt.Errorf("failed to record baseline: %w", err)
+			}
+			logger.Info("Existing database recorded at the baseline migration",
+				logger.Int64("version", baseline.Version),
+			)
+		}
+	}
+
+	var rows []*appliedMigration
+	if err := db.Order("version").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", migrationTable, err)
+	}
+
+	applied := make(map[int64]*appliedMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+func (m *Migrator) find(version int64) *Migration {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration
+		}
+	}
+	return nil
+}
+
+func sortedVersions(applied map[int64]*appliedMigration) []int64 {
+	versions := make([]int64, 0, len(applied))
+	for v := range applied {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions
+}
+
+// execScript runs the statements of a script one at a time, since not every
+// driver accepts several statements in one call. A statement ends with a
+// semicolon at the end of a line; lines starting with -- are comments.
+func execScript(tx *gorm.DB, script string) error {
+	var stmt strings.Builder
+	scanner := bufio.NewScanner(strings.NewReader(script))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if stmt.Len() == 0 && (trimmed == "" || strings.HasPrefix(trimmed, "--")) {
+			continue
+		}
+
+		stmt.WriteString(line)
+		stmt.WriteByte('\n')
+		if strings.HasSuffix(trimmed, ";") {
+			if err := tx.Exec(stmt.String()).Error; err != nil {
+				return err
+			}
+			stmt.Reset()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(stmt.String()) != "" {
+		return tx.Exec(stmt.String()).Error
+	}
+	return nil
+}
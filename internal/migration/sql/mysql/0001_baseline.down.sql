@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS `user`;
+DROP TABLE IF EXISTS `program`;
+DROP TABLE IF EXISTS `channel_mapping`;
+DROP TABLE IF EXISTS `channel`;
+DROP TABLE IF EXISTS `timezone`;
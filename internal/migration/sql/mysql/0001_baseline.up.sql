@@ -1,291 +1,5 @@
-/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;
-/*!40101 SET @OLD_CHARACTER_SET_RESULTS=@@CHARACTER_SET_RESULTS */;
-/*!40101 SET @OLD_COLLATION_CONNECTION=@@COLLATION_CONNECTION */;
-SET NAMES utf8mb4;
-/*!40014 SET @OLD_FOREIGN_KEY_CHECKS=@@FOREIGN_KEY_CHECKS, FOREIGN_KEY_CHECKS=0 */;
-/*!40101 SET @OLD_SQL_MODE='NO_AUTO_VALUE_ON_ZERO', SQL_MODE='NO_AUTO_VALUE_ON_ZERO' */;
-/*!40111 SET @OLD_SQL_NOTES=@@SQL_NOTES, SQL_NOTES=0 */;
-
-
-# Dump of table channel
-# ------------------------------------------------------------
-
-DROP TABLE IF EXISTS `channel`;
-
-CREATE TABLE `channel` (
-  `id` bigint NOT NULL AUTO_INCREMENT,
-  `channel_id` varchar(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
-  `display_name` varchar(100) DEFAULT NULL,
-  `category` varchar(50) DEFAULT NULL,
-  `area` varchar(10) DEFAULT 'CN',
-  `logo_url` varchar(500) DEFAULT NULL,
-  `timezone` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci DEFAULT 'Asia/Shanghai',
-  `is_active` tinyint(1) DEFAULT '1',
-  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
-  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-  `regexp` varchar(100) DEFAULT NULL,
-  PRIMARY KEY (`id`),
-  UNIQUE KEY `uk_channel_id` (`channel_id`),
-  KEY `idx_category` (`category`),
-  KEY `idx_area` (`area`),
-  KEY `channel_ibfk_1` (`timezone`),
-  CONSTRAINT `channel_ibfk_1` FOREIGN KEY (`timezone`) REFERENCES `timezone` (`tz_name`) ON DELETE CASCADE
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
-
-LOCK TABLES `channel` WRITE;
-/*!40000 ALTER TABLE `channel` DISABLE KEYS */;
-
-INSERT INTO `channel` (`id`, `channel_id`, `display_name`, `category`, `area`, `logo_url`, `timezone`, `is_active`, `created_at`, `updated_at`, `regexp`)
-VALUES
-	(1,'CCTV1','CCTV-1 综合','','CN','','Asia/Shanghai',1,'2025-11-12 07:26:03','2025-12-01 05:43:09','^cctv-?1(\\s*综合)?'),
-	(2,'CCTV2','CCTV-2 财经','','CN','','Asia/Shanghai',1,'2025-11-12 07:46:22','2025-12-01 05:43:37','^cctv-?2(\\s*财经)?'),
-	(3,'CCTV3','CCTV-3 综艺','','CN','','Asia/Shanghai',1,'2025-11-12 08:01:19','2025-12-01 05:44:37','^cctv-?3(\\s*综艺)?'),
-	(4,'CCTV4','CCTV-4 中文国际','','CN','','Asia/Shanghai',1,'2025-11-12 08:01:19','2025-12-01 05:45:17','^cctv-?4(\\s*(中文国际|亚洲))?'),
-	(5,'CCTV5','CCTV-5 体育','','CN','','Asia/Shanghai',1,'2025-11-13 12:44:08','2025-12-01 05:48:39','^cctv-?5(\\s*体育)?'),
-	(6,'CCTV5+','CCTV-5 体育赛事','','CN','','Asia/Shanghai',1,'2025-11-13 12:44:08','2025-12-01 05:49:01','^cctv-?5(\\s*体育赛事)?'),
-	(7,'CCTV6','CCTV-6 电影','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:35','^cctv-?6(\\s*电影)?'),
-	(8,'CCTV7','CCTV-7 国防军事','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 05:51:16','^cctv-?7(\\s*国防军事)?'),
-	(9,'CCTV8','CCTV-8 电视剧','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:27','^cctv-?8(\\s*电视剧)?'),
-	(10,'CCTV9','CCTV-9 纪录','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:23','^cctv-?9(\\s*纪录)?'),
-	(11,'CCTV10','CCTV-10 科教','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:20','^cctv-?10(\\s*记录)?'),
-	(12,'CCTV11','CCTV-11 戏曲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:16','^cctv-?11(\\s*戏曲)?'),
-	(13,'CCTV12','CCTV-12 社会与法','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:13','^cctv-?12(\\s*社会与法)?'),
-	(14,'CCTV13','CCTV-13 新闻','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:10','^cctv-?13(\\s*新闻)?'),
-	(15,'CCTV14','CCTV-14 少儿','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:06','^cctv-?14(\\s*少儿)?'),
-	(16,'CCTV15','CCTV-15 音乐','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:02','^cctv-?15(\\s*音乐)?'),
-	(17,'CCTV16','CCTV-16 奥林匹克','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:59','^cctv-?16(\\s*(奥林匹克|奥运))?'),
-	(18,'CCTV17','CCTV-17 农业农村','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:56','^cctv-?17(\\s*农业农村)?'),
-	(19,'CCTV4K','CCTV-4K 超高清','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:51','^cctv-?4k(\\s*超高清)?'),
-	(20,'CCTV8K','CCTV-8K 超高清','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:47','^cctv-?8k(\\s*超高清)?'),
-	(21,'CCTV4欧洲','CCTV-4 欧洲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:35','^cctv-?4(\\s*欧洲)?'),
-	(22,'CCTV4美洲','CCTV-4 美洲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 05:56:03','^cctv-?4(\\s*美洲)?'),
-	(23,'CGTN','CGTN','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:03:15','^cgtn$'),
-	(24,'CGTN俄语','CGTN俄语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:03:03','^cgtn\\s*(俄语|Russian)'),
-	(25,'CGTN西语','CGTN西语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:16','^cgtn\\s*(西语|西班牙语|Spanish)'),
-	(26,'CGTN阿语','CGTN阿语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:13','^cgtn\\s*(阿语|阿拉伯语|Arabic)'),
-	(27,'CGTN法语','CGTN法语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:34','^cgtn\\s*(法语|French)'),
-	(28,'CGTN记录','CGTN记录','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:55','^cgtn\\s*(记录|documentary)'),
-	(29,'CCTV风云剧场','CCTV-风云剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:41','(cctv-?)?风云剧场'),
-	(30,'CCTV第一剧场','CCTV-第一剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:58','(cctv-?)?第一剧场'),
-	(31,'CCTV怀旧剧场','CCTV-怀旧剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:04','(cctv-?)?怀旧剧场'),
-	(32,'CCTV世界地理','CCTV-世界地理','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:06','(cctv-?)?世界地理'),
-	(33,'CCTV风云音乐','CCTV-风云音乐','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:07','(cctv-?)?风云音乐'),
-	(34,'CCTV兵器科技','CCTV-兵器科技','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:09','(cctv-?)?兵器科技'),
-	(35,'CCTV风云足球','CCTV-风云足球','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:11','(cctv-?)?风云足球'),
-	(36,'CCTV高尔夫网球','CCTV-高尔夫网球','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:21','(cctv-?)?高尔夫·?网球'),
-	(37,'CCTV女性时尚','CCTV-女性时尚','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:56','(cctv-?)?女性时尚'),
-	(38,'CCTV央视文化精品','CCTV-央视文化精品',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:44','(cctv-?)?央视文化精品'),
-	(39,'CCTV央视台球','CCTV-央视台球',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:57','(cctv-?)?央视台球'),
-	(40,'CCTV电视指南','CCTV-电视指南',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:11:12','(cctv-?)?电视指南'),
-	(41,'CCTV卫生健康','CCTV-卫生健康',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:11:20','(cctv-?)?卫生健康'),
-	(42,'北京卫视','北京卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:11:40','^北京卫视'),
-	(43,'江苏卫视','江苏卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:12:01','^江苏卫视'),
-	(44,'东方卫视','东方卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:30','^东方卫视'),
-	(45,'浙江卫视','浙江卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:24','^浙江卫视'),
-	(46,'湖南卫视','湖南卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:18','^湖南卫视'),
-	(47,'湖北卫视','湖北卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:46','^湖北卫视'),
-	(48,'广东卫视','广东卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:56','^广东卫视'),
-	(49,'广西卫视','广西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:06','^广西卫视'),
-	(50,'黑龙江卫视','黑龙江卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:16','^黑龙江卫视'),
-	(51,'海南卫视','海南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:24','^海南卫视'),
-	(52,'重庆卫视','重庆卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:33','^重庆卫视'),
-	(53,'深圳卫视','深圳卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:41','^深圳卫视'),
-	(54,'四川卫视','四川卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:49','^四川卫视'),
-	(55,'河南卫视','河南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:57','^河南卫视'),
-	(56,'东南卫视','东南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:32','(福建)?东南卫视'),
-	(57,'贵州卫视','贵州卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:40','^贵州卫视'),
-	(58,'江西卫视','江西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:49','^江西卫视'),
-	(59,'辽宁卫视','辽宁卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:57','^辽宁卫视'),
-	(60,'安徽卫视','安徽卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:05','^安徽卫视'),
-	(61,'河北卫视','河北卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:15','^河北卫视'),
-	(62,'山东卫视','山东卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:26','^山东卫视'),
-	(63,'天津卫视','天津卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:33','^天津卫视'),
-	(64,'吉林卫视','吉林卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:42','^吉林卫视'),
-	(65,'陕西卫视','陕西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:03','^陕西卫视'),
-	(66,'宁夏卫视','宁夏卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:13','^宁夏卫视'),
-	(67,'内蒙古卫视','内蒙古卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:53','^内蒙古卫视'),
-	(68,'云南卫视','云南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:24','^云南卫视'),
-	(69,'山西卫视','山西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:33','^山西卫视'),
-	(70,'青海卫视','青海卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:54','^青海卫视'),
-	(71,'西藏卫视','西藏卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:43','^西藏卫视'),
-	(72,'新疆卫视','新疆卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:19:01','^新疆卫视'),
-	(73,'三沙卫视','三沙卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:02:59','2025-12-01 06:19:10','^三沙卫视'),
-	(74,'延边卫视','延边卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:07:25','2025-12-01 06:19:18','^延边卫视'),
-	(75,'厦门卫视','厦门卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:08:51','2025-12-01 06:19:38','^厦门卫视'),
-	(76,'兵团卫视','兵团卫视','','CN','','Asia/Shanghai',1,'2025-11-15 02:20:30','2025-12-01 06:20:47','^兵团卫视'),
-	(77,'大湾区卫视','大湾区卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:29','^大湾区卫视'),
-	(78,'海峡卫视','海峡卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:39','^海峡卫视'),
-	(79,'农林卫视','中国农林卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:11','(中国)?农林卫视'),
-	(80,'CETV1','CETV-1','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:29:25','(cetv-?1)|(中国教育-?1)'),
-	(81,'CHC影迷电影','CHC影迷电影','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:29:41','^CHC影迷电影'),
-	(82,'CHC动作电影','CHC动作电影','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:29:50','^CHC动作电影'),
-	(83,'CHC家庭影院','CHC家庭影院','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:30:00','^CHC家庭影院'),
-	(84,'凤凰中文','凤凰卫视中文台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:30:54','凤凰(卫视)?中文(台)?'),
-	(85,'凤凰资讯','凤凰卫视资讯台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:30:43','凤凰(卫视)?资讯(台)?'),
-	(86,'凤凰香港','凤凰卫视香港台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:31:14','凤凰(卫视)?香港(台)?'),
-	(87,'上海新闻综合','上海新闻综合','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:31:39','^上海新闻综合'),
-	(88,'第一财经','第一财经','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:14','^(上海)?第一财经'),
-	(89,'新纪实','新纪实','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:32:27','^(上海)新纪实'),
-	(90,'五星体育','五星体育','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:30','^(上海)五星体育'),
-	(91,'哈哈炫动','哈哈炫动','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:43','^(上海)哈哈炫动'),
-	(92,'上海都市频道','上海都市频道','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:54','^上海都市频道'),
-	(93,'东方影视','东方影视','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:34:09','^(上海)东方影视'),
-	(94,'南京新闻综合','南京新闻综合频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:34:27','^南京新闻综合'),
-	(95,'南京教科','南京教科频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:42:06','^南京教科'),
-	(96,'南京十八','南京十八频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^南京十八'),
-	(97,'江苏体育休闲','江苏体育休闲频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏体育休闲'),
-	(98,'江苏城市','江苏城市频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏城市'),
-	(99,'江苏国际','江苏国际频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏国际'),
-	(100,'江苏教育','江苏教育频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏教育'),
-	(101,'江苏影视','江苏影视频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏影视'),
-	(102,'江苏综艺','江苏综艺频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏综艺'),
-	(103,'江苏新闻','江苏新闻频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏新闻'),
-	(104,'盐城新闻综合','盐城新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^盐城新闻综合'),
-	(105,'淮安综合','淮安综合频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^淮安综合'),
-	(106,'泰州新闻综合','泰州新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^泰州新闻综合'),
-	(107,'连云港新闻综合','连云港新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^连云港新闻综合'),
-	(108,'宿迁新闻综合','宿迁新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^宿迁新闻综合'),
-	(109,'徐州新闻综合','徐州新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^徐州新闻综合'),
-	(110,'优漫卡通','优漫卡通频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^优漫卡通'),
-	(111,'江阴新闻综合','江阴新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江阴新闻综合'),
-	(112,'南通新闻综合','南通新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^南通新闻综合'),
-	(113,'宜兴新闻综合','宜兴新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^宜兴新闻综合'),
-	(114,'溧水新闻综合','溧水新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^溧水新闻综合'),
-	(115,'陕西银龄','陕西银龄频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西银龄'),
-	(116,'陕西都市青春','陕西都市青春频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西都市青春'),
-	(117,'陕西体育休闲','陕西体育休闲频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西体育休闲'),
-	(118,'陕西秦腔','陕西秦腔频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西秦腔'),
-	(119,'陕西新闻资讯','陕西新闻资讯频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西新闻资讯'),
-	(120,'财富天下','江苏财富天下',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^财富天下'),
-	(121,'北京文艺','北京文艺频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:43:28','^(北京|BRTV)文艺'),
-	(122,'北京纪实科教','北京纪实科教频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:10','^(北京|BRTV)纪实科教'),
-	(123,'北京影视','北京影视频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:31','^(北京|BRTV)影视'),
-	(124,'北京财经','北京财经频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:40','^(北京|BRTV)财经'),
-	(125,'北京体育休闲','北京体育休闲频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:49','^(北京|BRTV)体育休闲'),
-	(126,'北京生活','北京生活频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:45:01','^(北京|BRTV)生活'),
-	(127,'北京新闻','北京新闻频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:45:15','^(北京|BRTV)新闻'),
-	(128,'卡酷少儿','卡酷少儿频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:41:31','^卡酷少儿'),
-	(129,'广东珠江','广东珠江',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东珠江'),
-	(130,'广东新闻','广东新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东新闻'),
-	(131,'广东民生','广东民生',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东民生'),
-	(132,'广东体育','广东体育',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东体育'),
-	(133,'广东影视','广东影视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东影视'),
-	(134,'广东少儿','广东少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东少儿'),
-	(135,'嘉佳卡通','嘉佳卡通',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^嘉佳卡通'),
-	(136,'岭南戏曲','岭南戏曲',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^岭南戏曲'),
-	(137,'广东移动','广东移动',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东移动'),
-	(138,'现代教育','现代教育',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^现代教育'),
-	(139,'广东台经典剧','广东台经典剧',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东台经典剧'),
-	(140,'山东齐鲁','齐鲁频道','','CN','','Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:45:58','^(山东)齐鲁(频道)?'),
-	(141,'山东体育','山东体育频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东体育'),
-	(142,'山东生活','山东生活频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东生活'),
-	(143,'山东综艺','山东综艺频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东综艺'),
-	(144,'山东新闻','山东新闻频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东新闻'),
-	(145,'山东农科','山东农科频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东农科'),
-	(146,'山东文旅','山东文旅频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东文旅'),
-	(147,'山东少儿','山东少儿频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东少儿'),
-	(148,'黄河电视台','黄河电视台',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^黄河电视台'),
-	(149,'山西经济与科技','山西经济与科技',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西经济与科技'),
-	(150,'山西影视','山西影视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西影视'),
-	(151,'山西社会与法制','山西社会与法制',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西社会与法制'),
-	(152,'山西文体生活','山西文体生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西文体生活'),
-	(153,'苏州4K','苏州4K',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 13:23:39','2025-12-01 06:41:31','^苏州4K'),
-	(154,'海南自贸','海南自贸',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南自贸'),
-	(155,'海南新闻','海南新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南新闻'),
-	(156,'海南公共','海南公共',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南公共'),
-	(157,'海南文旅','海南文旅',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南文旅'),
-	(158,'海南少儿','海南少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南少儿'),
-	(159,'中国天气','中国天气频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 16:07:39','2025-12-01 06:41:31','^中国天气'),
-	(161,'国学频道','国学频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-26 03:25:21','2025-12-01 06:41:31','^国学频道'),
-	(162,'厦视一套','厦视一套',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:12:07','2025-12-01 06:41:31','^厦视一套'),
-	(163,'厦视二套','厦视二套',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:12:07','2025-12-01 06:41:31','^厦视二套'),
-	(164,'江西都市','江西都市',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西都市'),
-	(165,'江西经济生活','江西经济生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西经济生活'),
-	(166,'江西公共农业','江西公共农业',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西公共农业'),
-	(167,'江西少儿','江西少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西少儿'),
-	(168,'江西新闻','江西新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西新闻'),
-	(169,'重温经典','重温经典',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^重温经典'),
-	(170,'河南新闻','河南新闻频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:45:14','2025-12-06 10:45:32','^河南新闻'),
-	(171,'河南都市','河南都市频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:48:42','^河南都市'),
-	(172,'河南民生','河南民生频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:48:50','^河南民生'),
-	(173,'河南法治','河南法治频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:02','^河南法治'),
-	(174,'河南公共','河南公共频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:51:41','^河南公共'),
-	(175,'河南乡村','河南乡村频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:52:11','^河南乡村'),
-	(176,'河南电视剧','河南电视剧频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:52:02','^河南电视剧'),
-	(177,'河南梨园','河南梨园频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:51:53','^河南梨园'),
-	(178,'河南文物宝库','河南文物宝库','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:50:52','^河南文物宝库'),
-	(179,'河南武术','河南武术频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:53','^河南武术'),
-	(180,'睛彩中原','睛彩中原','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:38','^睛彩中原'),
-	(181,'河南移动戏曲','河南移动戏曲频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:30','^河南移动戏曲'),
-	(182,'象视界','象视界','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:16','象视界'),
-	(183,'陕西移动电视','陕西移动电视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-06 11:09:06','2025-12-06 11:09:06','^陕西移动电视'),
-	(184,'甘肃卫视','甘肃卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^甘肃卫视'),
-	(185,'河北经济生活','河北经济生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北经济生活'),
-	(186,'河北三农','河北三农',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北三农'),
-	(187,'河北都市','河北都市',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北都市'),
-	(188,'河北影视剧','河北影视剧',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北影视剧'),
-	(189,'河北少儿科教','河北少儿科教',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北少儿科教'),
-	(190,'河北文旅公共','河北文旅公共',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北文旅公共');
-
-/*!40000 ALTER TABLE `channel` ENABLE KEYS */;
-UNLOCK TABLES;
-
-
-# Dump of table channel_mapping
-# ------------------------------------------------------------
-
-DROP TABLE IF EXISTS `channel_mapping`;
-
-CREATE TABLE `channel_mapping` (
-  `id` bigint NOT NULL AUTO_INCREMENT,
-  `canonical_id` varchar(50) NOT NULL,
-  `provider_id` varchar(50) NOT NULL,
-  `provider_channel_id` varchar(100) NOT NULL,
-  `provider_channel_name` varchar(200) DEFAULT NULL,
-  `confidence` float DEFAULT '1',
-  `is_verified` tinyint(1) DEFAULT '0',
-  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
-  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-  PRIMARY KEY (`id`),
-  UNIQUE KEY `uk_provider_mapping` (`provider_id`,`provider_channel_id`),
-  KEY `idx_canonical` (`canonical_id`),
-  KEY `idx_provider` (`provider_id`),
-  CONSTRAINT `channel_mapping_ibfk_1` FOREIGN KEY (`canonical_id`) REFERENCES `channel` (`channel_id`) ON DELETE CASCADE
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
-
-
-
-# Dump of table program
-# ------------------------------------------------------------
-
-DROP TABLE IF EXISTS `program`;
-
-CREATE TABLE `program` (
-  `id` bigint NOT NULL AUTO_INCREMENT,
-  `channel_id` varchar(50) NOT NULL,
-  `title` varchar(500) NOT NULL,
-  `description` text,
-  `start_time` timestamp NOT NULL,
-  `end_time` timestamp NOT NULL,
-  `original_timezone` varchar(255) DEFAULT 'Asia/Shanghai',
-  `category` varchar(50) DEFAULT NULL,
-  `provider_id` varchar(50) DEFAULT NULL,
-  `provider_program_id` varchar(100) DEFAULT NULL,
-  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
-  PRIMARY KEY (`id`),
-  KEY `idx_channel_time` (`channel_id`,`start_time`),
-  KEY `idx_time_range` (`start_time`,`end_time`),
-  KEY `program_ibfk_2` (`original_timezone`),
-  CONSTRAINT `program_ibfk_1` FOREIGN KEY (`channel_id`) REFERENCES `channel` (`channel_id`) ON DELETE CASCADE,
-  CONSTRAINT `program_ibfk_2` FOREIGN KEY (`original_timezone`) REFERENCES `timezone` (`tz_name`) ON DELETE CASCADE
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
-
-
-
-# Dump of table timezone
-# ------------------------------------------------------------
-
-DROP TABLE IF EXISTS `timezone`;
+-- Baseline schema and seed data. Databases created from the old SQL dumps
+-- are recorded at this version without running it.
 
 CREATE TABLE `timezone` (
   `id` bigint NOT NULL AUTO_INCREMENT,
@@ -297,9 +11,6 @@ CREATE TABLE `timezone` (
   UNIQUE KEY `uk_tz_name` (`tz_name`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 
-LOCK TABLES `timezone` WRITE;
-/*!40000 ALTER TABLE `timezone` DISABLE KEYS */;
-
 INSERT INTO `timezone` (`id`, `name`, `gmt_offset`, `tz_name`, `visible`)
 VALUES
 	(1,'(GMT-12:00) Etc - GMT+12',-720,'Etc/GMT+12',1),
@@ -867,14 +578,254 @@ VALUES
 	(563,'(GMT+14:00) Etc - GMT-14',840,'Etc/GMT-14',0),
 	(564,'(GMT+14:00) Pacific - Kiritimati',840,'Pacific/Kiritimati',0);
 
-/*!40000 ALTER TABLE `timezone` ENABLE KEYS */;
-UNLOCK TABLES;
+CREATE TABLE `channel` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `channel_id` varchar(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+  `display_name` varchar(100) DEFAULT NULL,
+  `category` varchar(50) DEFAULT NULL,
+  `area` varchar(10) DEFAULT 'CN',
+  `logo_url` varchar(500) DEFAULT NULL,
+  `timezone` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci DEFAULT 'Asia/Shanghai',
+  `is_active` tinyint(1) DEFAULT '1',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  `regexp` varchar(100) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_channel_id` (`channel_id`),
+  KEY `idx_category` (`category`),
+  KEY `idx_area` (`area`),
+  KEY `channel_ibfk_1` (`timezone`),
+  CONSTRAINT `channel_ibfk_1` FOREIGN KEY (`timezone`) REFERENCES `timezone` (`tz_name`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 
+INSERT INTO `channel` (`id`, `channel_id`, `display_name`, `category`, `area`, `logo_url`, `timezone`, `is_active`, `created_at`, `updated_at`, `regexp`)
+VALUES
+	(1,'CCTV1','CCTV-1 综合','','CN','','Asia/Shanghai',1,'2025-11-12 07:26:03','2025-12-01 05:43:09','^cctv-?1(\\s*综合)?'),
+	(2,'CCTV2','CCTV-2 财经','','CN','','Asia/Shanghai',1,'2025-11-12 07:46:22','2025-12-01 05:43:37','^cctv-?2(\\s*财经)?'),
+	(3,'CCTV3','CCTV-3 综艺','','CN','','Asia/Shanghai',1,'2025-11-12 08:01:19','2025-12-01 05:44:37','^cctv-?3(\\s*综艺)?'),
+	(4,'CCTV4','CCTV-4 中文国际','','CN','','Asia/Shanghai',1,'2025-11-12 08:01:19','2025-12-01 05:45:17','^cctv-?4(\\s*(中文国际|亚洲))?'),
+	(5,'CCTV5','CCTV-5 体育','','CN','','Asia/Shanghai',1,'2025-11-13 12:44:08','2025-12-01 05:48:39','^cctv-?5(\\s*体育)?'),
+	(6,'CCTV5+','CCTV-5 体育赛事','','CN','','Asia/Shanghai',1,'2025-11-13 12:44:08','2025-12-01 05:49:01','^cctv-?5(\\s*体育赛事)?'),
+	(7,'CCTV6','CCTV-6 电影','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:35','^cctv-?6(\\s*电影)?'),
+	(8,'CCTV7','CCTV-7 国防军事','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 05:51:16','^cctv-?7(\\s*国防军事)?'),
+	(9,'CCTV8','CCTV-8 电视剧','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:27','^cctv-?8(\\s*电视剧)?'),
+	(10,'CCTV9','CCTV-9 纪录','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:23','^cctv-?9(\\s*纪录)?'),
+	(11,'CCTV10','CCTV-10 科教','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:20','^cctv-?10(\\s*记录)?'),
+	(12,'CCTV11','CCTV-11 戏曲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:16','^cctv-?11(\\s*戏曲)?'),
+	(13,'CCTV12','CCTV-12 社会与法','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:13','^cctv-?12(\\s*社会与法)?'),
+	(14,'CCTV13','CCTV-13 新闻','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:10','^cctv-?13(\\s*新闻)?'),
+	(15,'CCTV14','CCTV-14 少儿','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:06','^cctv-?14(\\s*少儿)?'),
+	(16,'CCTV15','CCTV-15 音乐','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:02','^cctv-?15(\\s*音乐)?'),
+	(17,'CCTV16','CCTV-16 奥林匹克','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:59','^cctv-?16(\\s*(奥林匹克|奥运))?'),
+	(18,'CCTV17','CCTV-17 农业农村','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:56','^cctv-?17(\\s*农业农村)?'),
+	(19,'CCTV4K','CCTV-4K 超高清','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:51','^cctv-?4k(\\s*超高清)?'),
+	(20,'CCTV8K','CCTV-8K 超高清','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:47','^cctv-?8k(\\s*超高清)?'),
+	(21,'CCTV4欧洲','CCTV-4 欧洲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:35','^cctv-?4(\\s*欧洲)?'),
+	(22,'CCTV4美洲','CCTV-4 美洲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 05:56:03','^cctv-?4(\\s*美洲)?'),
+	(23,'CGTN','CGTN','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:03:15','^cgtn$'),
+	(24,'CGTN俄语','CGTN俄语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:03:03','^cgtn\\s*(俄语|Russian)'),
+	(25,'CGTN西语','CGTN西语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:16','^cgtn\\s*(西语|西班牙语|Spanish)'),
+	(26,'CGTN阿语','CGTN阿语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:13','^cgtn\\s*(阿语|阿拉伯语|Arabic)'),
+	(27,'CGTN法语','CGTN法语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:34','^cgtn\\s*(法语|French)'),
+	(28,'CGTN记录','CGTN记录','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:55','^cgtn\\s*(记录|documentary)'),
+	(29,'CCTV风云剧场','CCTV-风云剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:41','(cctv-?)?风云剧场'),
+	(30,'CCTV第一剧场','CCTV-第一剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:58','(cctv-?)?第一剧场'),
+	(31,'CCTV怀旧剧场','CCTV-怀旧剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:04','(cctv-?)?怀旧剧场'),
+	(32,'CCTV世界地理','CCTV-世界地理','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:06','(cctv-?)?世界地理'),
+	(33,'CCTV风云音乐','CCTV-风云音乐','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:07','(cctv-?)?风云音乐'),
+	(34,'CCTV兵器科技','CCTV-兵器科技','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:09','(cctv-?)?兵器科技'),
+	(35,'CCTV风云足球','CCTV-风云足球','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:11','(cctv-?)?风云足球'),
+	(36,'CCTV高尔夫网球','CCTV-高尔夫网球','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:21','(cctv-?)?高尔夫·?网球'),
+	(37,'CCTV女性时尚','CCTV-女性时尚','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:56','(cctv-?)?女性时尚'),
+	(38,'CCTV央视文化精品','CCTV-央视文化精品',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:44','(cctv-?)?央视文化精品'),
+	(39,'CCTV央视台球','CCTV-央视台球',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:57','(cctv-?)?央视台球'),
+	(40,'CCTV电视指南','CCTV-电视指南',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:11:12','(cctv-?)?电视指南'),
+	(41,'CCTV卫生健康','CCTV-卫生健康',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:11:20','(cctv-?)?卫生健康'),
+	(42,'北京卫视','北京卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:11:40','^北京卫视'),
+	(43,'江苏卫视','江苏卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:12:01','^江苏卫视'),
+	(44,'东方卫视','东方卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:30','^东方卫视'),
+	(45,'浙江卫视','浙江卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:24','^浙江卫视'),
+	(46,'湖南卫视','湖南卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:18','^湖南卫视'),
+	(47,'湖北卫视','湖北卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:46','^湖北卫视'),
+	(48,'广东卫视','广东卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:56','^广东卫视'),
+	(49,'广西卫视','广西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:06','^广西卫视'),
+	(50,'黑龙江卫视','黑龙江卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:16','^黑龙江卫视'),
+	(51,'海南卫视','海南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:24','^海南卫视'),
+	(52,'重庆卫视','重庆卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:33','^重庆卫视'),
+	(53,'深圳卫视','深圳卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:41','^深圳卫视'),
+	(54,'四川卫视','四川卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:49','^四川卫视'),
+	(55,'河南卫视','河南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:57','^河南卫视'),
+	(56,'东南卫视','东南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:32','(福建)?东南卫视'),
+	(57,'贵州卫视','贵州卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:40','^贵州卫视'),
+	(58,'江西卫视','江西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:49','^江西卫视'),
+	(59,'辽宁卫视','辽宁卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:57','^辽宁卫视'),
+	(60,'安徽卫视','安徽卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:05','^安徽卫视'),
+	(61,'河北卫视','河北卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:15','^河北卫视'),
+	(62,'山东卫视','山东卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:26','^山东卫视'),
+	(63,'天津卫视','天津卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:33','^天津卫视'),
+	(64,'吉林卫视','吉林卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:42','^吉林卫视'),
+	(65,'陕西卫视','陕西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:03','^陕西卫视'),
+	(66,'宁夏卫视','宁夏卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:13','^宁夏卫视'),
+	(67,'内蒙古卫视','内蒙古卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:53','^内蒙古卫视'),
+	(68,'云南卫视','云南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:24','^云南卫视'),
+	(69,'山西卫视','山西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:33','^山西卫视'),
+	(70,'青海卫视','青海卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:54','^青海卫视'),
+	(71,'西藏卫视','西藏卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:43','^西藏卫视'),
+	(72,'新疆卫视','新疆卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:19:01','^新疆卫视'),
+	(73,'三沙卫视','三沙卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:02:59','2025-12-01 06:19:10','^三沙卫视'),
+	(74,'延边卫视','延边卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:07:25','2025-12-01 06:19:18','^延边卫视'),
+	(75,'厦门卫视','厦门卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:08:51','2025-12-01 06:19:38','^厦门卫视'),
+	(76,'兵团卫视','兵团卫视','','CN','','Asia/Shanghai',1,'2025-11-15 02:20:30','2025-12-01 06:20:47','^兵团卫视'),
+	(77,'大湾区卫视','大湾区卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:29','^大湾区卫视'),
+	(78,'海峡卫视','海峡卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:39','^海峡卫视'),
+	(79,'农林卫视','中国农林卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:11','(中国)?农林卫视'),
+	(80,'CETV1','CETV-1','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:29:25','(cetv-?1)|(中国教育-?1)'),
+	(81,'CHC影迷电影','CHC影迷电影','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:29:41','^CHC影迷电影'),
+	(82,'CHC动作电影','CHC动作电影','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:29:50','^CHC动作电影'),
+	(83,'CHC家庭影院','CHC家庭影院','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:30:00','^CHC家庭影院'),
+	(84,'凤凰中文','凤凰卫视中文台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:30:54','凤凰(卫视)?中文(台)?'),
+	(85,'凤凰资讯','凤凰卫视资讯台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:30:43','凤凰(卫视)?资讯(台)?'),
+	(86,'凤凰香港','凤凰卫视香港台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:31:14','凤凰(卫视)?香港(台)?'),
+	(87,'上海新闻综合','上海新闻综合','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:31:39','^上海新闻综合'),
+	(88,'第一财经','第一财经','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:14','^(上海)?第一财经'),
+	(89,'新纪实','新纪实','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:32:27','^(上海)新纪实'),
+	(90,'五星体育','五星体育','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:30','^(上海)五星体育'),
+	(91,'哈哈炫动','哈哈炫动','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:43','^(上海)哈哈炫动'),
+	(92,'上海都市频道','上海都市频道','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:54','^上海都市频道'),
+	(93,'东方影视','东方影视','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:34:09','^(上海)东方影视'),
+	(94,'南京新闻综合','南京新闻综合频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:34:27','^南京新闻综合'),
+	(95,'南京教科','南京教科频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:42:06','^南京教科'),
+	(96,'南京十八','南京十八频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^南京十八'),
+	(97,'江苏体育休闲','江苏体育休闲频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏体育休闲'),
+	(98,'江苏城市','江苏城市频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏城市'),
+	(99,'江苏国际','江苏国际频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏国际'),
+	(100,'江苏教育','江苏教育频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏教育'),
+	(101,'江苏影视','江苏影视频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏影视'),
+	(102,'江苏综艺','江苏综艺频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏综艺'),
+	(103,'江苏新闻','江苏新闻频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏新闻'),
+	(104,'盐城新闻综合','盐城新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^盐城新闻综合'),
+	(105,'淮安综合','淮安综合频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^淮安综合'),
+	(106,'泰州新闻综合','泰州新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^泰州新闻综合'),
+	(107,'连云港新闻综合','连云港新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^连云港新闻综合'),
+	(108,'宿迁新闻综合','宿迁新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^宿迁新闻综合'),
+	(109,'徐州新闻综合','徐州新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^徐州新闻综合'),
+	(110,'优漫卡通','优漫卡通频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^优漫卡通'),
+	(111,'江阴新闻综合','江阴新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江阴新闻综合'),
+	(112,'南通新闻综合','南通新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^南通新闻综合'),
+	(113,'宜兴新闻综合','宜兴新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^宜兴新闻综合'),
+	(114,'溧水新闻综合','溧水新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^溧水新闻综合'),
+	(115,'陕西银龄','陕西银龄频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西银龄'),
+	(116,'陕西都市青春','陕西都市青春频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西都市青春'),
+	(117,'陕西体育休闲','陕西体育休闲频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西体育休闲'),
+	(118,'陕西秦腔','陕西秦腔频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西秦腔'),
+	(119,'陕西新闻资讯','陕西新闻资讯频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西新闻资讯'),
+	(120,'财富天下','江苏财富天下',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^财富天下'),
+	(121,'北京文艺','北京文艺频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:43:28','^(北京|BRTV)文艺'),
+	(122,'北京纪实科教','北京纪实科教频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:10','^(北京|BRTV)纪实科教'),
+	(123,'北京影视','北京影视频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:31','^(北京|BRTV)影视'),
+	(124,'北京财经','北京财经频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:40','^(北京|BRTV)财经'),
+	(125,'北京体育休闲','北京体育休闲频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:49','^(北京|BRTV)体育休闲'),
+	(126,'北京生活','北京生活频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:45:01','^(北京|BRTV)生活'),
+	(127,'北京新闻','北京新闻频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:45:15','^(北京|BRTV)新闻'),
+	(128,'卡酷少儿','卡酷少儿频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:41:31','^卡酷少儿'),
+	(129,'广东珠江','广东珠江',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东珠江'),
+	(130,'广东新闻','广东新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东新闻'),
+	(131,'广东民生','广东民生',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东民生'),
+	(132,'广东体育','广东体育',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东体育'),
+	(133,'广东影视','广东影视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东影视'),
+	(134,'广东少儿','广东少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东少儿'),
+	(135,'嘉佳卡通','嘉佳卡通',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^嘉佳卡通'),
+	(136,'岭南戏曲','岭南戏曲',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^岭南戏曲'),
+	(137,'广东移动','广东移动',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东移动'),
+	(138,'现代教育','现代教育',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^现代教育'),
+	(139,'广东台经典剧','广东台经典剧',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东台经典剧'),
+	(140,'山东齐鲁','齐鲁频道','','CN','','Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:45:58','^(山东)齐鲁(频道)?'),
+	(141,'山东体育','山东体育频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东体育'),
+	(142,'山东生活','山东生活频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东生活'),
+	(143,'山东综艺','山东综艺频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东综艺'),
+	(144,'山东新闻','山东新闻频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东新闻'),
+	(145,'山东农科','山东农科频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东农科'),
+	(146,'山东文旅','山东文旅频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东文旅'),
+	(147,'山东少儿','山东少儿频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东少儿'),
+	(148,'黄河电视台','黄河电视台',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^黄河电视台'),
+	(149,'山西经济与科技','山西经济与科技',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西经济与科技'),
+	(150,'山西影视','山西影视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西影视'),
+	(151,'山西社会与法制','山西社会与法制',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西社会与法制'),
+	(152,'山西文体生活','山西文体生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西文体生活'),
+	(153,'苏州4K','苏州4K',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 13:23:39','2025-12-01 06:41:31','^苏州4K'),
+	(154,'海南自贸','海南自贸',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南自贸'),
+	(155,'海南新闻','海南新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南新闻'),
+	(156,'海南公共','海南公共',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南公共'),
+	(157,'海南文旅','海南文旅',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南文旅'),
+	(158,'海南少儿','海南少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南少儿'),
+	(159,'中国天气','中国天气频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 16:07:39','2025-12-01 06:41:31','^中国天气'),
+	(161,'国学频道','国学频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-26 03:25:21','2025-12-01 06:41:31','^国学频道'),
+	(162,'厦视一套','厦视一套',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:12:07','2025-12-01 06:41:31','^厦视一套'),
+	(163,'厦视二套','厦视二套',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:12:07','2025-12-01 06:41:31','^厦视二套'),
+	(164,'江西都市','江西都市',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西都市'),
+	(165,'江西经济生活','江西经济生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西经济生活'),
+	(166,'江西公共农业','江西公共农业',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西公共农业'),
+	(167,'江西少儿','江西少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西少儿'),
+	(168,'江西新闻','江西新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西新闻'),
+	(169,'重温经典','重温经典',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^重温经典'),
+	(170,'河南新闻','河南新闻频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:45:14','2025-12-06 10:45:32','^河南新闻'),
+	(171,'河南都市','河南都市频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:48:42','^河南都市'),
+	(172,'河南民生','河南民生频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:48:50','^河南民生'),
+	(173,'河南法治','河南法治频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:02','^河南法治'),
+	(174,'河南公共','河南公共频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:51:41','^河南公共'),
+	(175,'河南乡村','河南乡村频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:52:11','^河南乡村'),
+	(176,'河南电视剧','河南电视剧频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:52:02','^河南电视剧'),
+	(177,'河南梨园','河南梨园频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:51:53','^河南梨园'),
+	(178,'河南文物宝库','河南文物宝库','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:50:52','^河南文物宝库'),
+	(179,'河南武术','河南武术频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:53','^河南武术'),
+	(180,'睛彩中原','睛彩中原','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:38','^睛彩中原'),
+	(181,'河南移动戏曲','河南移动戏曲频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:30','^河南移动戏曲'),
+	(182,'象视界','象视界','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:16','象视界'),
+	(183,'陕西移动电视','陕西移动电视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-06 11:09:06','2025-12-06 11:09:06','^陕西移动电视'),
+	(184,'甘肃卫视','甘肃卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^甘肃卫视'),
+	(185,'河北经济生活','河北经济生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北经济生活'),
+	(186,'河北三农','河北三农',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北三农'),
+	(187,'河北都市','河北都市',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北都市'),
+	(188,'河北影视剧','河北影视剧',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北影视剧'),
+	(189,'河北少儿科教','河北少儿科教',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北少儿科教'),
+	(190,'河北文旅公共','河北文旅公共',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北文旅公共');
 
-# Dump of table user
-# ------------------------------------------------------------
+CREATE TABLE `channel_mapping` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `canonical_id` varchar(50) NOT NULL,
+  `provider_id` varchar(50) NOT NULL,
+  `provider_channel_id` varchar(100) NOT NULL,
+  `provider_channel_name` varchar(200) DEFAULT NULL,
+  `confidence` float DEFAULT '1',
+  `is_verified` tinyint(1) DEFAULT '0',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_provider_mapping` (`provider_id`,`provider_channel_id`),
+  KEY `idx_canonical` (`canonical_id`),
+  KEY `idx_provider` (`provider_id`),
+  CONSTRAINT `channel_mapping_ibfk_1` FOREIGN KEY (`canonical_id`) REFERENCES `channel` (`channel_id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 
-DROP TABLE IF EXISTS `user`;
+CREATE TABLE `program` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `channel_id` varchar(50) NOT NULL,
+  `title` varchar(500) NOT NULL,
+  `description` text,
+  `start_time` timestamp NOT NULL,
+  `end_time` timestamp NOT NULL,
+  `original_timezone` varchar(255) DEFAULT 'Asia/Shanghai',
+  `category` varchar(50) DEFAULT NULL,
+  `provider_id` varchar(50) DEFAULT NULL,
+  `provider_program_id` varchar(100) DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_channel_time` (`channel_id`,`start_time`),
+  KEY `idx_time_range` (`start_time`,`end_time`),
+  KEY `program_ibfk_2` (`original_timezone`),
+  CONSTRAINT `program_ibfk_1` FOREIGN KEY (`channel_id`) REFERENCES `channel` (`channel_id`) ON DELETE CASCADE,
+  CONSTRAINT `program_ibfk_2` FOREIGN KEY (`original_timezone`) REFERENCES `timezone` (`tz_name`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 
 CREATE TABLE `user` (
   `id` bigint NOT NULL AUTO_INCREMENT,
@@ -890,13 +841,3 @@ CREATE TABLE `user` (
   KEY `idx_username` (`username`),
   KEY `idx_is_active` (`is_active`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
-
-
-
-
-/*!40111 SET SQL_NOTES=@OLD_SQL_NOTES */;
-/*!40101 SET SQL_MODE=@OLD_SQL_MODE */;
-/*!40014 SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS */;
-/*!40101 SET CHARACTER_SET_CLIENT=@OLD_CHARACTER_SET_CLIENT */;
-/*!40101 SET CHARACTER_SET_RESULTS=@OLD_CHARACTER_SET_RESULTS */;
-/*!40101 SET COLLATION_CONNECTION=@OLD_COLLATION_CONNECTION */;
@@ -0,0 +1,33 @@
+CREATE TABLE `program_change` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `channel_id` varchar(50) NOT NULL,
+  `provider_id` varchar(50) DEFAULT NULL,
+  `date` varchar(10) NOT NULL,
+  `change_type` varchar(20) NOT NULL,
+  `title` varchar(500) DEFAULT NULL,
+  `old_title` varchar(500) DEFAULT NULL,
+  `start_time` timestamp NULL DEFAULT NULL,
+  `end_time` timestamp NULL DEFAULT NULL,
+  `old_start_time` timestamp NULL DEFAULT NULL,
+  `old_end_time` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_change_channel_date` (`channel_id`,`date`),
+  KEY `idx_change_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+CREATE TABLE `webhook` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `url` varchar(500) NOT NULL,
+  `secret` varchar(255) DEFAULT NULL,
+  `events` varchar(255) DEFAULT NULL,
+  `channel_ids` text,
+  `is_active` tinyint(1) DEFAULT '1',
+  `last_status` int DEFAULT NULL,
+  `last_error` varchar(500) DEFAULT NULL,
+  `last_sent_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
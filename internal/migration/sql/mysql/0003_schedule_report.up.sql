@@ -0,0 +1,15 @@
+CREATE TABLE `schedule_report` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `channel_id` varchar(50) NOT NULL,
+  `provider_id` varchar(50) DEFAULT NULL,
+  `date` varchar(10) NOT NULL,
+  `program_count` int DEFAULT '0',
+  `coverage` float DEFAULT '0',
+  `issue_count` int DEFAULT '0',
+  `repair_count` int DEFAULT '0',
+  `issues` text,
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_report_channel_date` (`channel_id`,`date`),
+  KEY `idx_report_date` (`date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1 @@
+ALTER TABLE `program` DROP COLUMN `episode`;
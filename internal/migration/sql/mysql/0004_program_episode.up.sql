@@ -0,0 +1 @@
+ALTER TABLE `program` ADD COLUMN `episode` int NOT NULL DEFAULT '0' AFTER `category`;
@@ -0,0 +1,4 @@
+ALTER TABLE `program`
+  ADD COLUMN `series` varchar(255) DEFAULT NULL AFTER `category`,
+  ADD COLUMN `season` int NOT NULL DEFAULT '0' AFTER `series`,
+  ADD COLUMN `episode_label` varchar(50) DEFAULT NULL AFTER `episode`;
@@ -0,0 +1,5 @@
+ALTER TABLE `program`
+  DROP COLUMN `detail_fetched_at`,
+  DROP COLUMN `rating`,
+  DROP COLUMN `cast`,
+  DROP COLUMN `image_url`;
@@ -0,0 +1,5 @@
+ALTER TABLE `program`
+  ADD COLUMN `image_url` varchar(500) DEFAULT NULL AFTER `description`,
+  ADD COLUMN `cast` varchar(500) DEFAULT NULL AFTER `image_url`,
+  ADD COLUMN `rating` varchar(20) DEFAULT NULL AFTER `cast`,
+  ADD COLUMN `detail_fetched_at` timestamp NULL DEFAULT NULL AFTER `created_at`;
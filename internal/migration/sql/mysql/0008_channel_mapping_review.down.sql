@@ -0,0 +1,4 @@
+ALTER TABLE `channel_mapping`
+  DROP KEY `idx_status`,
+  DROP COLUMN `reviewed_at`,
+  DROP COLUMN `status`;
@@ -0,0 +1,7 @@
+ALTER TABLE `channel_mapping`
+  ADD COLUMN `status` varchar(20) NOT NULL DEFAULT 'pending' AFTER `is_verified`,
+  ADD COLUMN `reviewed_at` timestamp NULL DEFAULT NULL AFTER `status`,
+  ADD KEY `idx_status` (`status`);
+
+-- mappings verified before the review queue existed stay verified
+UPDATE `channel_mapping` SET `status` = 'verified' WHERE `is_verified` = 1;
@@ -0,0 +1 @@
+ALTER TABLE `channel_mapping` ADD COLUMN `priority` int NOT NULL DEFAULT '0' AFTER `status`;
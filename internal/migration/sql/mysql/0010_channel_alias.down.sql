@@ -0,0 +1,15 @@
+ALTER TABLE `channel` ADD COLUMN `regexp` varchar(100) DEFAULT NULL;
+
+-- the first regex alias of each channel goes back, unwrapped again
+UPDATE `channel` c
+JOIN `channel_alias` a ON a.`id` = (
+  SELECT MIN(`id`) FROM `channel_alias`
+  WHERE `channel_id` = c.`channel_id` AND `match_type` = 'regex'
+)
+SET c.`regexp` = CASE
+  WHEN a.`alias` LIKE '.*(?:%).*' THEN SUBSTRING(a.`alias`, 6, CHAR_LENGTH(a.`alias`) - 8)
+  WHEN a.`alias` LIKE '%.*' THEN LEFT(a.`alias`, CHAR_LENGTH(a.`alias`) - 2)
+  ELSE a.`alias`
+END;
+
+DROP TABLE IF EXISTS `channel_alias`;
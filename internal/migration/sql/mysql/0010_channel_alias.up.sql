@@ -0,0 +1,26 @@
+CREATE TABLE `channel_alias` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `channel_id` varchar(100) NOT NULL,
+  `alias` varchar(255) NOT NULL,
+  `match_type` varchar(20) NOT NULL DEFAULT 'exact',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_channel_id` (`channel_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- channel.regexp was searched for anywhere in the name, while a regex alias
+-- has to match the whole name, so each pattern is widened to keep matching
+-- the same names: an anchored one gets a trailing .*, the rest are wrapped.
+INSERT INTO `channel_alias` (`channel_id`, `alias`, `match_type`, `created_at`, `updated_at`)
+SELECT `channel_id`,
+  CASE WHEN `regexp` LIKE '^%' AND LOCATE('|', `regexp`) = 0
+    THEN CONCAT(`regexp`, '.*')
+    ELSE CONCAT('.*(?:', `regexp`, ').*')
+  END,
+  'regex', `updated_at`, `updated_at`
+FROM `channel`
+WHERE `regexp` IS NOT NULL AND `regexp` <> ''
+ORDER BY `id`;
+
+ALTER TABLE `channel` DROP COLUMN `regexp`;
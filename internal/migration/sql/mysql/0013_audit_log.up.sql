@@ -0,0 +1,16 @@
+CREATE TABLE `audit_log` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint DEFAULT NULL,
+  `username` varchar(50) NOT NULL DEFAULT '',
+  `action` varchar(50) NOT NULL,
+  `resource_type` varchar(50) NOT NULL,
+  `resource_id` varchar(255) NOT NULL DEFAULT '',
+  `ip` varchar(64) NOT NULL DEFAULT '',
+  `before_value` mediumtext,
+  `after_value` mediumtext,
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_audit_log_created_at` (`created_at`),
+  KEY `idx_audit_log_user_id` (`user_id`),
+  KEY `idx_audit_log_resource` (`resource_type`,`resource_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "user" CASCADE;
+DROP TABLE IF EXISTS "program" CASCADE;
+DROP TABLE IF EXISTS "channel_mapping" CASCADE;
+DROP TABLE IF EXISTS "channel" CASCADE;
+DROP TABLE IF EXISTS "timezone" CASCADE;
@@ -0,0 +1,848 @@
+-- Baseline schema and seed data. Databases created from the old SQL dumps
+-- are recorded at this version without running it.
+
+SET client_encoding = 'UTF8';
+
+CREATE TABLE "channel" (
+  "id" BIGSERIAL,
+  "channel_id" varchar(100) NOT NULL,
+  "display_name" varchar(100),
+  "category" varchar(50),
+  "area" varchar(10) DEFAULT 'CN',
+  "logo_url" varchar(500),
+  "timezone" varchar(255) DEFAULT 'Asia/Shanghai',
+  "is_active" smallint DEFAULT '1',
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  "regexp" varchar(100),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uk_channel_id" UNIQUE ("channel_id")
+);
+
+CREATE TABLE "channel_mapping" (
+  "id" BIGSERIAL,
+  "canonical_id" varchar(50) NOT NULL,
+  "provider_id" varchar(50) NOT NULL,
+  "provider_channel_id" varchar(100) NOT NULL,
+  "provider_channel_name" varchar(200),
+  "confidence" double precision DEFAULT '1',
+  "is_verified" smallint DEFAULT '0',
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uk_provider_mapping" UNIQUE ("provider_id", "provider_channel_id")
+);
+
+CREATE TABLE "program" (
+  "id" BIGSERIAL,
+  "channel_id" varchar(50) NOT NULL,
+  "title" varchar(500) NOT NULL,
+  "description" text,
+  "start_time" timestamptz NOT NULL,
+  "end_time" timestamptz NOT NULL,
+  "original_timezone" varchar(255) DEFAULT 'Asia/Shanghai',
+  "category" varchar(50),
+  "provider_id" varchar(50),
+  "provider_program_id" varchar(100),
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+CREATE TABLE "timezone" (
+  "id" BIGSERIAL,
+  "name" varchar(255) NOT NULL,
+  "gmt_offset" integer NOT NULL,
+  "tz_name" varchar(255),
+  "visible" smallint NOT NULL DEFAULT '0',
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uk_tz_name" UNIQUE ("tz_name")
+);
+
+CREATE TABLE "user" (
+  "id" BIGSERIAL,
+  "username" varchar(50) NOT NULL,
+  "password" varchar(255) NOT NULL,
+  "email" varchar(100),
+  "role" varchar(20) DEFAULT 'admin',
+  "is_active" smallint DEFAULT '1',
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uk_username" UNIQUE ("username")
+);
+CREATE INDEX "idx_category" ON "channel" ("category");
+CREATE INDEX "idx_area" ON "channel" ("area");
+CREATE INDEX "channel_ibfk_1" ON "channel" ("timezone");
+CREATE INDEX "idx_canonical" ON "channel_mapping" ("canonical_id");
+CREATE INDEX "idx_provider" ON "channel_mapping" ("provider_id");
+CREATE INDEX "idx_channel_time" ON "program" ("channel_id", "start_time");
+CREATE INDEX "idx_time_range" ON "program" ("start_time", "end_time");
+CREATE INDEX "program_ibfk_2" ON "program" ("original_timezone");
+CREATE INDEX "idx_username" ON "user" ("username");
+CREATE INDEX "idx_is_active" ON "user" ("is_active");
+
+INSERT INTO "channel" ("id", "channel_id", "display_name", "category", "area", "logo_url", "timezone", "is_active", "created_at", "updated_at", "regexp")
+VALUES
+	(1,'CCTV1','CCTV-1 综合','','CN','','Asia/Shanghai',1,'2025-11-12 07:26:03','2025-12-01 05:43:09','^cctv-?1(\s*综合)?'),
+	(2,'CCTV2','CCTV-2 财经','','CN','','Asia/Shanghai',1,'2025-11-12 07:46:22','2025-12-01 05:43:37','^cctv-?2(\s*财经)?'),
+	(3,'CCTV3','CCTV-3 综艺','','CN','','Asia/Shanghai',1,'2025-11-12 08:01:19','2025-12-01 05:44:37','^cctv-?3(\s*综艺)?'),
+	(4,'CCTV4','CCTV-4 中文国际','','CN','','Asia/Shanghai',1,'2025-11-12 08:01:19','2025-12-01 05:45:17','^cctv-?4(\s*(中文国际|亚洲))?'),
+	(5,'CCTV5','CCTV-5 体育','','CN','','Asia/Shanghai',1,'2025-11-13 12:44:08','2025-12-01 05:48:39','^cctv-?5(\s*体育)?'),
+	(6,'CCTV5+','CCTV-5 体育赛事','','CN','','Asia/Shanghai',1,'2025-11-13 12:44:08','2025-12-01 05:49:01','^cctv-?5(\s*体育赛事)?'),
+	(7,'CCTV6','CCTV-6 电影','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:35','^cctv-?6(\s*电影)?'),
+	(8,'CCTV7','CCTV-7 国防军事','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 05:51:16','^cctv-?7(\s*国防军事)?'),
+	(9,'CCTV8','CCTV-8 电视剧','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:27','^cctv-?8(\s*电视剧)?'),
+	(10,'CCTV9','CCTV-9 纪录','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:23','^cctv-?9(\s*纪录)?'),
+	(11,'CCTV10','CCTV-10 科教','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:20','^cctv-?10(\s*记录)?'),
+	(12,'CCTV11','CCTV-11 戏曲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:16','^cctv-?11(\s*戏曲)?'),
+	(13,'CCTV12','CCTV-12 社会与法','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:13','^cctv-?12(\s*社会与法)?'),
+	(14,'CCTV13','CCTV-13 新闻','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:10','^cctv-?13(\s*新闻)?'),
+	(15,'CCTV14','CCTV-14 少儿','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:06','^cctv-?14(\s*少儿)?'),
+	(16,'CCTV15','CCTV-15 音乐','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:02','^cctv-?15(\s*音乐)?'),
+	(17,'CCTV16','CCTV-16 奥林匹克','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:59','^cctv-?16(\s*(奥林匹克|奥运))?'),
+	(18,'CCTV17','CCTV-17 农业农村','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:56','^cctv-?17(\s*农业农村)?'),
+	(19,'CCTV4K','CCTV-4K 超高清','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:51','^cctv-?4k(\s*超高清)?'),
+	(20,'CCTV8K','CCTV-8K 超高清','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:47','^cctv-?8k(\s*超高清)?'),
+	(21,'CCTV4欧洲','CCTV-4 欧洲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:35','^cctv-?4(\s*欧洲)?'),
+	(22,'CCTV4美洲','CCTV-4 美洲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 05:56:03','^cctv-?4(\s*美洲)?'),
+	(23,'CGTN','CGTN','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:03:15','^cgtn$'),
+	(24,'CGTN俄语','CGTN俄语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:03:03','^cgtn\s*(俄语|Russian)'),
+	(25,'CGTN西语','CGTN西语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:16','^cgtn\s*(西语|西班牙语|Spanish)'),
+	(26,'CGTN阿语','CGTN阿语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:13','^cgtn\s*(阿语|阿拉伯语|Arabic)'),
+	(27,'CGTN法语','CGTN法语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:34','^cgtn\s*(法语|French)'),
+	(28,'CGTN记录','CGTN记录','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:55','^cgtn\s*(记录|documentary)'),
+	(29,'CCTV风云剧场','CCTV-风云剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:41','(cctv-?)?风云剧场'),
+	(30,'CCTV第一剧场','CCTV-第一剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:58','(cctv-?)?第一剧场'),
+	(31,'CCTV怀旧剧场','CCTV-怀旧剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:04','(cctv-?)?怀旧剧场'),
+	(32,'CCTV世界地理','CCTV-世界地理','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:06','(cctv-?)?世界地理'),
+	(33,'CCTV风云音乐','CCTV-风云音乐','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:07','(cctv-?)?风云音乐'),
+	(34,'CCTV兵器科技','CCTV-兵器科技','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:09','(cctv-?)?兵器科技'),
+	(35,'CCTV风云足球','CCTV-风云足球','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:11','(cctv-?)?风云足球'),
+	(36,'CCTV高尔夫网球','CCTV-高尔夫网球','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:21','(cctv-?)?高尔夫·?网球'),
+	(37,'CCTV女性时尚','CCTV-女性时尚','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:56','(cctv-?)?女性时尚'),
+	(38,'CCTV央视文化精品','CCTV-央视文化精品',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:44','(cctv-?)?央视文化精品'),
+	(39,'CCTV央视台球','CCTV-央视台球',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:57','(cctv-?)?央视台球'),
+	(40,'CCTV电视指南','CCTV-电视指南',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:11:12','(cctv-?)?电视指南'),
+	(41,'CCTV卫生健康','CCTV-卫生健康',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:11:20','(cctv-?)?卫生健康'),
+	(42,'北京卫视','北京卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:11:40','^北京卫视'),
+	(43,'江苏卫视','江苏卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:12:01','^江苏卫视'),
+	(44,'东方卫视','东方卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:30','^东方卫视'),
+	(45,'浙江卫视','浙江卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:24','^浙江卫视'),
+	(46,'湖南卫视','湖南卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:18','^湖南卫视'),
+	(47,'湖北卫视','湖北卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:46','^湖北卫视'),
+	(48,'广东卫视','广东卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:56','^广东卫视'),
+	(49,'广西卫视','广西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:06','^广西卫视'),
+	(50,'黑龙江卫视','黑龙江卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:16','^黑龙江卫视'),
+	(51,'海南卫视','海南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:24','^海南卫视'),
+	(52,'重庆卫视','重庆卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:33','^重庆卫视'),
+	(53,'深圳卫视','深圳卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:41','^深圳卫视'),
+	(54,'四川卫视','四川卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:49','^四川卫视'),
+	(55,'河南卫视','河南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:57','^河南卫视'),
+	(56,'东南卫视','东南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:32','(福建)?东南卫视'),
+	(57,'贵州卫视','贵州卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:40','^贵州卫视'),
+	(58,'江西卫视','江西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:49','^江西卫视'),
+	(59,'辽宁卫视','辽宁卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:57','^辽宁卫视'),
+	(60,'安徽卫视','安徽卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:05','^安徽卫视'),
+	(61,'河北卫视','河北卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:15','^河北卫视'),
+	(62,'山东卫视','山东卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:26','^山东卫视'),
+	(63,'天津卫视','天津卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:33','^天津卫视'),
+	(64,'吉林卫视','吉林卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:42','^吉林卫视'),
+	(65,'陕西卫视','陕西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:03','^陕西卫视'),
+	(66,'宁夏卫视','宁夏卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:13','^宁夏卫视'),
+	(67,'内蒙古卫视','内蒙古卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:53','^内蒙古卫视'),
+	(68,'云南卫视','云南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:24','^云南卫视'),
+	(69,'山西卫视','山西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:33','^山西卫视'),
+	(70,'青海卫视','青海卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:54','^青海卫视'),
+	(71,'西藏卫视','西藏卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:43','^西藏卫视'),
+	(72,'新疆卫视','新疆卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:19:01','^新疆卫视'),
+	(73,'三沙卫视','三沙卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:02:59','2025-12-01 06:19:10','^三沙卫视'),
+	(74,'延边卫视','延边卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:07:25','2025-12-01 06:19:18','^延边卫视'),
+	(75,'厦门卫视','厦门卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:08:51','2025-12-01 06:19:38','^厦门卫视'),
+	(76,'兵团卫视','兵团卫视','','CN','','Asia/Shanghai',1,'2025-11-15 02:20:30','2025-12-01 06:20:47','^兵团卫视'),
+	(77,'大湾区卫视','大湾区卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:29','^大湾区卫视'),
+	(78,'海峡卫视','海峡卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:39','^海峡卫视'),
+	(79,'农林卫视','中国农林卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:11','(中国)?农林卫视'),
+	(80,'CETV1','CETV-1','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:29:25','(cetv-?1)|(中国教育-?1)'),
+	(81,'CHC影迷电影','CHC影迷电影','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:29:41','^CHC影迷电影'),
+	(82,'CHC动作电影','CHC动作电影','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:29:50','^CHC动作电影'),
+	(83,'CHC家庭影院','CHC家庭影院','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:30:00','^CHC家庭影院'),
+	(84,'凤凰中文','凤凰卫视中文台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:30:54','凤凰(卫视)?中文(台)?'),
+	(85,'凤凰资讯','凤凰卫视资讯台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:30:43','凤凰(卫视)?资讯(台)?'),
+	(86,'凤凰香港','凤凰卫视香港台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:31:14','凤凰(卫视)?香港(台)?'),
+	(87,'上海新闻综合','上海新闻综合','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:31:39','^上海新闻综合'),
+	(88,'第一财经','第一财经','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:14','^(上海)?第一财经'),
+	(89,'新纪实','新纪实','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:32:27','^(上海)新纪实'),
+	(90,'五星体育','五星体育','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:30','^(上海)五星体育'),
+	(91,'哈哈炫动','哈哈炫动','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:43','^(上海)哈哈炫动'),
+	(92,'上海都市频道','上海都市频道','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:54','^上海都市频道'),
+	(93,'东方影视','东方影视','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:34:09','^(上海)东方影视'),
+	(94,'南京新闻综合','南京新闻综合频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:34:27','^南京新闻综合'),
+	(95,'南京教科','南京教科频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:42:06','^南京教科'),
+	(96,'南京十八','南京十八频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^南京十八'),
+	(97,'江苏体育休闲','江苏体育休闲频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏体育休闲'),
+	(98,'江苏城市','江苏城市频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏城市'),
+	(99,'江苏国际','江苏国际频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏国际'),
+	(100,'江苏教育','江苏教育频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏教育'),
+	(101,'江苏影视','江苏影视频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏影视'),
+	(102,'江苏综艺','江苏综艺频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏综艺'),
+	(103,'江苏新闻','江苏新闻频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏新闻'),
+	(104,'盐城新闻综合','盐城新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^盐城新闻综合'),
+	(105,'淮安综合','淮安综合频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^淮安综合'),
+	(106,'泰州新闻综合','泰州新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^泰州新闻综合'),
+	(107,'连云港新闻综合','连云港新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^连云港新闻综合'),
+	(108,'宿迁新闻综合','宿迁新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^宿迁新闻综合'),
+	(109,'徐州新闻综合','徐州新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^徐州新闻综合'),
+	(110,'优漫卡通','优漫卡通频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^优漫卡通'),
+	(111,'江阴新闻综合','江阴新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江阴新闻综合'),
+	(112,'南通新闻综合','南通新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^南通新闻综合'),
+	(113,'宜兴新闻综合','宜兴新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^宜兴新闻综合'),
+	(114,'溧水新闻综合','溧水新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^溧水新闻综合'),
+	(115,'陕西银龄','陕西银龄频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西银龄'),
+	(116,'陕西都市青春','陕西都市青春频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西都市青春'),
+	(117,'陕西体育休闲','陕西体育休闲频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西体育休闲'),
+	(118,'陕西秦腔','陕西秦腔频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西秦腔'),
+	(119,'陕西新闻资讯','陕西新闻资讯频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西新闻资讯'),
+	(120,'财富天下','江苏财富天下',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^财富天下'),
+	(121,'北京文艺','北京文艺频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:43:28','^(北京|BRTV)文艺'),
+	(122,'北京纪实科教','北京纪实科教频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:10','^(北京|BRTV)纪实科教'),
+	(123,'北京影视','北京影视频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:31','^(北京|BRTV)影视'),
+	(124,'北京财经','北京财经频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:40','^(北京|BRTV)财经'),
+	(125,'北京体育休闲','北京体育休闲频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:49','^(北京|BRTV)体育休闲'),
+	(126,'北京生活','北京生活频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:45:01','^(北京|BRTV)生活'),
+	(127,'北京新闻','北京新闻频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:45:15','^(北京|BRTV)新闻'),
+	(128,'卡酷少儿','卡酷少儿频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:41:31','^卡酷少儿'),
+	(129,'广东珠江','广东珠江',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东珠江'),
+	(130,'广东新闻','广东新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东新闻'),
+	(131,'广东民生','广东民生',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东民生'),
+	(132,'广东体育','广东体育',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东体育'),
+	(133,'广东影视','广东影视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东影视'),
+	(134,'广东少儿','广东少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东少儿'),
+	(135,'嘉佳卡通','嘉佳卡通',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^嘉佳卡通'),
+	(136,'岭南戏曲','岭南戏曲',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^岭南戏曲'),
+	(137,'广东移动','广东移动',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东移动'),
+	(138,'现代教育','现代教育',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^现代教育'),
+	(139,'广东台经典剧','广东台经典剧',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东台经典剧'),
+	(140,'山东齐鲁','齐鲁频道','','CN','','Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:45:58','^(山东)齐鲁(频道)?'),
+	(141,'山东体育','山东体育频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东体育'),
+	(142,'山东生活','山东生活频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东生活'),
+	(143,'山东综艺','山东综艺频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东综艺'),
+	(144,'山东新闻','山东新闻频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东新闻'),
+	(145,'山东农科','山东农科频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东农科'),
+	(146,'山东文旅','山东文旅频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东文旅'),
+	(147,'山东少儿','山东少儿频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东少儿'),
+	(148,'黄河电视台','黄河电视台',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^黄河电视台'),
+	(149,'山西经济与科技','山西经济与科技',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西经济与科技'),
+	(150,'山西影视','山西影视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西影视'),
+	(151,'山西社会与法制','山西社会与法制',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西社会与法制'),
+	(152,'山西文体生活','山西文体生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西文体生活'),
+	(153,'苏州4K','苏州4K',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 13:23:39','2025-12-01 06:41:31','^苏州4K'),
+	(154,'海南自贸','海南自贸',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南自贸'),
+	(155,'海南新闻','海南新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南新闻'),
+	(156,'海南公共','海南公共',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南公共'),
+	(157,'海南文旅','海南文旅',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南文旅'),
+	(158,'海南少儿','海南少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南少儿'),
+	(159,'中国天气','中国天气频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 16:07:39','2025-12-01 06:41:31','^中国天气'),
+	(161,'国学频道','国学频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-26 03:25:21','2025-12-01 06:41:31','^国学频道'),
+	(162,'厦视一套','厦视一套',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:12:07','2025-12-01 06:41:31','^厦视一套'),
+	(163,'厦视二套','厦视二套',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:12:07','2025-12-01 06:41:31','^厦视二套'),
+	(164,'江西都市','江西都市',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西都市'),
+	(165,'江西经济生活','江西经济生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西经济生活'),
+	(166,'江西公共农业','江西公共农业',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西公共农业'),
+	(167,'江西少儿','江西少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西少儿'),
+	(168,'江西新闻','江西新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西新闻'),
+	(169,'重温经典','重温经典',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^重温经典'),
+	(170,'河南新闻','河南新闻频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:45:14','2025-12-06 10:45:32','^河南新闻'),
+	(171,'河南都市','河南都市频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:48:42','^河南都市'),
+	(172,'河南民生','河南民生频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:48:50','^河南民生'),
+	(173,'河南法治','河南法治频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:02','^河南法治'),
+	(174,'河南公共','河南公共频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:51:41','^河南公共'),
+	(175,'河南乡村','河南乡村频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:52:11','^河南乡村'),
+	(176,'河南电视剧','河南电视剧频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:52:02','^河南电视剧'),
+	(177,'河南梨园','河南梨园频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:51:53','^河南梨园'),
+	(178,'河南文物宝库','河南文物宝库','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:50:52','^河南文物宝库'),
+	(179,'河南武术','河南武术频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:53','^河南武术'),
+	(180,'睛彩中原','睛彩中原','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:38','^睛彩中原'),
+	(181,'河南移动戏曲','河南移动戏曲频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:30','^河南移动戏曲'),
+	(182,'象视界','象视界','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:16','象视界'),
+	(183,'陕西移动电视','陕西移动电视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-06 11:09:06','2025-12-06 11:09:06','^陕西移动电视'),
+	(184,'甘肃卫视','甘肃卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^甘肃卫视'),
+	(185,'河北经济生活','河北经济生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北经济生活'),
+	(186,'河北三农','河北三农',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北三农'),
+	(187,'河北都市','河北都市',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北都市'),
+	(188,'河北影视剧','河北影视剧',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北影视剧'),
+	(189,'河北少儿科教','河北少儿科教',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北少儿科教'),
+	(190,'河北文旅公共','河北文旅公共',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^河北文旅公共');
+
+INSERT INTO "timezone" ("id", "name", "gmt_offset", "tz_name", "visible")
+VALUES
+	(1,'(GMT-12:00) Etc - GMT+12',-720,'Etc/GMT+12',1),
+	(2,'(GMT-11:00) Etc - GMT+11',-660,'Etc/GMT+11',0),
+	(3,'(GMT-11:00) Pacific - Apia',-660,'Pacific/Apia',0),
+	(4,'(GMT-11:00) Pacific - Midway',-660,'Pacific/Midway',1),
+	(5,'(GMT-11:00) Pacific - Niue',-660,'Pacific/Niue',0),
+	(6,'(GMT-11:00) Pacific - Pago Pago',-660,'Pacific/Pago_Pago',0),
+	(7,'(GMT-11:00) Pacific - Samoa',-660,'Pacific/Samoa',0),
+	(8,'(GMT-11:00) US - Samoa',-660,'US/Samoa',0),
+	(9,'(GMT-10:00) America - Adak',-600,'America/Adak',0),
+	(10,'(GMT-10:00) America - Atka',-600,'America/Atka',0),
+	(11,'(GMT-10:00) Etc - GMT+10',-600,'Etc/GMT+10',0),
+	(12,'(GMT-10:00) HST',-600,'HST',0),
+	(13,'(GMT-10:00) Pacific - Fakaofo',-600,'Pacific/Fakaofo',0),
+	(14,'(GMT-10:00) Pacific - Honolulu',-600,'Pacific/Honolulu',1),
+	(15,'(GMT-10:00) Pacific - Johnston',-600,'Pacific/Johnston',0),
+	(16,'(GMT-10:00) Pacific - Rarotonga',-600,'Pacific/Rarotonga',0),
+	(17,'(GMT-10:00) Pacific - Tahiti',-600,'Pacific/Tahiti',0),
+	(18,'(GMT-10:00) US - Aleutian',-600,'US/Aleutian',1),
+	(19,'(GMT-10:00) US - Hawaii',-600,'US/Hawaii',1),
+	(20,'(GMT-09:30) Pacific - Marquesas',-570,'Pacific/Marquesas',0),
+	(21,'(GMT-09:00) America - Anchorage',-540,'America/Anchorage',0),
+	(22,'(GMT-09:00) America - Juneau',-540,'America/Juneau',1),
+	(23,'(GMT-09:00) America - Nome',-540,'America/Nome',0),
+	(24,'(GMT-09:00) America - Yakutat',-540,'America/Yakutat',0),
+	(25,'(GMT-09:00) Etc - GMT+9',-540,'Etc/GMT+9',0),
+	(26,'(GMT-09:00) Pacific - Gambier',-540,'Pacific/Gambier',0),
+	(27,'(GMT-09:00) US - Alaska',-540,'US/Alaska',0),
+	(28,'(GMT-08:00) America - Dawson',-480,'America/Dawson',0),
+	(29,'(GMT-08:00) America - Ensenada',-480,'America/Ensenada',0),
+	(30,'(GMT-08:00) America - Los Angeles',-480,'America/Los_Angeles',1),
+	(31,'(GMT-08:00) America - Tijuana',-480,'America/Tijuana',1),
+	(32,'(GMT-08:00) America - Vancouver',-480,'America/Vancouver',0),
+	(33,'(GMT-08:00) America - Whitehorse',-480,'America/Whitehorse',0),
+	(34,'(GMT-08:00) Canada - Pacific',-480,'Canada/Pacific',0),
+	(35,'(GMT-08:00) Canada - Yukon',-480,'Canada/Yukon',0),
+	(36,'(GMT-08:00) Etc - GMT+8',-480,'Etc/GMT+8',0),
+	(37,'(GMT-08:00) Mexico - Baja Norte',-480,'Mexico/BajaNorte',0),
+	(38,'(GMT-08:00) PST8PDT',-480,'PST8PDT',0),
+	(39,'(GMT-08:00) Pacific - Pitcairn',-480,'Pacific/Pitcairn',0),
+	(40,'(GMT-08:00) US - Pacific',-480,'US/Pacific',0),
+	(41,'(GMT-08:00) US - Pacific-New',-480,'US/Pacific-New',0),
+	(42,'(GMT-07:00) America - Boise',-420,'America/Boise',0),
+	(43,'(GMT-07:00) America - Cambridge Bay',-420,'America/Cambridge_Bay',0),
+	(44,'(GMT-07:00) America - Chihuahua',-420,'America/Chihuahua',1),
+	(45,'(GMT-07:00) America - Dawson Creek',-420,'America/Dawson_Creek',0),
+	(46,'(GMT-07:00) America - Denver',-420,'America/Denver',1),
+	(47,'(GMT-07:00) America - Edmonton',-420,'America/Edmonton',0),
+	(48,'(GMT-07:00) America - Hermosillo',-420,'America/Hermosillo',0),
+	(49,'(GMT-07:00) America - Inuvik',-420,'America/Inuvik',0),
+	(50,'(GMT-07:00) America - Mazatlan',-420,'America/Mazatlan',0),
+	(51,'(GMT-07:00) America - Phoenix',-420,'America/Phoenix',1),
+	(52,'(GMT-07:00) America - Shiprock',-420,'America/Shiprock',0),
+	(53,'(GMT-07:00) America - Yellowknife',-420,'America/Yellowknife',0),
+	(54,'(GMT-07:00) Canada - Mountain',-420,'Canada/Mountain',0),
+	(55,'(GMT-07:00) Etc - GMT+7',-420,'Etc/GMT+7',0),
+	(56,'(GMT-07:00) MST',-420,'MST',0),
+	(57,'(GMT-07:00) MST7MDT',-420,'MST7MDT',0),
+	(58,'(GMT-07:00) Mexico - Baja Sur',-420,'Mexico/BajaSur',0),
+	(59,'(GMT-07:00) Navajo',-420,'Navajo',0),
+	(60,'(GMT-07:00) US - Arizona',-420,'US/Arizona',0),
+	(61,'(GMT-07:00) US - Mountain',-420,'US/Mountain',0),
+	(62,'(GMT-06:00) America - Belize',-360,'America/Belize',0),
+	(63,'(GMT-06:00) America - Cancun',-360,'America/Cancun',0),
+	(64,'(GMT-06:00) America - Center, North Dakota',-360,'America/North_Dakota/Center',0),
+	(65,'(GMT-06:00) America - Chicago',-360,'America/Chicago',1),
+	(66,'(GMT-06:00) America - Costa Rica',-360,'America/Costa_Rica',0),
+	(67,'(GMT-06:00) America - El Salvador',-360,'America/El_Salvador',0),
+	(68,'(GMT-06:00) America - Guatemala',-360,'America/Guatemala',0),
+	(69,'(GMT-06:00) America - Knox INN',-360,'America/Knox_IN',0),
+	(70,'(GMT-06:00) America - Knox, Indiana',-360,'America/Indiana/Knox',0),
+	(71,'(GMT-06:00) America - Managua',-360,'America/Managua',0),
+	(72,'(GMT-06:00) America - Menominee',-360,'America/Menominee',0),
+	(73,'(GMT-06:00) America - Merida',-360,'America/Merida',0),
+	(74,'(GMT-06:00) America - Mexico City',-360,'America/Mexico_City',1),
+	(75,'(GMT-06:00) America - Monterrey',-360,'America/Monterrey',0),
+	(76,'(GMT-06:00) America - New Salem, North Dakota',-360,'America/North_Dakota/New_Salem',0),
+	(77,'(GMT-06:00) America - Rainy River',-360,'America/Rainy_River',0),
+	(78,'(GMT-06:00) America - Rankin Inlet',-360,'America/Rankin_Inlet',0),
+	(79,'(GMT-06:00) America - Regina',-360,'America/Regina',1),
+	(80,'(GMT-06:00) America - Swift Current',-360,'America/Swift_Current',0),
+	(81,'(GMT-06:00) America - Tegucigalpa',-360,'America/Tegucigalpa',0),
+	(82,'(GMT-06:00) America - Tell City, Indiana',-360,'America/Indiana/Tell_City',0),
+	(83,'(GMT-06:00) America - Winnipeg',-360,'America/Winnipeg',0),
+	(84,'(GMT-06:00) CST6CDT',-360,'CST6CDT',0),
+	(85,'(GMT-06:00) Canada - Central',-360,'Canada/Central',0),
+	(86,'(GMT-06:00) Canada - East-Saskatchewan',-360,'Canada/East-Saskatchewan',0),
+	(87,'(GMT-06:00) Canada - Saskatchewan',-360,'Canada/Saskatchewan',0),
+	(88,'(GMT-06:00) Chile - Easter Island',-360,'Chile/EasterIsland',0),
+	(89,'(GMT-06:00) Etc - GMT+6',-360,'Etc/GMT+6',0),
+	(90,'(GMT-06:00) Mexico - General',-360,'Mexico/General',0),
+	(91,'(GMT-06:00) Pacific - Easter',-360,'Pacific/Easter',0),
+	(92,'(GMT-06:00) Pacific - Galapagos',-360,'Pacific/Galapagos',0),
+	(93,'(GMT-06:00) US - Central',-360,'US/Central',0),
+	(94,'(GMT-06:00) US - Indiana-Starke',-360,'US/Indiana-Starke',0),
+	(95,'(GMT-05:00) America - Atikokan',-300,'America/Atikokan',0),
+	(96,'(GMT-05:00) America - Bogota',-300,'America/Bogota',1),
+	(97,'(GMT-05:00) America - Cayman',-300,'America/Cayman',0),
+	(98,'(GMT-05:00) America - Coral Harbour',-300,'America/Coral_Harbour',0),
+	(99,'(GMT-05:00) America - Detroit',-300,'America/Detroit',0),
+	(100,'(GMT-05:00) America - Fort Wayne',-300,'America/Fort_Wayne',0),
+	(101,'(GMT-05:00) America - Grand Turk',-300,'America/Grand_Turk',0),
+	(102,'(GMT-05:00) America - Guayaquil',-300,'America/Guayaquil',0),
+	(103,'(GMT-05:00) America - Havana',-300,'America/Havana',0),
+	(104,'(GMT-05:00) America - Indianapolis',-300,'America/Indianapolis',0),
+	(105,'(GMT-05:00) America - Indianapolis, Indiana',-300,'America/Indiana/Indianapolis',1),
+	(106,'(GMT-05:00) America - Iqaluit',-300,'America/Iqaluit',0),
+	(107,'(GMT-05:00) America - Jamaica',-300,'America/Jamaica',0),
+	(108,'(GMT-05:00) America - Lima',-300,'America/Lima',0),
+	(109,'(GMT-05:00) America - Louisville',-300,'America/Louisville',0),
+	(110,'(GMT-05:00) America - Louisville, Kentucky',-300,'America/Kentucky/Louisville',0),
+	(111,'(GMT-05:00) America - Marengo, Indiana',-300,'America/Indiana/Marengo',0),
+	(112,'(GMT-05:00) America - Monticello, Kentucky',-300,'America/Kentucky/Monticello',0),
+	(113,'(GMT-05:00) America - Montreal',-300,'America/Montreal',0),
+	(114,'(GMT-05:00) America - Nassau',-300,'America/Nassau',0),
+	(115,'(GMT-05:00) America - New York',-300,'America/New_York',1),
+	(116,'(GMT-05:00) America - Nipigon',-300,'America/Nipigon',0),
+	(117,'(GMT-05:00) America - Panama',-300,'America/Panama',0),
+	(118,'(GMT-05:00) America - Pangnirtung',-300,'America/Pangnirtung',0),
+	(119,'(GMT-05:00) America - Petersburg, Indiana',-300,'America/Indiana/Petersburg',0),
+	(120,'(GMT-05:00) America - Port-au-Prince',-300,'America/Port-au-Prince',0),
+	(121,'(GMT-05:00) America - Resolute',-300,'America/Resolute',0),
+	(122,'(GMT-05:00) America - Thunder Bay',-300,'America/Thunder_Bay',0),
+	(123,'(GMT-05:00) America - Toronto',-300,'America/Toronto',0),
+	(124,'(GMT-05:00) America - Vevay, Indiana',-300,'America/Indiana/Vevay',0),
+	(125,'(GMT-05:00) America - Vincennes, Indiana',-300,'America/Indiana/Vincennes',0),
+	(126,'(GMT-05:00) America - Winamac, Indiana',-300,'America/Indiana/Winamac',0),
+	(127,'(GMT-05:00) Canada - Eastern',-300,'Canada/Eastern',0),
+	(128,'(GMT-05:00) Cuba',-300,'Cuba',0),
+	(129,'(GMT-05:00) EST',-300,'EST',0),
+	(130,'(GMT-05:00) EST5EDT',-300,'EST5EDT',0),
+	(131,'(GMT-05:00) Etc - GMT+5',-300,'Etc/GMT+5',0),
+	(132,'(GMT-05:00) Jamaica',-300,'Jamaica',0),
+	(133,'(GMT-05:00) US - East-Indiana',-300,'US/East-Indiana',0),
+	(134,'(GMT-05:00) US - Eastern',-300,'US/Eastern',0),
+	(135,'(GMT-05:00) US - Michigan',-300,'US/Michigan',0),
+	(136,'(GMT-04:30) America - Caracas',-270,'America/Caracas',0),
+	(137,'(GMT-04:00) America - Anguilla',-240,'America/Anguilla',0),
+	(138,'(GMT-04:00) America - Antigua',-240,'America/Antigua',0),
+	(139,'(GMT-04:00) America - Aruba',-240,'America/Aruba',0),
+	(140,'(GMT-04:00) America - Asuncion',-240,'America/Asuncion',0),
+	(141,'(GMT-04:00) America - Barbados',-240,'America/Barbados',0),
+	(142,'(GMT-04:00) America - Blanc-Sablon',-240,'America/Blanc-Sablon',0),
+	(143,'(GMT-04:00) America - Boa Vista',-240,'America/Boa_Vista',0),
+	(144,'(GMT-04:00) America - Campo Grande',-240,'America/Campo_Grande',0),
+	(145,'(GMT-04:00) America - Cuiaba',-240,'America/Cuiaba',0),
+	(146,'(GMT-04:00) America - Curacao',-240,'America/Curacao',0),
+	(147,'(GMT-04:00) America - Dominica',-240,'America/Dominica',0),
+	(148,'(GMT-04:00) America - Eirunepe',-240,'America/Eirunepe',0),
+	(149,'(GMT-04:00) America - Glace Bay',-240,'America/Glace_Bay',0),
+	(150,'(GMT-04:00) America - Goose Bay',-240,'America/Goose_Bay',0),
+	(151,'(GMT-04:00) America - Grenada',-240,'America/Grenada',0),
+	(152,'(GMT-04:00) America - Guadeloupe',-240,'America/Guadeloupe',0),
+	(153,'(GMT-04:00) America - Guyana',-240,'America/Guyana',0),
+	(154,'(GMT-04:00) America - Halifax',-240,'America/Halifax',1),
+	(155,'(GMT-04:00) America - La Paz',-240,'America/La_Paz',0),
+	(156,'(GMT-04:00) America - Manaus',-240,'America/Manaus',1),
+	(157,'(GMT-04:00) America - Marigot',-240,'America/Marigot',0),
+	(158,'(GMT-04:00) America - Martinique',-240,'America/Martinique',0),
+	(159,'(GMT-04:00) America - Moncton',-240,'America/Moncton',0),
+	(160,'(GMT-04:00) America - Montserrat',-240,'America/Montserrat',0),
+	(161,'(GMT-04:00) America - Port of Spain',-240,'America/Port_of_Spain',0),
+	(162,'(GMT-04:00) America - Porto Acre',-240,'America/Porto_Acre',0),
+	(163,'(GMT-04:00) America - Porto Velho',-240,'America/Porto_Velho',0),
+	(164,'(GMT-04:00) America - Puerto Rico',-240,'America/Puerto_Rico',0),
+	(165,'(GMT-04:00) America - Rio Branco',-240,'America/Rio_Branco',0),
+	(166,'(GMT-04:00) America - Santiago',-240,'America/Santiago',1),
+	(167,'(GMT-04:00) America - Santo Domingo',-240,'America/Santo_Domingo',0),
+	(168,'(GMT-04:00) America - St Barthelemy',-240,'America/St_Barthelemy',0),
+	(169,'(GMT-04:00) America - St Kitts',-240,'America/St_Kitts',0),
+	(170,'(GMT-04:00) America - St Lucia',-240,'America/St_Lucia',0),
+	(171,'(GMT-04:00) America - St Thomas',-240,'America/St_Thomas',0),
+	(172,'(GMT-04:00) America - St Vincent',-240,'America/St_Vincent',0),
+	(173,'(GMT-04:00) America - Thule',-240,'America/Thule',0),
+	(174,'(GMT-04:00) America - Tortola',-240,'America/Tortola',0),
+	(175,'(GMT-04:00) America - Virgin',-240,'America/Virgin',0),
+	(176,'(GMT-04:00) Antarctica - Palmer',-240,'Antarctica/Palmer',0),
+	(177,'(GMT-04:00) Atlantic - Bermuda',-240,'Atlantic/Bermuda',0),
+	(178,'(GMT-04:00) Atlantic - Stanley',-240,'Atlantic/Stanley',0),
+	(179,'(GMT-04:00) Brazil - Acre',-240,'Brazil/Acre',0),
+	(180,'(GMT-04:00) Brazil - West',-240,'Brazil/West',0),
+	(181,'(GMT-04:00) Canada - Atlantic',-240,'Canada/Atlantic',0),
+	(182,'(GMT-04:00) Chile - Continental',-240,'Chile/Continental',0),
+	(183,'(GMT-04:00) Etc - GMT+4',-240,'Etc/GMT+4',0),
+	(184,'(GMT-03:30) America - St Johns',-210,'America/St_Johns',0),
+	(185,'(GMT-03:30) Canada - Newfoundland',-210,'Canada/Newfoundland',0),
+	(186,'(GMT-03:00) America - Araguaina',-180,'America/Araguaina',0),
+	(187,'(GMT-03:00) America - Bahia',-180,'America/Bahia',0),
+	(188,'(GMT-03:00) America - Belem',-180,'America/Belem',0),
+	(189,'(GMT-03:00) America - Buenos Aires',-180,'America/Buenos_Aires',0),
+	(190,'(GMT-03:00) America - Buenos Aires, Argentina',-180,'America/Argentina/Buenos_Aires',1),
+	(191,'(GMT-03:00) America - Catamarca',-180,'America/Catamarca',0),
+	(192,'(GMT-03:00) America - Catamarca, Argentina',-180,'America/Argentina/Catamarca',0),
+	(193,'(GMT-03:00) America - Cayenne',-180,'America/Cayenne',0),
+	(194,'(GMT-03:00) America - Comod Rivadavia, Argentina',-180,'America/Argentina/ComodRivadavia',0),
+	(195,'(GMT-03:00) America - Cordoba',-180,'America/Cordoba',0),
+	(196,'(GMT-03:00) America - Cordoba, Argentina',-180,'America/Argentina/Cordoba',0),
+	(197,'(GMT-03:00) America - Fortaleza',-180,'America/Fortaleza',0),
+	(198,'(GMT-03:00) America - Godthab',-180,'America/Godthab',1),
+	(199,'(GMT-03:00) America - Jujuy',-180,'America/Jujuy',0),
+	(200,'(GMT-03:00) America - Jujuy, Argentina',-180,'America/Argentina/Jujuy',0),
+	(201,'(GMT-03:00) America - La Rioja, Argentina',-180,'America/Argentina/La_Rioja',0),
+	(202,'(GMT-03:00) America - Maceio',-180,'America/Maceio',0),
+	(203,'(GMT-03:00) America - Mendoza',-180,'America/Mendoza',0),
+	(204,'(GMT-03:00) America - Mendoza, Argentina',-180,'America/Argentina/Mendoza',0),
+	(205,'(GMT-03:00) America - Miquelon',-180,'America/Miquelon',0),
+	(206,'(GMT-03:00) America - Montevideo',-180,'America/Montevideo',1),
+	(207,'(GMT-03:00) America - Paramaribo',-180,'America/Paramaribo',0),
+	(208,'(GMT-03:00) America - Recife',-180,'America/Recife',0),
+	(209,'(GMT-03:00) America - Rio Gallegos, Argentina',-180,'America/Argentina/Rio_Gallegos',0),
+	(210,'(GMT-03:00) America - Rosario',-180,'America/Rosario',0),
+	(211,'(GMT-03:00) America - Salta, Argentina',-180,'America/Argentina/Salta',0),
+	(212,'(GMT-03:00) America - San Juan, Argentina',-180,'America/Argentina/San_Juan',0),
+	(213,'(GMT-03:00) America - San Luis, Argentina',-180,'America/Argentina/San_Luis',0),
+	(214,'(GMT-03:00) America - Santarem',-180,'America/Santarem',0),
+	(215,'(GMT-03:00) America - Sao Paulo',-180,'America/Sao_Paulo',0),
+	(216,'(GMT-03:00) America - Tucuman, Argentina',-180,'America/Argentina/Tucuman',0),
+	(217,'(GMT-03:00) America - Ushuaia, Argentina',-180,'America/Argentina/Ushuaia',0),
+	(218,'(GMT-03:00) Antarctica - Rothera',-180,'Antarctica/Rothera',0),
+	(219,'(GMT-03:00) Brazil - East',-180,'Brazil/East',0),
+	(220,'(GMT-03:00) Etc - GMT+3',-180,'Etc/GMT+3',0),
+	(221,'(GMT-02:00) America - Noronha',-120,'America/Noronha',0),
+	(222,'(GMT-02:00) Atlantic - South Georgia',-120,'Atlantic/South_Georgia',1),
+	(223,'(GMT-02:00) Brazil - De Noronha',-120,'Brazil/DeNoronha',0),
+	(224,'(GMT-02:00) Etc - GMT+2',-120,'Etc/GMT+2',0),
+	(225,'(GMT-01:00) America - Scoresbysund',-60,'America/Scoresbysund',0),
+	(226,'(GMT-01:00) Atlantic - Azores',-60,'Atlantic/Azores',1),
+	(227,'(GMT-01:00) Atlantic - Cape Verde',-60,'Atlantic/Cape_Verde',1),
+	(228,'(GMT-01:00) Etc - GMT+1',-60,'Etc/GMT+1',0),
+	(229,'(GMT+00:00) Africa - Abidjan',0,'Africa/Abidjan',0),
+	(230,'(GMT+00:00) Africa - Accra',0,'Africa/Accra',0),
+	(231,'(GMT+00:00) Africa - Bamako',0,'Africa/Bamako',0),
+	(232,'(GMT+00:00) Africa - Banjul',0,'Africa/Banjul',0),
+	(233,'(GMT+00:00) Africa - Bissau',0,'Africa/Bissau',0),
+	(234,'(GMT+00:00) Africa - Casablanca',0,'Africa/Casablanca',1),
+	(235,'(GMT+00:00) Africa - Conakry',0,'Africa/Conakry',0),
+	(236,'(GMT+00:00) Africa - Dakar',0,'Africa/Dakar',0),
+	(237,'(GMT+00:00) Africa - El Aaiun',0,'Africa/El_Aaiun',0),
+	(238,'(GMT+00:00) Africa - Freetown',0,'Africa/Freetown',0),
+	(239,'(GMT+00:00) Africa - Lome',0,'Africa/Lome',0),
+	(240,'(GMT+00:00) Africa - Monrovia',0,'Africa/Monrovia',0),
+	(241,'(GMT+00:00) Africa - Nouakchott',0,'Africa/Nouakchott',0),
+	(242,'(GMT+00:00) Africa - Ouagadougou',0,'Africa/Ouagadougou',0),
+	(243,'(GMT+00:00) Africa - Sao Tome',0,'Africa/Sao_Tome',0),
+	(244,'(GMT+00:00) Africa - Timbuktu',0,'Africa/Timbuktu',0),
+	(245,'(GMT+00:00) America - Danmarkshavn',0,'America/Danmarkshavn',0),
+	(246,'(GMT+00:00) Atlantic - Canary',0,'Atlantic/Canary',0),
+	(247,'(GMT+00:00) Atlantic - Faeroe',0,'Atlantic/Faeroe',0),
+	(248,'(GMT+00:00) Atlantic - Faroe',0,'Atlantic/Faroe',0),
+	(249,'(GMT+00:00) Atlantic - Madeira',0,'Atlantic/Madeira',0),
+	(250,'(GMT+00:00) Atlantic - Reykjavik',0,'Atlantic/Reykjavik',0),
+	(251,'(GMT+00:00) Atlantic - St Helena',0,'Atlantic/St_Helena',0),
+	(252,'(GMT+00:00) Eire',0,'Eire',0),
+	(253,'(GMT+00:00) Etc - GMT',0,'Etc/GMT',1),
+	(254,'(GMT+00:00) Etc - GMT+0',0,'Etc/GMT+0',0),
+	(255,'(GMT+00:00) Etc - GMT-0',0,'Etc/GMT-0',0),
+	(256,'(GMT+00:00) Etc - GMT0',0,'Etc/GMT0',0),
+	(257,'(GMT+00:00) Etc - Greenwich',0,'Etc/Greenwich',0),
+	(258,'(GMT+00:00) Etc - UCT',0,'Etc/UCT',0),
+	(259,'(GMT+00:00) Etc - UTC',0,'Etc/UTC',0),
+	(260,'(GMT+00:00) Etc - Universal',0,'Etc/Universal',0),
+	(261,'(GMT+00:00) Etc - Zulu',0,'Etc/Zulu',0),
+	(262,'(GMT+00:00) Europe - Belfast',0,'Europe/Belfast',0),
+	(263,'(GMT+00:00) Europe - Dublin',0,'Europe/Dublin',0),
+	(264,'(GMT+00:00) Europe - Guernsey',0,'Europe/Guernsey',0),
+	(265,'(GMT+00:00) Europe - Isle of Man',0,'Europe/Isle_of_Man',0),
+	(266,'(GMT+00:00) Europe - Jersey',0,'Europe/Jersey',0),
+	(267,'(GMT+00:00) Europe - Lisbon',0,'Europe/Lisbon',0),
+	(268,'(GMT+00:00) Europe - London',0,'Europe/London',1),
+	(269,'(GMT+00:00) GB',0,'GB',0),
+	(270,'(GMT+00:00) GB-Eire',0,'GB-Eire',0),
+	(271,'(GMT+00:00) GMT',0,'GMT',0),
+	(272,'(GMT+00:00) GMT+0',0,'GMT+0',0),
+	(273,'(GMT+00:00) GMT-0',0,'GMT-0',0),
+	(274,'(GMT+00:00) GMT0',0,'GMT0',0),
+	(275,'(GMT+00:00) Greenwich',0,'Greenwich',0),
+	(276,'(GMT+00:00) Iceland',0,'Iceland',0),
+	(277,'(GMT+00:00) Portugal',0,'Portugal',0),
+	(278,'(GMT+00:00) UCT',0,'UCT',0),
+	(279,'(GMT+00:00) UTC',0,'UTC',0),
+	(280,'(GMT+00:00) Universal',0,'Universal',0),
+	(281,'(GMT+00:00) WET',0,'WET',0),
+	(282,'(GMT+00:00) Zulu',0,'Zulu',0),
+	(283,'(GMT+01:00) Africa - Algiers',60,'Africa/Algiers',1),
+	(284,'(GMT+01:00) Africa - Bangui',60,'Africa/Bangui',0),
+	(285,'(GMT+01:00) Africa - Brazzaville',60,'Africa/Brazzaville',0),
+	(286,'(GMT+01:00) Africa - Ceuta',60,'Africa/Ceuta',0),
+	(287,'(GMT+01:00) Africa - Douala',60,'Africa/Douala',0),
+	(288,'(GMT+01:00) Africa - Kinshasa',60,'Africa/Kinshasa',0),
+	(289,'(GMT+01:00) Africa - Lagos',60,'Africa/Lagos',0),
+	(290,'(GMT+01:00) Africa - Libreville',60,'Africa/Libreville',0),
+	(291,'(GMT+01:00) Africa - Luanda',60,'Africa/Luanda',0),
+	(292,'(GMT+01:00) Africa - Malabo',60,'Africa/Malabo',0),
+	(293,'(GMT+01:00) Africa - Ndjamena',60,'Africa/Ndjamena',0),
+	(294,'(GMT+01:00) Africa - Niamey',60,'Africa/Niamey',0),
+	(295,'(GMT+01:00) Africa - Porto-Novo',60,'Africa/Porto-Novo',0),
+	(296,'(GMT+01:00) Africa - Tunis',60,'Africa/Tunis',0),
+	(297,'(GMT+01:00) Africa - Windhoek',60,'Africa/Windhoek',1),
+	(298,'(GMT+01:00) Arctic - Longyearbyen',60,'Arctic/Longyearbyen',0),
+	(299,'(GMT+01:00) Atlantic - Jan Mayen',60,'Atlantic/Jan_Mayen',0),
+	(300,'(GMT+01:00) CET',60,'CET',1),
+	(301,'(GMT+01:00) Etc - GMT-1',60,'Etc/GMT-1',0),
+	(302,'(GMT+01:00) Europe - Amsterdam',60,'Europe/Amsterdam',1),
+	(303,'(GMT+01:00) Europe - Andorra',60,'Europe/Andorra',0),
+	(304,'(GMT+01:00) Europe - Belgrade',60,'Europe/Belgrade',1),
+	(305,'(GMT+01:00) Europe - Berlin',60,'Europe/Berlin',0),
+	(306,'(GMT+01:00) Europe - Bratislava',60,'Europe/Bratislava',0),
+	(307,'(GMT+01:00) Europe - Brussels',60,'Europe/Brussels',1),
+	(308,'(GMT+01:00) Europe - Budapest',60,'Europe/Budapest',0),
+	(309,'(GMT+01:00) Europe - Copenhagen',60,'Europe/Copenhagen',0),
+	(310,'(GMT+01:00) Europe - Gibraltar',60,'Europe/Gibraltar',0),
+	(311,'(GMT+01:00) Europe - Ljubljana',60,'Europe/Ljubljana',0),
+	(312,'(GMT+01:00) Europe - Luxembourg',60,'Europe/Luxembourg',0),
+	(313,'(GMT+01:00) Europe - Madrid',60,'Europe/Madrid',0),
+	(314,'(GMT+01:00) Europe - Malta',60,'Europe/Malta',0),
+	(315,'(GMT+01:00) Europe - Monaco',60,'Europe/Monaco',0),
+	(316,'(GMT+01:00) Europe - Oslo',60,'Europe/Oslo',0),
+	(317,'(GMT+01:00) Europe - Paris',60,'Europe/Paris',0),
+	(318,'(GMT+01:00) Europe - Podgorica',60,'Europe/Podgorica',0),
+	(319,'(GMT+01:00) Europe - Prague',60,'Europe/Prague',0),
+	(320,'(GMT+01:00) Europe - Rome',60,'Europe/Rome',0),
+	(321,'(GMT+01:00) Europe - San Marino',60,'Europe/San_Marino',0),
+	(322,'(GMT+01:00) Europe - Sarajevo',60,'Europe/Sarajevo',1),
+	(323,'(GMT+01:00) Europe - Skopje',60,'Europe/Skopje',0),
+	(324,'(GMT+01:00) Europe - Stockholm',60,'Europe/Stockholm',0),
+	(325,'(GMT+01:00) Europe - Tirane',60,'Europe/Tirane',0),
+	(326,'(GMT+01:00) Europe - Vaduz',60,'Europe/Vaduz',0),
+	(327,'(GMT+01:00) Europe - Vatican',60,'Europe/Vatican',0),
+	(328,'(GMT+01:00) Europe - Vienna',60,'Europe/Vienna',0),
+	(329,'(GMT+01:00) Europe - Warsaw',60,'Europe/Warsaw',0),
+	(330,'(GMT+01:00) Europe - Zagreb',60,'Europe/Zagreb',0),
+	(331,'(GMT+01:00) Europe - Zurich',60,'Europe/Zurich',0),
+	(332,'(GMT+01:00) MET',60,'MET',0),
+	(333,'(GMT+01:00) Poland',60,'Poland',0),
+	(334,'(GMT+02:00) Africa - Blantyre',120,'Africa/Blantyre',0),
+	(335,'(GMT+02:00) Africa - Bujumbura',120,'Africa/Bujumbura',0),
+	(336,'(GMT+02:00) Africa - Cairo',120,'Africa/Cairo',1),
+	(337,'(GMT+02:00) Africa - Gaborone',120,'Africa/Gaborone',0),
+	(338,'(GMT+02:00) Africa - Harare',120,'Africa/Harare',1),
+	(339,'(GMT+02:00) Africa - Johannesburg',120,'Africa/Johannesburg',0),
+	(340,'(GMT+02:00) Africa - Kigali',120,'Africa/Kigali',0),
+	(341,'(GMT+02:00) Africa - Lubumbashi',120,'Africa/Lubumbashi',0),
+	(342,'(GMT+02:00) Africa - Lusaka',120,'Africa/Lusaka',0),
+	(343,'(GMT+02:00) Africa - Maputo',120,'Africa/Maputo',0),
+	(344,'(GMT+02:00) Africa - Maseru',120,'Africa/Maseru',0),
+	(345,'(GMT+02:00) Africa - Mbabane',120,'Africa/Mbabane',0),
+	(346,'(GMT+02:00) Africa - Tripoli',120,'Africa/Tripoli',0),
+	(347,'(GMT+02:00) Asia - Amman',120,'Asia/Amman',1),
+	(348,'(GMT+02:00) Asia - Beirut',120,'Asia/Beirut',1),
+	(349,'(GMT+02:00) Asia - Damascus',120,'Asia/Damascus',0),
+	(350,'(GMT+02:00) Asia - Gaza',120,'Asia/Gaza',0),
+	(351,'(GMT+02:00) Asia - Istanbul',120,'Asia/Istanbul',0),
+	(352,'(GMT+02:00) Asia - Jerusalem',120,'Asia/Jerusalem',1),
+	(353,'(GMT+02:00) Asia - Nicosia',120,'Asia/Nicosia',0),
+	(354,'(GMT+02:00) Asia - Tel Aviv',120,'Asia/Tel_Aviv',0),
+	(355,'(GMT+02:00) EET',120,'EET',1),
+	(356,'(GMT+02:00) Egypt',120,'Egypt',0),
+	(357,'(GMT+02:00) Etc - GMT-2',120,'Etc/GMT-2',0),
+	(358,'(GMT+02:00) Europe - Athens',120,'Europe/Athens',1),
+	(359,'(GMT+02:00) Europe - Bucharest',120,'Europe/Bucharest',0),
+	(360,'(GMT+02:00) Europe - Chisinau',120,'Europe/Chisinau',0),
+	(361,'(GMT+02:00) Europe - Helsinki',120,'Europe/Helsinki',1),
+	(362,'(GMT+02:00) Europe - Istanbul',120,'Europe/Istanbul',0),
+	(363,'(GMT+02:00) Europe - Kaliningrad',120,'Europe/Kaliningrad',0),
+	(364,'(GMT+02:00) Europe - Kiev',120,'Europe/Kiev',0),
+	(365,'(GMT+02:00) Europe - Mariehamn',120,'Europe/Mariehamn',0),
+	(366,'(GMT+03:00) Europe - Minsk',180,'Europe/Minsk',1),
+	(367,'(GMT+02:00) Europe - Nicosia',120,'Europe/Nicosia',0),
+	(368,'(GMT+02:00) Europe - Riga',120,'Europe/Riga',0),
+	(369,'(GMT+02:00) Europe - Simferopol',120,'Europe/Simferopol',0),
+	(370,'(GMT+02:00) Europe - Sofia',120,'Europe/Sofia',0),
+	(371,'(GMT+02:00) Europe - Tallinn',120,'Europe/Tallinn',0),
+	(372,'(GMT+02:00) Europe - Tiraspol',120,'Europe/Tiraspol',0),
+	(373,'(GMT+02:00) Europe - Uzhgorod',120,'Europe/Uzhgorod',0),
+	(374,'(GMT+02:00) Europe - Vilnius',120,'Europe/Vilnius',0),
+	(375,'(GMT+02:00) Europe - Zaporozhye',120,'Europe/Zaporozhye',0),
+	(376,'(GMT+02:00) Israel',120,'Israel',0),
+	(377,'(GMT+02:00) Libya',120,'Libya',0),
+	(378,'(GMT+02:00) Turkey',120,'Turkey',0),
+	(379,'(GMT+03:00) Africa - Addis Ababa',180,'Africa/Addis_Ababa',0),
+	(380,'(GMT+03:00) Africa - Asmara',180,'Africa/Asmara',0),
+	(381,'(GMT+03:00) Africa - Asmera',180,'Africa/Asmera',0),
+	(382,'(GMT+03:00) Africa - Dar es Salaam',180,'Africa/Dar_es_Salaam',0),
+	(383,'(GMT+03:00) Africa - Djibouti',180,'Africa/Djibouti',0),
+	(384,'(GMT+03:00) Africa - Kampala',180,'Africa/Kampala',0),
+	(385,'(GMT+03:00) Africa - Khartoum',180,'Africa/Khartoum',0),
+	(386,'(GMT+03:00) Africa - Mogadishu',180,'Africa/Mogadishu',0),
+	(387,'(GMT+03:00) Africa - Nairobi',180,'Africa/Nairobi',1),
+	(388,'(GMT+03:00) Antarctica - Syowa',180,'Antarctica/Syowa',0),
+	(389,'(GMT+03:00) Asia - Aden',180,'Asia/Aden',0),
+	(390,'(GMT+03:00) Asia - Baghdad',180,'Asia/Baghdad',1),
+	(391,'(GMT+03:00) Asia - Bahrain',180,'Asia/Bahrain',0),
+	(392,'(GMT+03:00) Asia - Kuwait',180,'Asia/Kuwait',1),
+	(393,'(GMT+03:00) Asia - Qatar',180,'Asia/Qatar',0),
+	(394,'(GMT+03:00) Asia - Riyadh',180,'Asia/Riyadh',0),
+	(395,'(GMT+03:00) Etc - GMT-3',180,'Etc/GMT-3',0),
+	(396,'(GMT+03:00) Europe - Moscow',180,'Europe/Moscow',1),
+	(397,'(GMT+03:00) Europe - Volgograd',180,'Europe/Volgograd',0),
+	(398,'(GMT+03:00) Indian - Antananarivo',180,'Indian/Antananarivo',0),
+	(399,'(GMT+03:00) Indian - Comoro',180,'Indian/Comoro',0),
+	(400,'(GMT+03:00) Indian - Mayotte',180,'Indian/Mayotte',0),
+	(401,'(GMT+03:00) W-SU',180,'W-SU',0),
+	(402,'(GMT+03:07) Asia - Riyadh87',187,'Asia/Riyadh87',0),
+	(403,'(GMT+03:07) Asia - Riyadh88',187,'Asia/Riyadh88',0),
+	(404,'(GMT+03:07) Asia - Riyadh89',187,'Asia/Riyadh89',0),
+	(405,'(GMT+03:07) Mideast - Riyadh87',187,'Mideast/Riyadh87',0),
+	(406,'(GMT+03:07) Mideast - Riyadh88',187,'Mideast/Riyadh88',0),
+	(407,'(GMT+03:07) Mideast - Riyadh89',187,'Mideast/Riyadh89',0),
+	(408,'(GMT+03:30) Asia - Tehran',210,'Asia/Tehran',0),
+	(409,'(GMT+03:30) Iran',210,'Iran',0),
+	(410,'(GMT+04:00) Asia - Baku',240,'Asia/Baku',1),
+	(411,'(GMT+04:00) Asia - Dubai',240,'Asia/Dubai',0),
+	(412,'(GMT+04:00) Asia - Muscat',240,'Asia/Muscat',1),
+	(413,'(GMT+04:00) Asia - Tbilisi',240,'Asia/Tbilisi',1),
+	(414,'(GMT+04:00) Asia - Yerevan',240,'Asia/Yerevan',1),
+	(415,'(GMT+04:00) Etc - GMT-4',240,'Etc/GMT-4',0),
+	(416,'(GMT+04:00) Europe - Samara',240,'Europe/Samara',0),
+	(417,'(GMT+04:00) Indian - Mahe',240,'Indian/Mahe',0),
+	(418,'(GMT+04:00) Indian - Mauritius',240,'Indian/Mauritius',0),
+	(419,'(GMT+04:00) Indian - Reunion',240,'Indian/Reunion',0),
+	(420,'(GMT+04:30) Asia - Kabul',270,'Asia/Kabul',0),
+	(421,'(GMT+05:00) Asia - Aqtau',300,'Asia/Aqtau',0),
+	(422,'(GMT+05:00) Asia - Aqtobe',300,'Asia/Aqtobe',0),
+	(423,'(GMT+05:00) Asia - Ashgabat',300,'Asia/Ashgabat',0),
+	(424,'(GMT+05:00) Asia - Ashkhabad',300,'Asia/Ashkhabad',0),
+	(425,'(GMT+05:00) Asia - Dushanbe',300,'Asia/Dushanbe',0),
+	(426,'(GMT+05:00) Asia - Karachi',300,'Asia/Karachi',1),
+	(427,'(GMT+05:00) Asia - Oral',300,'Asia/Oral',0),
+	(428,'(GMT+05:00) Asia - Samarkand',300,'Asia/Samarkand',0),
+	(429,'(GMT+05:00) Asia - Tashkent',300,'Asia/Tashkent',0),
+	(430,'(GMT+05:00) Asia - Yekaterinburg',300,'Asia/Yekaterinburg',1),
+	(431,'(GMT+05:00) Etc - GMT-5',300,'Etc/GMT-5',0),
+	(432,'(GMT+05:00) Indian - Kerguelen',300,'Indian/Kerguelen',0),
+	(433,'(GMT+05:00) Indian - Maldives',300,'Indian/Maldives',0),
+	(434,'(GMT+05:30) Asia - Calcutta',330,'Asia/Calcutta',0),
+	(435,'(GMT+05:30) Asia - Colombo',330,'Asia/Colombo',0),
+	(436,'(GMT+05:30) Asia - Kolkata',330,'Asia/Kolkata',1),
+	(437,'(GMT+05:45) Asia - Katmandu',345,'Asia/Katmandu',0),
+	(438,'(GMT+06:00) Antarctica - Mawson',360,'Antarctica/Mawson',0),
+	(439,'(GMT+06:00) Antarctica - Vostok',360,'Antarctica/Vostok',0),
+	(440,'(GMT+06:00) Asia - Almaty',360,'Asia/Almaty',1),
+	(441,'(GMT+06:00) Asia - Bishkek',360,'Asia/Bishkek',0),
+	(442,'(GMT+06:00) Asia - Dacca',360,'Asia/Dacca',0),
+	(443,'(GMT+06:00) Asia - Dhaka',360,'Asia/Dhaka',1),
+	(444,'(GMT+06:00) Asia - Novosibirsk',360,'Asia/Novosibirsk',0),
+	(445,'(GMT+06:00) Asia - Omsk',360,'Asia/Omsk',0),
+	(446,'(GMT+06:00) Asia - Qyzylorda',360,'Asia/Qyzylorda',0),
+	(447,'(GMT+06:00) Asia - Thimbu',360,'Asia/Thimbu',0),
+	(448,'(GMT+06:00) Asia - Thimphu',360,'Asia/Thimphu',0),
+	(449,'(GMT+06:00) Etc - GMT-6',360,'Etc/GMT-6',0),
+	(450,'(GMT+06:00) Indian - Chagos',360,'Indian/Chagos',0),
+	(451,'(GMT+06:30) Asia - Rangoon',390,'Asia/Rangoon',0),
+	(452,'(GMT+06:30) Indian - Cocos',390,'Indian/Cocos',0),
+	(453,'(GMT+07:00) Antarctica - Davis',420,'Antarctica/Davis',0),
+	(454,'(GMT+07:00) Asia - Bangkok',420,'Asia/Bangkok',1),
+	(455,'(GMT+07:00) Asia - Ho Chi Minh',420,'Asia/Ho_Chi_Minh',0),
+	(456,'(GMT+07:00) Asia - Hovd',420,'Asia/Hovd',0),
+	(457,'(GMT+07:00) Asia - Jakarta',420,'Asia/Jakarta',0),
+	(458,'(GMT+07:00) Asia - Krasnoyarsk',420,'Asia/Krasnoyarsk',1),
+	(459,'(GMT+07:00) Asia - Phnom Penh',420,'Asia/Phnom_Penh',0),
+	(460,'(GMT+07:00) Asia - Pontianak',420,'Asia/Pontianak',0),
+	(461,'(GMT+07:00) Asia - Saigon',420,'Asia/Saigon',0),
+	(462,'(GMT+07:00) Asia - Vientiane',420,'Asia/Vientiane',0),
+	(463,'(GMT+07:00) Etc - GMT-7',420,'Etc/GMT-7',0),
+	(464,'(GMT+07:00) Indian - Christmas',420,'Indian/Christmas',0),
+	(465,'(GMT+08:00) Antarctica - Casey',480,'Antarctica/Casey',0),
+	(466,'(GMT+08:00) Asia - Brunei',480,'Asia/Brunei',0),
+	(467,'(GMT+08:00) Asia - Choibalsan',480,'Asia/Choibalsan',0),
+	(468,'(GMT+08:00) Asia - Chongqing',480,'Asia/Chongqing',0),
+	(469,'(GMT+08:00) Asia - Chungking',480,'Asia/Chungking',0),
+	(470,'(GMT+08:00) Asia - Harbin',480,'Asia/Harbin',0),
+	(471,'(GMT+08:00) Asia - Hong Kong',480,'Asia/Hong_Kong',0),
+	(472,'(GMT+08:00) Asia - Irkutsk',480,'Asia/Irkutsk',1),
+	(473,'(GMT+08:00) Asia - Kashgar',480,'Asia/Kashgar',0),
+	(474,'(GMT+08:00) Asia - Kuala Lumpur',480,'Asia/Kuala_Lumpur',1),
+	(475,'(GMT+08:00) Asia - Kuching',480,'Asia/Kuching',0),
+	(476,'(GMT+08:00) Asia - Macao',480,'Asia/Macao',0),
+	(477,'(GMT+08:00) Asia - Macau',480,'Asia/Macau',0),
+	(478,'(GMT+08:00) Asia - Makassar',480,'Asia/Makassar',0),
+	(479,'(GMT+08:00) Asia - Manila',480,'Asia/Manila',0),
+	(480,'(GMT+08:00) Asia - Shanghai',480,'Asia/Shanghai',1),
+	(481,'(GMT+08:00) Asia - Singapore',480,'Asia/Singapore',0),
+	(482,'(GMT+08:00) Asia - Taipei',480,'Asia/Taipei',1),
+	(483,'(GMT+08:00) Asia - Ujung Pandang',480,'Asia/Ujung_Pandang',0),
+	(484,'(GMT+08:00) Asia - Ulaanbaatar',480,'Asia/Ulaanbaatar',0),
+	(485,'(GMT+08:00) Asia - Ulan Bator',480,'Asia/Ulan_Bator',0),
+	(486,'(GMT+08:00) Asia - Urumqi',480,'Asia/Urumqi',0),
+	(487,'(GMT+08:00) Australia - Perth',480,'Australia/Perth',1),
+	(488,'(GMT+08:00) Australia - West',480,'Australia/West',0),
+	(489,'(GMT+08:00) Etc - GMT-8',480,'Etc/GMT-8',0),
+	(490,'(GMT+08:00) Hongkong',480,'Hongkong',0),
+	(491,'(GMT+08:00) PRC',480,'PRC',0),
+	(492,'(GMT+08:00) ROC',480,'ROC',0),
+	(493,'(GMT+08:00) Singapore',480,'Singapore',0),
+	(494,'(GMT+08:45) Australia - Eucla',525,'Australia/Eucla',0),
+	(495,'(GMT+09:00) Asia - Dili',540,'Asia/Dili',0),
+	(496,'(GMT+09:00) Asia - Jayapura',540,'Asia/Jayapura',0),
+	(497,'(GMT+09:00) Asia - Pyongyang',540,'Asia/Pyongyang',0),
+	(498,'(GMT+09:00) Asia - Seoul',540,'Asia/Seoul',1),
+	(499,'(GMT+09:00) Asia - Tokyo',540,'Asia/Tokyo',1),
+	(500,'(GMT+09:00) Asia - Yakutsk',540,'Asia/Yakutsk',1),
+	(501,'(GMT+09:00) Etc - GMT-9',540,'Etc/GMT-9',0),
+	(502,'(GMT+09:00) Japan',540,'Japan',0),
+	(503,'(GMT+09:00) Pacific - Palau',540,'Pacific/Palau',0),
+	(504,'(GMT+09:00) ROK',540,'ROK',0),
+	(505,'(GMT+09:30) Australia - Adelaide',570,'Australia/Adelaide',1),
+	(506,'(GMT+09:30) Australia - Broken Hill',570,'Australia/Broken_Hill',0),
+	(507,'(GMT+09:30) Australia - Darwin',570,'Australia/Darwin',0),
+	(508,'(GMT+09:30) Australia - North',570,'Australia/North',0),
+	(509,'(GMT+09:30) Australia - South',570,'Australia/South',0),
+	(510,'(GMT+09:30) Australia - Yancowinna',570,'Australia/Yancowinna',0),
+	(511,'(GMT+10:00) Antarctica - Dumont DUrvilleUrville',600,'Antarctica/DumontDUrville',0),
+	(512,'(GMT+10:00) Asia - Sakhalin',600,'Asia/Sakhalin',0),
+	(513,'(GMT+10:00) Asia - Vladivostok',600,'Asia/Vladivostok',1),
+	(514,'(GMT+10:00) Australia - ACT',600,'Australia/ACT',0),
+	(515,'(GMT+10:00) Australia - Brisbane',600,'Australia/Brisbane',1),
+	(516,'(GMT+10:00) Australia - Canberra',600,'Australia/Canberra',0),
+	(517,'(GMT+10:00) Australia - Currie',600,'Australia/Currie',0),
+	(518,'(GMT+10:00) Australia - Hobart',600,'Australia/Hobart',1),
+	(519,'(GMT+10:00) Australia - Lindeman',600,'Australia/Lindeman',0),
+	(520,'(GMT+10:00) Australia - Melbourne',600,'Australia/Melbourne',1),
+	(521,'(GMT+10:00) Australia - NSW',600,'Australia/NSW',0),
+	(522,'(GMT+10:00) Australia - Queensland',600,'Australia/Queensland',0),
+	(523,'(GMT+10:00) Australia - Sydney',600,'Australia/Sydney',0),
+	(524,'(GMT+10:00) Australia - Tasmania',600,'Australia/Tasmania',0),
+	(525,'(GMT+10:00) Australia - Victoria',600,'Australia/Victoria',0),
+	(526,'(GMT+10:00) Etc - GMT-10',600,'Etc/GMT-10',0),
+	(527,'(GMT+10:00) Pacific - Guam',600,'Pacific/Guam',0),
+	(528,'(GMT+10:00) Pacific - Port Moresby',600,'Pacific/Port_Moresby',1),
+	(529,'(GMT+10:00) Pacific - Saipan',600,'Pacific/Saipan',0),
+	(530,'(GMT+10:00) Pacific - Truk',600,'Pacific/Truk',0),
+	(531,'(GMT+10:00) Pacific - Yap',600,'Pacific/Yap',0),
+	(532,'(GMT+10:30) Australia - LHI',630,'Australia/LHI',0),
+	(533,'(GMT+10:30) Australia - Lord Howe',630,'Australia/Lord_Howe',0),
+	(534,'(GMT+11:00) Asia - Magadan',660,'Asia/Magadan',1),
+	(535,'(GMT+11:00) Etc - GMT-11',660,'Etc/GMT-11',0),
+	(536,'(GMT+11:00) Pacific - Efate',660,'Pacific/Efate',0),
+	(537,'(GMT+11:00) Pacific - Guadalcanal',660,'Pacific/Guadalcanal',0),
+	(538,'(GMT+11:00) Pacific - Kosrae',660,'Pacific/Kosrae',0),
+	(539,'(GMT+11:00) Pacific - Noumea',660,'Pacific/Noumea',0),
+	(540,'(GMT+11:00) Pacific - Ponape',660,'Pacific/Ponape',0),
+	(541,'(GMT+11:30) Pacific - Norfolk',690,'Pacific/Norfolk',0),
+	(542,'(GMT+12:00) Antarctica - McMurdo',720,'Antarctica/McMurdo',0),
+	(543,'(GMT+12:00) Antarctica - South Pole',720,'Antarctica/South_Pole',0),
+	(544,'(GMT+12:00) Asia - Anadyr',720,'Asia/Anadyr',0),
+	(545,'(GMT+12:00) Asia - Kamchatka',720,'Asia/Kamchatka',0),
+	(546,'(GMT+12:00) Etc - GMT-12',720,'Etc/GMT-12',0),
+	(547,'(GMT+12:00) Kwajalein',720,'Kwajalein',0),
+	(548,'(GMT+12:00) NZ',720,'NZ',0),
+	(549,'(GMT+12:00) Pacific - Auckland',720,'Pacific/Auckland',1),
+	(550,'(GMT+12:00) Pacific - Fiji',720,'Pacific/Fiji',1),
+	(551,'(GMT+12:00) Pacific - Funafuti',720,'Pacific/Funafuti',0),
+	(552,'(GMT+12:00) Pacific - Kwajalein',720,'Pacific/Kwajalein',0),
+	(553,'(GMT+12:00) Pacific - Majuro',720,'Pacific/Majuro',0),
+	(554,'(GMT+12:00) Pacific - Nauru',720,'Pacific/Nauru',0),
+	(555,'(GMT+12:00) Pacific - Tarawa',720,'Pacific/Tarawa',0),
+	(556,'(GMT+12:00) Pacific - Wake',720,'Pacific/Wake',0),
+	(557,'(GMT+12:00) Pacific - Wallis',720,'Pacific/Wallis',0),
+	(558,'(GMT+12:45) NZ-CHAT',765,'NZ-CHAT',0),
+	(559,'(GMT+12:45) Pacific - Chatham',765,'Pacific/Chatham',0),
+	(560,'(GMT+13:00) Etc - GMT-13',780,'Etc/GMT-13',0),
+	(561,'(GMT+13:00) Pacific - Enderbury',780,'Pacific/Enderbury',0),
+	(562,'(GMT+13:00) Pacific - Tongatapu',780,'Pacific/Tongatapu',1),
+	(563,'(GMT+14:00) Etc - GMT-14',840,'Etc/GMT-14',0),
+	(564,'(GMT+14:00) Pacific - Kiritimati',840,'Pacific/Kiritimati',0);
+SELECT setval(pg_get_serial_sequence('"channel"', 'id'), (SELECT MAX("id") FROM "channel"));
+SELECT setval(pg_get_serial_sequence('"timezone"', 'id'), (SELECT MAX("id") FROM "timezone"));
+
+ALTER TABLE "channel" ADD CONSTRAINT "channel_ibfk_1" FOREIGN KEY ("timezone") REFERENCES "timezone" ("tz_name") ON DELETE CASCADE;
+ALTER TABLE "channel_mapping" ADD CONSTRAINT "channel_mapping_ibfk_1" FOREIGN KEY ("canonical_id") REFERENCES "channel" ("channel_id") ON DELETE CASCADE;
+ALTER TABLE "program" ADD CONSTRAINT "program_ibfk_1" FOREIGN KEY ("channel_id") REFERENCES "channel" ("channel_id") ON DELETE CASCADE;
+ALTER TABLE "program" ADD CONSTRAINT "program_ibfk_2" FOREIGN KEY ("original_timezone") REFERENCES "timezone" ("tz_name") ON DELETE CASCADE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "webhook" CASCADE;
+DROP TABLE IF EXISTS "program_change" CASCADE;
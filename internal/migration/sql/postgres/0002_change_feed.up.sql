@@ -0,0 +1,34 @@
+CREATE TABLE "program_change" (
+  "id" BIGSERIAL,
+  "channel_id" varchar(50) NOT NULL,
+  "provider_id" varchar(50),
+  "date" varchar(10) NOT NULL,
+  "change_type" varchar(20) NOT NULL,
+  "title" varchar(500),
+  "old_title" varchar(500),
+  "start_time" timestamptz,
+  "end_time" timestamptz,
+  "old_start_time" timestamptz,
+  "old_end_time" timestamptz,
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX "idx_change_channel_date" ON "program_change" ("channel_id", "date");
+CREATE INDEX "idx_change_created_at" ON "program_change" ("created_at");
+
+CREATE TABLE "webhook" (
+  "id" BIGSERIAL,
+  "name" varchar(100) NOT NULL,
+  "url" varchar(500) NOT NULL,
+  "secret" varchar(255),
+  "events" varchar(255),
+  "channel_ids" text,
+  "is_active" smallint DEFAULT '1',
+  "last_status" integer,
+  "last_error" varchar(500),
+  "last_sent_at" timestamptz,
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
@@ -0,0 +1,16 @@
+CREATE TABLE "schedule_report" (
+  "id" BIGSERIAL,
+  "channel_id" varchar(50) NOT NULL,
+  "provider_id" varchar(50),
+  "date" varchar(10) NOT NULL,
+  "program_count" integer DEFAULT '0',
+  "coverage" double precision DEFAULT '0',
+  "issue_count" integer DEFAULT '0',
+  "repair_count" integer DEFAULT '0',
+  "issues" text,
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uk_report_channel_date" UNIQUE ("channel_id", "date")
+);
+
+CREATE INDEX "idx_report_date" ON "schedule_report" ("date");
@@ -0,0 +1 @@
+ALTER TABLE "program" ADD COLUMN "episode" integer NOT NULL DEFAULT '0';
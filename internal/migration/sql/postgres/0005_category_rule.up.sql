@@ -0,0 +1,51 @@
+CREATE TABLE "category_rule" (
+  "id" BIGSERIAL,
+  "category" varchar(50) NOT NULL,
+  "match_type" varchar(20) NOT NULL,
+  "pattern" varchar(255) NOT NULL,
+  "channel_id" varchar(100),
+  "priority" integer NOT NULL DEFAULT '0',
+  "is_active" smallint DEFAULT '1',
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX "idx_priority" ON "category_rule" ("priority");
+
+INSERT INTO "category_rule" ("id", "category", "match_type", "pattern", "channel_id", "priority", "is_active", "created_at", "updated_at")
+VALUES
+	(1,'kids','keyword','动画',NULL,30,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(2,'kids','keyword','少儿',NULL,30,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(3,'kids','keyword','卡通',NULL,30,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(4,'kids','keyword','动漫',NULL,30,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(5,'sports','keyword','体育',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(6,'sports','keyword','足球',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(7,'sports','keyword','篮球',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(8,'sports','keyword','NBA',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(9,'sports','keyword','CBA',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(10,'sports','keyword','赛事',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(11,'sports','keyword','奥运',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(12,'news','keyword','新闻',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(13,'news','keyword','联播',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(14,'news','keyword','资讯',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(15,'news','keyword','焦点访谈',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(16,'documentary','keyword','纪录',NULL,15,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(17,'documentary','keyword','纪实',NULL,15,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(18,'movie','keyword','电影',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(19,'movie','keyword','影院',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(20,'series','keyword','电视剧',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(21,'series','keyword','剧场',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(22,'series','regex','第\d+集',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(23,'series','regex','\(\d+\)$',NULL,5,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(24,'variety','keyword','综艺',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(25,'variety','keyword','晚会',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(26,'variety','keyword','真人秀',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(27,'music','keyword','音乐',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(28,'music','keyword','演唱会',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(29,'music','keyword','戏曲',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(30,'education','keyword','讲堂',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(31,'education','keyword','课堂',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00'),
+	(32,'education','keyword','科教',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+
+SELECT setval(pg_get_serial_sequence('"category_rule"', 'id'), (SELECT MAX("id") FROM "category_rule"));
@@ -0,0 +1,4 @@
+ALTER TABLE "program"
+  DROP COLUMN "episode_label",
+  DROP COLUMN "season",
+  DROP COLUMN "series";
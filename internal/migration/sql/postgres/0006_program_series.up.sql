@@ -0,0 +1,4 @@
+ALTER TABLE "program"
+  ADD COLUMN "series" varchar(255),
+  ADD COLUMN "season" integer NOT NULL DEFAULT '0',
+  ADD COLUMN "episode_label" varchar(50);
@@ -0,0 +1,5 @@
+ALTER TABLE "program"
+  ADD COLUMN "image_url" varchar(500),
+  ADD COLUMN "cast" varchar(500),
+  ADD COLUMN "rating" varchar(20),
+  ADD COLUMN "detail_fetched_at" timestamptz;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "idx_status";
+
+ALTER TABLE "channel_mapping"
+  DROP COLUMN "reviewed_at",
+  DROP COLUMN "status";
@@ -0,0 +1,8 @@
+ALTER TABLE "channel_mapping"
+  ADD COLUMN "status" varchar(20) NOT NULL DEFAULT 'pending',
+  ADD COLUMN "reviewed_at" timestamptz;
+
+CREATE INDEX "idx_status" ON "channel_mapping" ("status");
+
+-- mappings verified before the review queue existed stay verified
+UPDATE "channel_mapping" SET "status" = 'verified' WHERE "is_verified" = 1;
@@ -0,0 +1 @@
+ALTER TABLE "channel_mapping" ADD COLUMN "priority" integer NOT NULL DEFAULT '0';
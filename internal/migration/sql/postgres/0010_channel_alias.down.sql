@@ -0,0 +1,17 @@
+ALTER TABLE "channel" ADD COLUMN "regexp" varchar(100);
+
+-- the first regex alias of each channel goes back, unwrapped again
+UPDATE "channel" c SET "regexp" = CASE
+  WHEN a."alias" LIKE '.*(?:%).*' THEN substr(a."alias", 6, length(a."alias") - 8)
+  WHEN a."alias" LIKE '%.*' THEN left(a."alias", length(a."alias") - 2)
+  ELSE a."alias"
+END
+FROM (
+  SELECT DISTINCT ON ("channel_id") "channel_id", "alias"
+  FROM "channel_alias"
+  WHERE "match_type" = 'regex'
+  ORDER BY "channel_id", "id"
+) a
+WHERE a."channel_id" = c."channel_id";
+
+DROP TABLE IF EXISTS "channel_alias" CASCADE;
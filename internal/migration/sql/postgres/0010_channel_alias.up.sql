@@ -0,0 +1,27 @@
+CREATE TABLE "channel_alias" (
+  "id" BIGSERIAL,
+  "channel_id" varchar(100) NOT NULL,
+  "alias" varchar(255) NOT NULL,
+  "match_type" varchar(20) NOT NULL DEFAULT 'exact',
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX "idx_channel_id" ON "channel_alias" ("channel_id");
+
+-- channel.regexp was searched for anywhere in the name, while a regex alias
+-- has to match the whole name, so each pattern is widened to keep matching
+-- the same names: an anchored one gets a trailing .*, the rest are wrapped.
+INSERT INTO "channel_alias" ("channel_id", "alias", "match_type", "created_at", "updated_at")
+SELECT "channel_id",
+  CASE WHEN "regexp" LIKE '^%' AND strpos("regexp", '|') = 0
+    THEN "regexp" || '.*'
+    ELSE '.*(?:' || "regexp" || ').*'
+  END,
+  'regex', "updated_at", "updated_at"
+FROM "channel"
+WHERE "regexp" IS NOT NULL AND "regexp" <> ''
+ORDER BY "id";
+
+ALTER TABLE "channel" DROP COLUMN "regexp";
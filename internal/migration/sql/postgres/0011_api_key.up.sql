@@ -0,0 +1,18 @@
+CREATE TABLE "api_key" (
+  "id" BIGSERIAL,
+  "user_id" bigint NOT NULL,
+  "name" varchar(100) NOT NULL,
+  "prefix" varchar(20) NOT NULL,
+  "key_hash" varchar(64) NOT NULL,
+  "scopes" varchar(255) NOT NULL,
+  "expires_at" timestamptz,
+  "last_used_at" timestamptz,
+  "last_used_ip" varchar(64),
+  "revoked_at" timestamptz,
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uk_api_key_hash" UNIQUE ("key_hash"),
+  CONSTRAINT "api_key_ibfk_1" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE
+);
+
+CREATE INDEX "idx_api_key_user_id" ON "api_key" ("user_id");
@@ -0,0 +1,16 @@
+CREATE TABLE "refresh_token" (
+  "id" BIGSERIAL,
+  "user_id" bigint NOT NULL,
+  "session_id" varchar(32) NOT NULL,
+  "token_hash" varchar(64) NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "revoked_at" timestamptz,
+  "created_ip" varchar(64),
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uk_refresh_token_hash" UNIQUE ("token_hash"),
+  CONSTRAINT "refresh_token_ibfk_1" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE
+);
+
+CREATE INDEX "idx_refresh_token_user_id" ON "refresh_token" ("user_id");
+CREATE INDEX "idx_refresh_token_session_id" ON "refresh_token" ("session_id");
@@ -0,0 +1,17 @@
+CREATE TABLE "audit_log" (
+  "id" BIGSERIAL,
+  "user_id" bigint,
+  "username" varchar(50) NOT NULL DEFAULT '',
+  "action" varchar(50) NOT NULL,
+  "resource_type" varchar(50) NOT NULL,
+  "resource_id" varchar(255) NOT NULL DEFAULT '',
+  "ip" varchar(64) NOT NULL DEFAULT '',
+  "before_value" text,
+  "after_value" text,
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX "idx_audit_log_created_at" ON "audit_log" ("created_at");
+CREATE INDEX "idx_audit_log_user_id" ON "audit_log" ("user_id");
+CREATE INDEX "idx_audit_log_resource" ON "audit_log" ("resource_type", "resource_id");
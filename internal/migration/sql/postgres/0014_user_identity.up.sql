@@ -0,0 +1,13 @@
+CREATE TABLE "user_identity" (
+  "id" BIGSERIAL,
+  "user_id" bigint NOT NULL,
+  "issuer" varchar(255) NOT NULL,
+  "subject" varchar(255) NOT NULL,
+  "created_at" timestamptz DEFAULT CURRENT_TIMESTAMP,
+  "last_login_at" timestamptz,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uk_user_identity_subject" UNIQUE ("issuer", "subject"),
+  CONSTRAINT "user_identity_ibfk_1" FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE
+);
+
+CREATE INDEX "idx_user_identity_user_id" ON "user_identity" ("user_id");
@@ -0,0 +1,834 @@
+-- Baseline schema and seed data. Databases created from the old SQL dumps
+-- are recorded at this version without running it.
+
+CREATE TABLE "timezone" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"name" VARCHAR(255) NOT NULL,
+	"gmt_offset" INTEGER NOT NULL,
+	"tz_name" VARCHAR(255) NULL,
+	"visible" TINYINT NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX "uk_tz_name" ON "timezone" ("tz_name");
+
+CREATE TABLE "channel" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"channel_id" VARCHAR(100) NOT NULL,
+	"display_name" VARCHAR(100) NULL,
+	"category" VARCHAR(50) NULL,
+	"area" VARCHAR(10) NULL DEFAULT 'CN',
+	"logo_url" VARCHAR(500) NULL,
+	"timezone" VARCHAR(255) NULL DEFAULT 'Asia/Shanghai',
+	"is_active" TINYINT NULL DEFAULT 1,
+	"created_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+	"updated_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+	"regexp" VARCHAR(100) NULL,
+	FOREIGN KEY ("timezone") REFERENCES "timezone" ("tz_name") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX "uk_channel_id" ON "channel" ("channel_id");
+CREATE INDEX "idx_category" ON "channel" ("category");
+CREATE INDEX "idx_area" ON "channel" ("area");
+CREATE INDEX "channel_ibfk_1" ON "channel" ("timezone");
+
+CREATE TABLE "channel_mapping" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"canonical_id" VARCHAR(50) NOT NULL,
+	"provider_id" VARCHAR(50) NOT NULL,
+	"provider_channel_id" VARCHAR(100) NOT NULL,
+	"provider_channel_name" VARCHAR(200) NULL,
+	"confidence" FLOAT NULL DEFAULT 1,
+	"is_verified" TINYINT NULL DEFAULT 0,
+	"created_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+	"updated_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY ("canonical_id") REFERENCES "channel" ("channel_id") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX "uk_provider_mapping" ON "channel_mapping" ("provider_id", "provider_channel_id");
+CREATE INDEX "idx_canonical" ON "channel_mapping" ("canonical_id");
+CREATE INDEX "idx_provider" ON "channel_mapping" ("provider_id");
+
+CREATE TABLE "program" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"channel_id" VARCHAR(50) NOT NULL,
+	"title" VARCHAR(500) NOT NULL,
+	"description" TEXT NULL,
+	"start_time" DATETIME NOT NULL,
+	"end_time" DATETIME NOT NULL,
+	"original_timezone" VARCHAR(255) NULL DEFAULT 'Asia/Shanghai',
+	"category" VARCHAR(50) NULL,
+	"provider_id" VARCHAR(50) NULL,
+	"provider_program_id" VARCHAR(100) NULL,
+	"created_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY ("channel_id") REFERENCES "channel" ("channel_id") ON DELETE CASCADE,
+	FOREIGN KEY ("original_timezone") REFERENCES "timezone" ("tz_name") ON DELETE CASCADE
+);
+CREATE INDEX "idx_channel_time" ON "program" ("channel_id", "start_time");
+CREATE INDEX "idx_time_range" ON "program" ("start_time", "end_time");
+CREATE INDEX "program_ibfk_2" ON "program" ("original_timezone");
+
+CREATE TABLE "user" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"username" VARCHAR(50) NOT NULL,
+	"password" VARCHAR(255) NOT NULL,
+	"email" VARCHAR(100) NULL,
+	"role" VARCHAR(20) NULL DEFAULT 'admin',
+	"is_active" TINYINT NULL DEFAULT 1,
+	"created_at" DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	"updated_at" DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX "uk_username" ON "user" ("username");
+CREATE INDEX "idx_username" ON "user" ("username");
+CREATE INDEX "idx_is_active" ON "user" ("is_active");
+
+INSERT INTO "timezone" VALUES(1,'(GMT-12:00) Etc - GMT+12',-720,'Etc/GMT+12',1);
+INSERT INTO "timezone" VALUES(2,'(GMT-11:00) Etc - GMT+11',-660,'Etc/GMT+11',0);
+INSERT INTO "timezone" VALUES(3,'(GMT-11:00) Pacific - Apia',-660,'Pacific/Apia',0);
+INSERT INTO "timezone" VALUES(4,'(GMT-11:00) Pacific - Midway',-660,'Pacific/Midway',1);
+INSERT INTO "timezone" VALUES(5,'(GMT-11:00) Pacific - Niue',-660,'Pacific/Niue',0);
+INSERT INTO "timezone" VALUES(6,'(GMT-11:00) Pacific - Pago Pago',-660,'Pacific/Pago_Pago',0);
+INSERT INTO "timezone" VALUES(7,'(GMT-11:00) Pacific - Samoa',-660,'Pacific/Samoa',0);
+INSERT INTO "timezone" VALUES(8,'(GMT-11:00) US - Samoa',-660,'US/Samoa',0);
+INSERT INTO "timezone" VALUES(9,'(GMT-10:00) America - Adak',-600,'America/Adak',0);
+INSERT INTO "timezone" VALUES(10,'(GMT-10:00) America - Atka',-600,'America/Atka',0);
+INSERT INTO "timezone" VALUES(11,'(GMT-10:00) Etc - GMT+10',-600,'Etc/GMT+10',0);
+INSERT INTO "timezone" VALUES(12,'(GMT-10:00) HST',-600,'HST',0);
+INSERT INTO "timezone" VALUES(13,'(GMT-10:00) Pacific - Fakaofo',-600,'Pacific/Fakaofo',0);
+INSERT INTO "timezone" VALUES(14,'(GMT-10:00) Pacific - Honolulu',-600,'Pacific/Honolulu',1);
+INSERT INTO "timezone" VALUES(15,'(GMT-10:00) Pacific - Johnston',-600,'Pacific/Johnston',0);
+INSERT INTO "timezone" VALUES(16,'(GMT-10:00) Pacific - Rarotonga',-600,'Pacific/Rarotonga',0);
+INSERT INTO "timezone" VALUES(17,'(GMT-10:00) Pacific - Tahiti',-600,'Pacific/Tahiti',0);
+INSERT INTO "timezone" VALUES(18,'(GMT-10:00) US - Aleutian',-600,'US/Aleutian',1);
+INSERT INTO "timezone" VALUES(19,'(GMT-10:00) US - Hawaii',-600,'US/Hawaii',1);
+INSERT INTO "timezone" VALUES(20,'(GMT-09:30) Pacific - Marquesas',-570,'Pacific/Marquesas',0);
+INSERT INTO "timezone" VALUES(21,'(GMT-09:00) America - Anchorage',-540,'America/Anchorage',0);
+INSERT INTO "timezone" VALUES(22,'(GMT-09:00) America - Juneau',-540,'America/Juneau',1);
+INSERT INTO "timezone" VALUES(23,'(GMT-09:00) America - Nome',-540,'America/Nome',0);
+INSERT INTO "timezone" VALUES(24,'(GMT-09:00) America - Yakutat',-540,'America/Yakutat',0);
+INSERT INTO "timezone" VALUES(25,'(GMT-09:00) Etc - GMT+9',-540,'Etc/GMT+9',0);
+INSERT INTO "timezone" VALUES(26,'(GMT-09:00) Pacific - Gambier',-540,'Pacific/Gambier',0);
+INSERT INTO "timezone" VALUES(27,'(GMT-09:00) US - Alaska',-540,'US/Alaska',0);
+INSERT INTO "timezone" VALUES(28,'(GMT-08:00) America - Dawson',-480,'America/Dawson',0);
+INSERT INTO "timezone" VALUES(29,'(GMT-08:00) America - Ensenada',-480,'America/Ensenada',0);
+INSERT INTO "timezone" VALUES(30,'(GMT-08:00) America - Los Angeles',-480,'America/Los_Angeles',1);
+INSERT INTO "timezone" VALUES(31,'(GMT-08:00) America - Tijuana',-480,'America/Tijuana',1);
+INSERT INTO "timezone" VALUES(32,'(GMT-08:00) America - Vancouver',-480,'America/Vancouver',0);
+INSERT INTO "timezone" VALUES(33,'(GMT-08:00) America - Whitehorse',-480,'America/Whitehorse',0);
+INSERT INTO "timezone" VALUES(34,'(GMT-08:00) Canada - Pacific',-480,'Canada/Pacific',0);
+INSERT INTO "timezone" VALUES(35,'(GMT-08:00) Canada - Yukon',-480,'Canada/Yukon',0);
+INSERT INTO "timezone" VALUES(36,'(GMT-08:00) Etc - GMT+8',-480,'Etc/GMT+8',0);
+INSERT INTO "timezone" VALUES(37,'(GMT-08:00) Mexico - Baja Norte',-480,'Mexico/BajaNorte',0);
+INSERT INTO "timezone" VALUES(38,'(GMT-08:00) PST8PDT',-480,'PST8PDT',0);
+INSERT INTO "timezone" VALUES(39,'(GMT-08:00) Pacific - Pitcairn',-480,'Pacific/Pitcairn',0);
+INSERT INTO "timezone" VALUES(40,'(GMT-08:00) US - Pacific',-480,'US/Pacific',0);
+INSERT INTO "timezone" VALUES(41,'(GMT-08:00) US - Pacific-New',-480,'US/Pacific-New',0);
+INSERT INTO "timezone" VALUES(42,'(GMT-07:00) America - Boise',-420,'America/Boise',0);
+INSERT INTO "timezone" VALUES(43,'(GMT-07:00) America - Cambridge Bay',-420,'America/Cambridge_Bay',0);
+INSERT INTO "timezone" VALUES(44,'(GMT-07:00) America - Chihuahua',-420,'America/Chihuahua',1);
+INSERT INTO "timezone" VALUES(45,'(GMT-07:00) America - Dawson Creek',-420,'America/Dawson_Creek',0);
+INSERT INTO "timezone" VALUES(46,'(GMT-07:00) America - Denver',-420,'America/Denver',1);
+INSERT INTO "timezone" VALUES(47,'(GMT-07:00) America - Edmonton',-420,'America/Edmonton',0);
+INSERT INTO "timezone" VALUES(48,'(GMT-07:00) America - Hermosillo',-420,'America/Hermosillo',0);
+INSERT INTO "timezone" VALUES(49,'(GMT-07:00) America - Inuvik',-420,'America/Inuvik',0);
+INSERT INTO "timezone" VALUES(50,'(GMT-07:00) America - Mazatlan',-420,'America/Mazatlan',0);
+INSERT INTO "timezone" VALUES(51,'(GMT-07:00) America - Phoenix',-420,'America/Phoenix',1);
+INSERT INTO "timezone" VALUES(52,'(GMT-07:00) America - Shiprock',-420,'America/Shiprock',0);
+INSERT INTO "timezone" VALUES(53,'(GMT-07:00) America - Yellowknife',-420,'America/Yellowknife',0);
+INSERT INTO "timezone" VALUES(54,'(GMT-07:00) Canada - Mountain',-420,'Canada/Mountain',0);
+INSERT INTO "timezone" VALUES(55,'(GMT-07:00) Etc - GMT+7',-420,'Etc/GMT+7',0);
+INSERT INTO "timezone" VALUES(56,'(GMT-07:00) MST',-420,'MST',0);
+INSERT INTO "timezone" VALUES(57,'(GMT-07:00) MST7MDT',-420,'MST7MDT',0);
+INSERT INTO "timezone" VALUES(58,'(GMT-07:00) Mexico - Baja Sur',-420,'Mexico/BajaSur',0);
+INSERT INTO "timezone" VALUES(59,'(GMT-07:00) Navajo',-420,'Navajo',0);
+INSERT INTO "timezone" VALUES(60,'(GMT-07:00) US - Arizona',-420,'US/Arizona',0);
+INSERT INTO "timezone" VALUES(61,'(GMT-07:00) US - Mountain',-420,'US/Mountain',0);
+INSERT INTO "timezone" VALUES(62,'(GMT-06:00) America - Belize',-360,'America/Belize',0);
+INSERT INTO "timezone" VALUES(63,'(GMT-06:00) America - Cancun',-360,'America/Cancun',0);
+INSERT INTO "timezone" VALUES(64,'(GMT-06:00) America - Center, North Dakota',-360,'America/North_Dakota/Center',0);
+INSERT INTO "timezone" VALUES(65,'(GMT-06:00) America - Chicago',-360,'America/Chicago',1);
+INSERT INTO "timezone" VALUES(66,'(GMT-06:00) America - Costa Rica',-360,'America/Costa_Rica',0);
+INSERT INTO "timezone" VALUES(67,'(GMT-06:00) America - El Salvador',-360,'America/El_Salvador',0);
+INSERT INTO "timezone" VALUES(68,'(GMT-06:00) America - Guatemala',-360,'America/Guatemala',0);
+INSERT INTO "timezone" VALUES(69,'(GMT-06:00) America - Knox INN',-360,'America/Knox_IN',0);
+INSERT INTO "timezone" VALUES(70,'(GMT-06:00) America - Knox, Indiana',-360,'America/Indiana/Knox',0);
+INSERT INTO "timezone" VALUES(71,'(GMT-06:00) America - Managua',-360,'America/Managua',0);
+INSERT INTO "timezone" VALUES(72,'(GMT-06:00) America - Menominee',-360,'America/Menominee',0);
+INSERT INTO "timezone" VALUES(73,'(GMT-06:00) America - Merida',-360,'America/Merida',0);
+INSERT INTO "timezone" VALUES(74,'(GMT-06:00) America - Mexico City',-360,'America/Mexico_City',1);
+INSERT INTO "timezone" VALUES(75,'(GMT-06:00) America - Monterrey',-360,'America/Monterrey',0);
+INSERT INTO "timezone" VALUES(76,'(GMT-06:00) America - New Salem, North Dakota',-360,'America/North_Dakota/New_Salem',0);
+INSERT INTO "timezone" VALUES(77,'(GMT-06:00) America - Rainy River',-360,'America/Rainy_River',0);
+INSERT INTO "timezone" VALUES(78,'(GMT-06:00) America - Rankin Inlet',-360,'America/Rankin_Inlet',0);
+INSERT INTO "timezone" VALUES(79,'(GMT-06:00) America - Regina',-360,'America/Regina',1);
+INSERT INTO "timezone" VALUES(80,'(GMT-06:00) America - Swift Current',-360,'America/Swift_Current',0);
+INSERT INTO "timezone" VALUES(81,'(GMT-06:00) America - Tegucigalpa',-360,'America/Tegucigalpa',0);
+INSERT INTO "timezone" VALUES(82,'(GMT-06:00) America - Tell City, Indiana',-360,'America/Indiana/Tell_City',0);
+INSERT INTO "timezone" VALUES(83,'(GMT-06:00) America - Winnipeg',-360,'America/Winnipeg',0);
+INSERT INTO "timezone" VALUES(84,'(GMT-06:00) CST6CDT',-360,'CST6CDT',0);
+INSERT INTO "timezone" VALUES(85,'(GMT-06:00) Canada - Central',-360,'Canada/Central',0);
+INSERT INTO "timezone" VALUES(86,'(GMT-06:00) Canada - East-Saskatchewan',-360,'Canada/East-Saskatchewan',0);
+INSERT INTO "timezone" VALUES(87,'(GMT-06:00) Canada - Saskatchewan',-360,'Canada/Saskatchewan',0);
+INSERT INTO "timezone" VALUES(88,'(GMT-06:00) Chile - Easter Island',-360,'Chile/EasterIsland',0);
+INSERT INTO "timezone" VALUES(89,'(GMT-06:00) Etc - GMT+6',-360,'Etc/GMT+6',0);
+INSERT INTO "timezone" VALUES(90,'(GMT-06:00) Mexico - General',-360,'Mexico/General',0);
+INSERT INTO "timezone" VALUES(91,'(GMT-06:00) Pacific - Easter',-360,'Pacific/Easter',0);
+INSERT INTO "timezone" VALUES(92,'(GMT-06:00) Pacific - Galapagos',-360,'Pacific/Galapagos',0);
+INSERT INTO "timezone" VALUES(93,'(GMT-06:00) US - Central',-360,'US/Central',0);
+INSERT INTO "timezone" VALUES(94,'(GMT-06:00) US - Indiana-Starke',-360,'US/Indiana-Starke',0);
+INSERT INTO "timezone" VALUES(95,'(GMT-05:00) America - Atikokan',-300,'America/Atikokan',0);
+INSERT INTO "timezone" VALUES(96,'(GMT-05:00) America - Bogota',-300,'America/Bogota',1);
+INSERT INTO "timezone" VALUES(97,'(GMT-05:00) America - Cayman',-300,'America/Cayman',0);
+INSERT INTO "timezone" VALUES(98,'(GMT-05:00) America - Coral Harbour',-300,'America/Coral_Harbour',0);
+INSERT INTO "timezone" VALUES(99,'(GMT-05:00) America - Detroit',-300,'America/Detroit',0);
+INSERT INTO "timezone" VALUES(100,'(GMT-05:00) America - Fort Wayne',-300,'America/Fort_Wayne',0);
+INSERT INTO "timezone" VALUES(101,'(GMT-05:00) America - Grand Turk',-300,'America/Grand_Turk',0);
+INSERT INTO "timezone" VALUES(102,'(GMT-05:00) America - Guayaquil',-300,'America/Guayaquil',0);
+INSERT INTO "timezone" VALUES(103,'(GMT-05:00) America - Havana',-300,'America/Havana',0);
+INSERT INTO "timezone" VALUES(104,'(GMT-05:00) America - Indianapolis',-300,'America/Indianapolis',0);
+INSERT INTO "timezone" VALUES(105,'(GMT-05:00) America - Indianapolis, Indiana',-300,'America/Indiana/Indianapolis',1);
+INSERT INTO "timezone" VALUES(106,'(GMT-05:00) America - Iqaluit',-300,'America/Iqaluit',0);
+INSERT INTO "timezone" VALUES(107,'(GMT-05:00) America - Jamaica',-300,'America/Jamaica',0);
+INSERT INTO "timezone" VALUES(108,'(GMT-05:00) America - Lima',-300,'America/Lima',0);
+INSERT INTO "timezone" VALUES(109,'(GMT-05:00) America - Louisville',-300,'America/Louisville',0);
+INSERT INTO "timezone" VALUES(110,'(GMT-05:00) America - Louisville, Kentucky',-300,'America/Kentucky/Louisville',0);
+INSERT INTO "timezone" VALUES(111,'(GMT-05:00) America - Marengo, Indiana',-300,'America/Indiana/Marengo',0);
+INSERT INTO "timezone" VALUES(112,'(GMT-05:00) America - Monticello, Kentucky',-300,'America/Kentucky/Monticello',0);
+INSERT INTO "timezone" VALUES(113,'(GMT-05:00) America - Montreal',-300,'America/Montreal',0);
+INSERT INTO "timezone" VALUES(114,'(GMT-05:00) America - Nassau',-300,'America/Nassau',0);
+INSERT INTO "timezone" VALUES(115,'(GMT-05:00) America - New York',-300,'America/New_York',1);
+INSERT INTO "timezone" VALUES(116,'(GMT-05:00) America - Nipigon',-300,'America/Nipigon',0);
+INSERT INTO "timezone" VALUES(117,'(GMT-05:00) America - Panama',-300,'America/Panama',0);
+INSERT INTO "timezone" VALUES(118,'(GMT-05:00) America - Pangnirtung',-300,'America/Pangnirtung',0);
+INSERT INTO "timezone" VALUES(119,'(GMT-05:00) America - Petersburg, Indiana',-300,'America/Indiana/Petersburg',0);
+INSERT INTO "timezone" VALUES(120,'(GMT-05:00) America - Port-au-Prince',-300,'America/Port-au-Prince',0);
+INSERT INTO "timezone" VALUES(121,'(GMT-05:00) America - Resolute',-300,'America/Resolute',0);
+INSERT INTO "timezone" VALUES(122,'(GMT-05:00) America - Thunder Bay',-300,'America/Thunder_Bay',0);
+INSERT INTO "timezone" VALUES(123,'(GMT-05:00) America - Toronto',-300,'America/Toronto',0);
+INSERT INTO "timezone" VALUES(124,'(GMT-05:00) America - Vevay, Indiana',-300,'America/Indiana/Vevay',0);
+INSERT INTO "timezone" VALUES(125,'(GMT-05:00) America - Vincennes, Indiana',-300,'America/Indiana/Vincennes',0);
+INSERT INTO "timezone" VALUES(126,'(GMT-05:00) America - Winamac, Indiana',-300,'America/Indiana/Winamac',0);
+INSERT INTO "timezone" VALUES(127,'(GMT-05:00) Canada - Eastern',-300,'Canada/Eastern',0);
+INSERT INTO "timezone" VALUES(128,'(GMT-05:00) Cuba',-300,'Cuba',0);
+INSERT INTO "timezone" VALUES(129,'(GMT-05:00) EST',-300,'EST',0);
+INSERT INTO "timezone" VALUES(130,'(GMT-05:00) EST5EDT',-300,'EST5EDT',0);
+INSERT INTO "timezone" VALUES(131,'(GMT-05:00) Etc - GMT+5',-300,'Etc/GMT+5',0);
+INSERT INTO "timezone" VALUES(132,'(GMT-05:00) Jamaica',-300,'Jamaica',0);
+INSERT INTO "timezone" VALUES(133,'(GMT-05:00) US - East-Indiana',-300,'US/East-Indiana',0);
+INSERT INTO "timezone" VALUES(134,'(GMT-05:00) US - Eastern',-300,'US/Eastern',0);
+INSERT INTO "timezone" VALUES(135,'(GMT-05:00) US - Michigan',-300,'US/Michigan',0);
+INSERT INTO "timezone" VALUES(136,'(GMT-04:30) America - Caracas',-270,'America/Caracas',0);
+INSERT INTO "timezone" VALUES(137,'(GMT-04:00) America - Anguilla',-240,'America/Anguilla',0);
+INSERT INTO "timezone" VALUES(138,'(GMT-04:00) America - Antigua',-240,'America/Antigua',0);
+INSERT INTO "timezone" VALUES(139,'(GMT-04:00) America - Aruba',-240,'America/Aruba',0);
+INSERT INTO "timezone" VALUES(140,'(GMT-04:00) America - Asuncion',-240,'America/Asuncion',0);
+INSERT INTO "timezone" VALUES(141,'(GMT-04:00) America - Barbados',-240,'America/Barbados',0);
+INSERT INTO "timezone" VALUES(142,'(GMT-04:00) America - Blanc-Sablon',-240,'America/Blanc-Sablon',0);
+INSERT INTO "timezone" VALUES(143,'(GMT-04:00) America - Boa Vista',-240,'America/Boa_Vista',0);
+INSERT INTO "timezone" VALUES(144,'(GMT-04:00) America - Campo Grande',-240,'America/Campo_Grande',0);
+INSERT INTO "timezone" VALUES(145,'(GMT-04:00) America - Cuiaba',-240,'America/Cuiaba',0);
+INSERT INTO "timezone" VALUES(146,'(GMT-04:00) America - Curacao',-240,'America/Curacao',0);
+INSERT INTO "timezone" VALUES(147,'(GMT-04:00) America - Dominica',-240,'America/Dominica',0);
+INSERT INTO "timezone" VALUES(148,'(GMT-04:00) America - Eirunepe',-240,'America/Eirunepe',0);
+INSERT INTO "timezone" VALUES(149,'(GMT-04:00) America - Glace Bay',-240,'America/Glace_Bay',0);
+INSERT INTO "timezone" VALUES(150,'(GMT-04:00) America - Goose Bay',-240,'America/Goose_Bay',0);
+INSERT INTO "timezone" VALUES(151,'(GMT-04:00) America - Grenada',-240,'America/Grenada',0);
+INSERT INTO "timezone" VALUES(152,'(GMT-04:00) America - Guadeloupe',-240,'America/Guadeloupe',0);
+INSERT INTO "timezone" VALUES(153,'(GMT-04:00) America - Guyana',-240,'America/Guyana',0);
+INSERT INTO "timezone" VALUES(154,'(GMT-04:00) America - Halifax',-240,'America/Halifax',1);
+INSERT INTO "timezone" VALUES(155,'(GMT-04:00) America - La Paz',-240,'America/La_Paz',0);
+INSERT INTO "timezone" VALUES(156,'(GMT-04:00) America - Manaus',-240,'America/Manaus',1);
+INSERT INTO "timezone" VALUES(157,'(GMT-04:00) America - Marigot',-240,'America/Marigot',0);
+INSERT INTO "timezone" VALUES(158,'(GMT-04:00) America - Martinique',-240,'America/Martinique',0);
+INSERT INTO "timezone" VALUES(159,'(GMT-04:00) America - Moncton',-240,'America/Moncton',0);
+INSERT INTO "timezone" VALUES(160,'(GMT-04:00) America - Montserrat',-240,'America/Montserrat',0);
+INSERT INTO "timezone" VALUES(161,'(GMT-04:00) America - Port of Spain',-240,'America/Port_of_Spain',0);
+INSERT INTO "timezone" VALUES(162,'(GMT-04:00) America - Porto Acre',-240,'America/Porto_Acre',0);
+INSERT INTO "timezone" VALUES(163,'(GMT-04:00) America - Porto Velho',-240,'America/Porto_Velho',0);
+INSERT INTO "timezone" VALUES(164,'(GMT-04:00) America - Puerto Rico',-240,'America/Puerto_Rico',0);
+INSERT INTO "timezone" VALUES(165,'(GMT-04:00) America - Rio Branco',-240,'America/Rio_Branco',0);
+INSERT INTO "timezone" VALUES(166,'(GMT-04:00) America - Santiago',-240,'America/Santiago',1);
+INSERT INTO "timezone" VALUES(167,'(GMT-04:00) America - Santo Domingo',-240,'America/Santo_Domingo',0);
+INSERT INTO "timezone" VALUES(168,'(GMT-04:00) America - St Barthelemy',-240,'America/St_Barthelemy',0);
+INSERT INTO "timezone" VALUES(169,'(GMT-04:00) America - St Kitts',-240,'America/St_Kitts',0);
+INSERT INTO "timezone" VALUES(170,'(GMT-04:00) America - St Lucia',-240,'America/St_Lucia',0);
+INSERT INTO "timezone" VALUES(171,'(GMT-04:00) America - St Thomas',-240,'America/St_Thomas',0);
+INSERT INTO "timezone" VALUES(172,'(GMT-04:00) America - St Vincent',-240,'America/St_Vincent',0);
+INSERT INTO "timezone" VALUES(173,'(GMT-04:00) America - Thule',-240,'America/Thule',0);
+INSERT INTO "timezone" VALUES(174,'(GMT-04:00) America - Tortola',-240,'America/Tortola',0);
+INSERT INTO "timezone" VALUES(175,'(GMT-04:00) America - Virgin',-240,'America/Virgin',0);
+INSERT INTO "timezone" VALUES(176,'(GMT-04:00) Antarctica - Palmer',-240,'Antarctica/Palmer',0);
+INSERT INTO "timezone" VALUES(177,'(GMT-04:00) Atlantic - Bermuda',-240,'Atlantic/Bermuda',0);
+INSERT INTO "timezone" VALUES(178,'(GMT-04:00) Atlantic - Stanley',-240,'Atlantic/Stanley',0);
+INSERT INTO "timezone" VALUES(179,'(GMT-04:00) Brazil - Acre',-240,'Brazil/Acre',0);
+INSERT INTO "timezone" VALUES(180,'(GMT-04:00) Brazil - West',-240,'Brazil/West',0);
+INSERT INTO "timezone" VALUES(181,'(GMT-04:00) Canada - Atlantic',-240,'Canada/Atlantic',0);
+INSERT INTO "timezone" VALUES(182,'(GMT-04:00) Chile - Continental',-240,'Chile/Continental',0);
+INSERT INTO "timezone" VALUES(183,'(GMT-04:00) Etc - GMT+4',-240,'Etc/GMT+4',0);
+INSERT INTO "timezone" VALUES(184,'(GMT-03:30) America - St Johns',-210,'America/St_Johns',0);
+INSERT INTO "timezone" VALUES(185,'(GMT-03:30) Canada - Newfoundland',-210,'Canada/Newfoundland',0);
+INSERT INTO "timezone" VALUES(186,'(GMT-03:00) America - Araguaina',-180,'America/Araguaina',0);
+INSERT INTO "timezone" VALUES(187,'(GMT-03:00) America - Bahia',-180,'America/Bahia',0);
+INSERT INTO "timezone" VALUES(188,'(GMT-03:00) America - Belem',-180,'America/Belem',0);
+INSERT INTO "timezone" VALUES(189,'(GMT-03:00) America - Buenos Aires',-180,'America/Buenos_Aires',0);
+INSERT INTO "timezone" VALUES(190,'(GMT-03:00) America - Buenos Aires, Argentina',-180,'America/Argentina/Buenos_Aires',1);
+INSERT INTO "timezone" VALUES(191,'(GMT-03:00) America - Catamarca',-180,'America/Catamarca',0);
+INSERT INTO "timezone" VALUES(192,'(GMT-03:00) America - Catamarca, Argentina',-180,'America/Argentina/Catamarca',0);
+INSERT INTO "timezone" VALUES(193,'(GMT-03:00) America - Cayenne',-180,'America/Cayenne',0);
+INSERT INTO "timezone" VALUES(194,'(GMT-03:00) America - Comod Rivadavia, Argentina',-180,'America/Argentina/ComodRivadavia',0);
+INSERT INTO "timezone" VALUES(195,'(GMT-03:00) America - Cordoba',-180,'America/Cordoba',0);
+INSERT INTO "timezone" VALUES(196,'(GMT-03:00) America - Cordoba, Argentina',-180,'America/Argentina/Cordoba',0);
+INSERT INTO "timezone" VALUES(197,'(GMT-03:00) America - Fortaleza',-180,'America/Fortaleza',0);
+INSERT INTO "timezone" VALUES(198,'(GMT-03:00) America - Godthab',-180,'America/Godthab',1);
+INSERT INTO "timezone" VALUES(199,'(GMT-03:00) America - Jujuy',-180,'America/Jujuy',0);
+INSERT INTO "timezone" VALUES(200,'(GMT-03:00) America - Jujuy, Argentina',-180,'America/Argentina/Jujuy',0);
+INSERT INTO "timezone" VALUES(201,'(GMT-03:00) America - La Rioja, Argentina',-180,'America/Argentina/La_Rioja',0);
+INSERT INTO "timezone" VALUES(202,'(GMT-03:00) America - Maceio',-180,'America/Maceio',0);
+INSERT INTO "timezone" VALUES(203,'(GMT-03:00) America - Mendoza',-180,'America/Mendoza',0);
+INSERT INTO "timezone" VALUES(204,'(GMT-03:00) America - Mendoza, Argentina',-180,'America/Argentina/Mendoza',0);
+INSERT INTO "timezone" VALUES(205,'(GMT-03:00) America - Miquelon',-180,'America/Miquelon',0);
+INSERT INTO "timezone" VALUES(206,'(GMT-03:00) America - Montevideo',-180,'America/Montevideo',1);
+INSERT INTO "timezone" VALUES(207,'(GMT-03:00) America - Paramaribo',-180,'America/Paramaribo',0);
+INSERT INTO "timezone" VALUES(208,'(GMT-03:00) America - Recife',-180,'America/Recife',0);
+INSERT INTO "timezone" VALUES(209,'(GMT-03:00) America - Rio Gallegos, Argentina',-180,'America/Argentina/Rio_Gallegos',0);
+INSERT INTO "timezone" VALUES(210,'(GMT-03:00) America - Rosario',-180,'America/Rosario',0);
+INSERT INTO "timezone" VALUES(211,'(GMT-03:00) America - Salta, Argentina',-180,'America/Argentina/Salta',0);
+INSERT INTO "timezone" VALUES(212,'(GMT-03:00) America - San Juan, Argentina',-180,'America/Argentina/San_Juan',0);
+INSERT INTO "timezone" VALUES(213,'(GMT-03:00) America - San Luis, Argentina',-180,'America/Argentina/San_Luis',0);
+INSERT INTO "timezone" VALUES(214,'(GMT-03:00) America - Santarem',-180,'America/Santarem',0);
+INSERT INTO "timezone" VALUES(215,'(GMT-03:00) America - Sao Paulo',-180,'America/Sao_Paulo',0);
+INSERT INTO "timezone" VALUES(216,'(GMT-03:00) America - Tucuman, Argentina',-180,'America/Argentina/Tucuman',0);
+INSERT INTO "timezone" VALUES(217,'(GMT-03:00) America - Ushuaia, Argentina',-180,'America/Argentina/Ushuaia',0);
+INSERT INTO "timezone" VALUES(218,'(GMT-03:00) Antarctica - Rothera',-180,'Antarctica/Rothera',0);
+INSERT INTO "timezone" VALUES(219,'(GMT-03:00) Brazil - East',-180,'Brazil/East',0);
+INSERT INTO "timezone" VALUES(220,'(GMT-03:00) Etc - GMT+3',-180,'Etc/GMT+3',0);
+INSERT INTO "timezone" VALUES(221,'(GMT-02:00) America - Noronha',-120,'America/Noronha',0);
+INSERT INTO "timezone" VALUES(222,'(GMT-02:00) Atlantic - South Georgia',-120,'Atlantic/South_Georgia',1);
+INSERT INTO "timezone" VALUES(223,'(GMT-02:00) Brazil - De Noronha',-120,'Brazil/DeNoronha',0);
+INSERT INTO "timezone" VALUES(224,'(GMT-02:00) Etc - GMT+2',-120,'Etc/GMT+2',0);
+INSERT INTO "timezone" VALUES(225,'(GMT-01:00) America - Scoresbysund',-60,'America/Scoresbysund',0);
+INSERT INTO "timezone" VALUES(226,'(GMT-01:00) Atlantic - Azores',-60,'Atlantic/Azores',1);
+INSERT INTO "timezone" VALUES(227,'(GMT-01:00) Atlantic - Cape Verde',-60,'Atlantic/Cape_Verde',1);
+INSERT INTO "timezone" VALUES(228,'(GMT-01:00) Etc - GMT+1',-60,'Etc/GMT+1',0);
+INSERT INTO "timezone" VALUES(229,'(GMT+00:00) Africa - Abidjan',0,'Africa/Abidjan',0);
+INSERT INTO "timezone" VALUES(230,'(GMT+00:00) Africa - Accra',0,'Africa/Accra',0);
+INSERT INTO "timezone" VALUES(231,'(GMT+00:00) Africa - Bamako',0,'Africa/Bamako',0);
+INSERT INTO "timezone" VALUES(232,'(GMT+00:00) Africa - Banjul',0,'Africa/Banjul',0);
+INSERT INTO "timezone" VALUES(233,'(GMT+00:00) Africa - Bissau',0,'Africa/Bissau',0);
+INSERT INTO "timezone" VALUES(234,'(GMT+00:00) Africa - Casablanca',0,'Africa/Casablanca',1);
+INSERT INTO "timezone" VALUES(235,'(GMT+00:00) Africa - Conakry',0,'Africa/Conakry',0);
+INSERT INTO "timezone" VALUES(236,'(GMT+00:00) Africa - Dakar',0,'Africa/Dakar',0);
+INSERT INTO "timezone" VALUES(237,'(GMT+00:00) Africa - El Aaiun',0,'Africa/El_Aaiun',0);
+INSERT INTO "timezone" VALUES(238,'(GMT+00:00) Africa - Freetown',0,'Africa/Freetown',0);
+INSERT INTO "timezone" VALUES(239,'(GMT+00:00) Africa - Lome',0,'Africa/Lome',0);
+INSERT INTO "timezone" VALUES(240,'(GMT+00:00) Africa - Monrovia',0,'Africa/Monrovia',0);
+INSERT INTO "timezone" VALUES(241,'(GMT+00:00) Africa - Nouakchott',0,'Africa/Nouakchott',0);
+INSERT INTO "timezone" VALUES(242,'(GMT+00:00) Africa - Ouagadougou',0,'Africa/Ouagadougou',0);
+INSERT INTO "timezone" VALUES(243,'(GMT+00:00) Africa - Sao Tome',0,'Africa/Sao_Tome',0);
+INSERT INTO "timezone" VALUES(244,'(GMT+00:00) Africa - Timbuktu',0,'Africa/Timbuktu',0);
+INSERT INTO "timezone" VALUES(245,'(GMT+00:00) America - Danmarkshavn',0,'America/Danmarkshavn',0);
+INSERT INTO "timezone" VALUES(246,'(GMT+00:00) Atlantic - Canary',0,'Atlantic/Canary',0);
+INSERT INTO "timezone" VALUES(247,'(GMT+00:00) Atlantic - Faeroe',0,'Atlantic/Faeroe',0);
+INSERT INTO "timezone" VALUES(248,'(GMT+00:00) Atlantic - Faroe',0,'Atlantic/Faroe',0);
+INSERT INTO "timezone" VALUES(249,'(GMT+00:00) Atlantic - Madeira',0,'Atlantic/Madeira',0);
+INSERT INTO "timezone" VALUES(250,'(GMT+00:00) Atlantic - Reykjavik',0,'Atlantic/Reykjavik',0);
+INSERT INTO "timezone" VALUES(251,'(GMT+00:00) Atlantic - St Helena',0,'Atlantic/St_Helena',0);
+INSERT INTO "timezone" VALUES(252,'(GMT+00:00) Eire',0,'Eire',0);
+INSERT INTO "timezone" VALUES(253,'(GMT+00:00) Etc - GMT',0,'Etc/GMT',1);
+INSERT INTO "timezone" VALUES(254,'(GMT+00:00) Etc - GMT+0',0,'Etc/GMT+0',0);
+INSERT INTO "timezone" VALUES(255,'(GMT+00:00) Etc - GMT-0',0,'Etc/GMT-0',0);
+INSERT INTO "timezone" VALUES(256,'(GMT+00:00) Etc - GMT0',0,'Etc/GMT0',0);
+INSERT INTO "timezone" VALUES(257,'(GMT+00:00) Etc - Greenwich',0,'Etc/Greenwich',0);
+INSERT INTO "timezone" VALUES(258,'(GMT+00:00) Etc - UCT',0,'Etc/UCT',0);
+INSERT INTO "timezone" VALUES(259,'(GMT+00:00) Etc - UTC',0,'Etc/UTC',0);
+INSERT INTO "timezone" VALUES(260,'(GMT+00:00) Etc - Universal',0,'Etc/Universal',0);
+INSERT INTO "timezone" VALUES(261,'(GMT+00:00) Etc - Zulu',0,'Etc/Zulu',0);
+INSERT INTO "timezone" VALUES(262,'(GMT+00:00) Europe - Belfast',0,'Europe/Belfast',0);
+INSERT INTO "timezone" VALUES(263,'(GMT+00:00) Europe - Dublin',0,'Europe/Dublin',0);
+INSERT INTO "timezone" VALUES(264,'(GMT+00:00) Europe - Guernsey',0,'Europe/Guernsey',0);
+INSERT INTO "timezone" VALUES(265,'(GMT+00:00) Europe - Isle of Man',0,'Europe/Isle_of_Man',0);
+INSERT INTO "timezone" VALUES(266,'(GMT+00:00) Europe - Jersey',0,'Europe/Jersey',0);
+INSERT INTO "timezone" VALUES(267,'(GMT+00:00) Europe - Lisbon',0,'Europe/Lisbon',0);
+INSERT INTO "timezone" VALUES(268,'(GMT+00:00) Europe - London',0,'Europe/London',1);
+INSERT INTO "timezone" VALUES(269,'(GMT+00:00) GB',0,'GB',0);
+INSERT INTO "timezone" VALUES(270,'(GMT+00:00) GB-Eire',0,'GB-Eire',0);
+INSERT INTO "timezone" VALUES(271,'(GMT+00:00) GMT',0,'GMT',0);
+INSERT INTO "timezone" VALUES(272,'(GMT+00:00) GMT+0',0,'GMT+0',0);
+INSERT INTO "timezone" VALUES(273,'(GMT+00:00) GMT-0',0,'GMT-0',0);
+INSERT INTO "timezone" VALUES(274,'(GMT+00:00) GMT0',0,'GMT0',0);
+INSERT INTO "timezone" VALUES(275,'(GMT+00:00) Greenwich',0,'Greenwich',0);
+INSERT INTO "timezone" VALUES(276,'(GMT+00:00) Iceland',0,'Iceland',0);
+INSERT INTO "timezone" VALUES(277,'(GMT+00:00) Portugal',0,'Portugal',0);
+INSERT INTO "timezone" VALUES(278,'(GMT+00:00) UCT',0,'UCT',0);
+INSERT INTO "timezone" VALUES(279,'(GMT+00:00) UTC',0,'UTC',0);
+INSERT INTO "timezone" VALUES(280,'(GMT+00:00) Universal',0,'Universal',0);
+INSERT INTO "timezone" VALUES(281,'(GMT+00:00) WET',0,'WET',0);
+INSERT INTO "timezone" VALUES(282,'(GMT+00:00) Zulu',0,'Zulu',0);
+INSERT INTO "timezone" VALUES(283,'(GMT+01:00) Africa - Algiers',60,'Africa/Algiers',1);
+INSERT INTO "timezone" VALUES(284,'(GMT+01:00) Africa - Bangui',60,'Africa/Bangui',0);
+INSERT INTO "timezone" VALUES(285,'(GMT+01:00) Africa - Brazzaville',60,'Africa/Brazzaville',0);
+INSERT INTO "timezone" VALUES(286,'(GMT+01:00) Africa - Ceuta',60,'Africa/Ceuta',0);
+INSERT INTO "timezone" VALUES(287,'(GMT+01:00) Africa - Douala',60,'Africa/Douala',0);
+INSERT INTO "timezone" VALUES(288,'(GMT+01:00) Africa - Kinshasa',60,'Africa/Kinshasa',0);
+INSERT INTO "timezone" VALUES(289,'(GMT+01:00) Africa - Lagos',60,'Africa/Lagos',0);
+INSERT INTO "timezone" VALUES(290,'(GMT+01:00) Africa - Libreville',60,'Africa/Libreville',0);
+INSERT INTO "timezone" VALUES(291,'(GMT+01:00) Africa - Luanda',60,'Africa/Luanda',0);
+INSERT INTO "timezone" VALUES(292,'(GMT+01:00) Africa - Malabo',60,'Africa/Malabo',0);
+INSERT INTO "timezone" VALUES(293,'(GMT+01:00) Africa - Ndjamena',60,'Africa/Ndjamena',0);
+INSERT INTO "timezone" VALUES(294,'(GMT+01:00) Africa - Niamey',60,'Africa/Niamey',0);
+INSERT INTO "timezone" VALUES(295,'(GMT+01:00) Africa - Porto-Novo',60,'Africa/Porto-Novo',0);
+INSERT INTO "timezone" VALUES(296,'(GMT+01:00) Africa - Tunis',60,'Africa/Tunis',0);
+INSERT INTO "timezone" VALUES(297,'(GMT+01:00) Africa - Windhoek',60,'Africa/Windhoek',1);
+INSERT INTO "timezone" VALUES(298,'(GMT+01:00) Arctic - Longyearbyen',60,'Arctic/Longyearbyen',0);
+INSERT INTO "timezone" VALUES(299,'(GMT+01:00) Atlantic - Jan Mayen',60,'Atlantic/Jan_Mayen',0);
+INSERT INTO "timezone" VALUES(300,'(GMT+01:00) CET',60,'CET',1);
+INSERT INTO "timezone" VALUES(301,'(GMT+01:00) Etc - GMT-1',60,'Etc/GMT-1',0);
+INSERT INTO "timezone" VALUES(302,'(GMT+01:00) Europe - Amsterdam',60,'Europe/Amsterdam',1);
+INSERT INTO "timezone" VALUES(303,'(GMT+01:00) Europe - Andorra',60,'Europe/Andorra',0);
+INSERT INTO "timezone" VALUES(304,'(GMT+01:00) Europe - Belgrade',60,'Europe/Belgrade',1);
+INSERT INTO "timezone" VALUES(305,'(GMT+01:00) Europe - Berlin',60,'Europe/Berlin',0);
+INSERT INTO "timezone" VALUES(306,'(GMT+01:00) Europe - Bratislava',60,'Europe/Bratislava',0);
+INSERT INTO "timezone" VALUES(307,'(GMT+01:00) Europe - Brussels',60,'Europe/Brussels',1);
+INSERT INTO "timezone" VALUES(308,'(GMT+01:00) Europe - Budapest',60,'Europe/Budapest',0);
+INSERT INTO "timezone" VALUES(309,'(GMT+01:00) Europe - Copenhagen',60,'Europe/Copenhagen',0);
+INSERT INTO "timezone" VALUES(310,'(GMT+01:00) Europe - Gibraltar',60,'Europe/Gibraltar',0);
+INSERT INTO "timezone" VALUES(311,'(GMT+01:00) Europe - Ljubljana',60,'Europe/Ljubljana',0);
+INSERT INTO "timezone" VALUES(312,'(GMT+01:00) Europe - Luxembourg',60,'Europe/Luxembourg',0);
+INSERT INTO "timezone" VALUES(313,'(GMT+01:00) Europe - Madrid',60,'Europe/Madrid',0);
+INSERT INTO "timezone" VALUES(314,'(GMT+01:00) Europe - Malta',60,'Europe/Malta',0);
+INSERT INTO "timezone" VALUES(315,'(GMT+01:00) Europe - Monaco',60,'Europe/Monaco',0);
+INSERT INTO "timezone" VALUES(316,'(GMT+01:00) Europe - Oslo',60,'Europe/Oslo',0);
+INSERT INTO "timezone" VALUES(317,'(GMT+01:00) Europe - Paris',60,'Europe/Paris',0);
+INSERT INTO "timezone" VALUES(318,'(GMT+01:00) Europe - Podgorica',60,'Europe/Podgorica',0);
+INSERT INTO "timezone" VALUES(319,'(GMT+01:00) Europe - Prague',60,'Europe/Prague',0);
+INSERT INTO "timezone" VALUES(320,'(GMT+01:00) Europe - Rome',60,'Europe/Rome',0);
+INSERT INTO "timezone" VALUES(321,'(GMT+01:00) Europe - San Marino',60,'Europe/San_Marino',0);
+INSERT INTO "timezone" VALUES(322,'(GMT+01:00) Europe - Sarajevo',60,'Europe/Sarajevo',1);
+INSERT INTO "timezone" VALUES(323,'(GMT+01:00) Europe - Skopje',60,'Europe/Skopje',0);
+INSERT INTO "timezone" VALUES(324,'(GMT+01:00) Europe - Stockholm',60,'Europe/Stockholm',0);
+INSERT INTO "timezone" VALUES(325,'(GMT+01:00) Europe - Tirane',60,'Europe/Tirane',0);
+INSERT INTO "timezone" VALUES(326,'(GMT+01:00) Europe - Vaduz',60,'Europe/Vaduz',0);
+INSERT INTO "timezone" VALUES(327,'(GMT+01:00) Europe - Vatican',60,'Europe/Vatican',0);
+INSERT INTO "timezone" VALUES(328,'(GMT+01:00) Europe - Vienna',60,'Europe/Vienna',0);
+INSERT INTO "timezone" VALUES(329,'(GMT+01:00) Europe - Warsaw',60,'Europe/Warsaw',0);
+INSERT INTO "timezone" VALUES(330,'(GMT+01:00) Europe - Zagreb',60,'Europe/Zagreb',0);
+INSERT INTO "timezone" VALUES(331,'(GMT+01:00) Europe - Zurich',60,'Europe/Zurich',0);
+INSERT INTO "timezone" VALUES(332,'(GMT+01:00) MET',60,'MET',0);
+INSERT INTO "timezone" VALUES(333,'(GMT+01:00) Poland',60,'Poland',0);
+INSERT INTO "timezone" VALUES(334,'(GMT+02:00) Africa - Blantyre',120,'Africa/Blantyre',0);
+INSERT INTO "timezone" VALUES(335,'(GMT+02:00) Africa - Bujumbura',120,'Africa/Bujumbura',0);
+INSERT INTO "timezone" VALUES(336,'(GMT+02:00) Africa - Cairo',120,'Africa/Cairo',1);
+INSERT INTO "timezone" VALUES(337,'(GMT+02:00) Africa - Gaborone',120,'Africa/Gaborone',0);
+INSERT INTO "timezone" VALUES(338,'(GMT+02:00) Africa - Harare',120,'Africa/Harare',1);
+INSERT INTO "timezone" VALUES(339,'(GMT+02:00) Africa - Johannesburg',120,'Africa/Johannesburg',0);
+INSERT INTO "timezone" VALUES(340,'(GMT+02:00) Africa - Kigali',120,'Africa/Kigali',0);
+INSERT INTO "timezone" VALUES(341,'(GMT+02:00) Africa - Lubumbashi',120,'Africa/Lubumbashi',0);
+INSERT INTO "timezone" VALUES(342,'(GMT+02:00) Africa - Lusaka',120,'Africa/Lusaka',0);
+INSERT INTO "timezone" VALUES(343,'(GMT+02:00) Africa - Maputo',120,'Africa/Maputo',0);
+INSERT INTO "timezone" VALUES(344,'(GMT+02:00) Africa - Maseru',120,'Africa/Maseru',0);
+INSERT INTO "timezone" VALUES(345,'(GMT+02:00) Africa - Mbabane',120,'Africa/Mbabane',0);
+INSERT INTO "timezone" VALUES(346,'(GMT+02:00) Africa - Tripoli',120,'Africa/Tripoli',0);
+INSERT INTO "timezone" VALUES(347,'(GMT+02:00) Asia - Amman',120,'Asia/Amman',1);
+INSERT INTO "timezone" VALUES(348,'(GMT+02:00) Asia - Beirut',120,'Asia/Beirut',1);
+INSERT INTO "timezone" VALUES(349,'(GMT+02:00) Asia - Damascus',120,'Asia/Damascus',0);
+INSERT INTO "timezone" VALUES(350,'(GMT+02:00) Asia - Gaza',120,'Asia/Gaza',0);
+INSERT INTO "timezone" VALUES(351,'(GMT+02:00) Asia - Istanbul',120,'Asia/Istanbul',0);
+INSERT INTO "timezone" VALUES(352,'(GMT+02:00) Asia - Jerusalem',120,'Asia/Jerusalem',1);
+INSERT INTO "timezone" VALUES(353,'(GMT+02:00) Asia - Nicosia',120,'Asia/Nicosia',0);
+INSERT INTO "timezone" VALUES(354,'(GMT+02:00) Asia - Tel Aviv',120,'Asia/Tel_Aviv',0);
+INSERT INTO "timezone" VALUES(355,'(GMT+02:00) EET',120,'EET',1);
+INSERT INTO "timezone" VALUES(356,'(GMT+02:00) Egypt',120,'Egypt',0);
+INSERT INTO "timezone" VALUES(357,'(GMT+02:00) Etc - GMT-2',120,'Etc/GMT-2',0);
+INSERT INTO "timezone" VALUES(358,'(GMT+02:00) Europe - Athens',120,'Europe/Athens',1);
+INSERT INTO "timezone" VALUES(359,'(GMT+02:00) Europe - Bucharest',120,'Europe/Bucharest',0);
+INSERT INTO "timezone" VALUES(360,'(GMT+02:00) Europe - Chisinau',120,'Europe/Chisinau',0);
+INSERT INTO "timezone" VALUES(361,'(GMT+02:00) Europe - Helsinki',120,'Europe/Helsinki',1);
+INSERT INTO "timezone" VALUES(362,'(GMT+02:00) Europe - Istanbul',120,'Europe/Istanbul',0);
+INSERT INTO "timezone" VALUES(363,'(GMT+02:00) Europe - Kaliningrad',120,'Europe/Kaliningrad',0);
+INSERT INTO "timezone" VALUES(364,'(GMT+02:00) Europe - Kiev',120,'Europe/Kiev',0);
+INSERT INTO "timezone" VALUES(365,'(GMT+02:00) Europe - Mariehamn',120,'Europe/Mariehamn',0);
+INSERT INTO "timezone" VALUES(366,'(GMT+03:00) Europe - Minsk',180,'Europe/Minsk',1);
+INSERT INTO "timezone" VALUES(367,'(GMT+02:00) Europe - Nicosia',120,'Europe/Nicosia',0);
+INSERT INTO "timezone" VALUES(368,'(GMT+02:00) Europe - Riga',120,'Europe/Riga',0);
+INSERT INTO "timezone" VALUES(369,'(GMT+02:00) Europe - Simferopol',120,'Europe/Simferopol',0);
+INSERT INTO "timezone" VALUES(370,'(GMT+02:00) Europe - Sofia',120,'Europe/Sofia',0);
+INSERT INTO "timezone" VALUES(371,'(GMT+02:00) Europe - Tallinn',120,'Europe/Tallinn',0);
+INSERT INTO "timezone" VALUES(372,'(GMT+02:00) Europe - Tiraspol',120,'Europe/Tiraspol',0);
+INSERT INTO "timezone" VALUES(373,'(GMT+02:00) Europe - Uzhgorod',120,'Europe/Uzhgorod',0);
+INSERT INTO "timezone" VALUES(374,'(GMT+02:00) Europe - Vilnius',120,'Europe/Vilnius',0);
+INSERT INTO "timezone" VALUES(375,'(GMT+02:00) Europe - Zaporozhye',120,'Europe/Zaporozhye',0);
+INSERT INTO "timezone" VALUES(376,'(GMT+02:00) Israel',120,'Israel',0);
+INSERT INTO "timezone" VALUES(377,'(GMT+02:00) Libya',120,'Libya',0);
+INSERT INTO "timezone" VALUES(378,'(GMT+02:00) Turkey',120,'Turkey',0);
+INSERT INTO "timezone" VALUES(379,'(GMT+03:00) Africa - Addis Ababa',180,'Africa/Addis_Ababa',0);
+INSERT INTO "timezone" VALUES(380,'(GMT+03:00) Africa - Asmara',180,'Africa/Asmara',0);
+INSERT INTO "timezone" VALUES(381,'(GMT+03:00) Africa - Asmera',180,'Africa/Asmera',0);
+INSERT INTO "timezone" VALUES(382,'(GMT+03:00) Africa - Dar es Salaam',180,'Africa/Dar_es_Salaam',0);
+INSERT INTO "timezone" VALUES(383,'(GMT+03:00) Africa - Djibouti',180,'Africa/Djibouti',0);
+INSERT INTO "timezone" VALUES(384,'(GMT+03:00) Africa - Kampala',180,'Africa/Kampala',0);
+INSERT INTO "timezone" VALUES(385,'(GMT+03:00) Africa - Khartoum',180,'Africa/Khartoum',0);
+INSERT INTO "timezone" VALUES(386,'(GMT+03:00) Africa - Mogadishu',180,'Africa/Mogadishu',0);
+INSERT INTO "timezone" VALUES(387,'(GMT+03:00) Africa - Nairobi',180,'Africa/Nairobi',1);
+INSERT INTO "timezone" VALUES(388,'(GMT+03:00) Antarctica - Syowa',180,'Antarctica/Syowa',0);
+INSERT INTO "timezone" VALUES(389,'(GMT+03:00) Asia - Aden',180,'Asia/Aden',0);
+INSERT INTO "timezone" VALUES(390,'(GMT+03:00) Asia - Baghdad',180,'Asia/Baghdad',1);
+INSERT INTO "timezone" VALUES(391,'(GMT+03:00) Asia - Bahrain',180,'Asia/Bahrain',0);
+INSERT INTO "timezone" VALUES(392,'(GMT+03:00) Asia - Kuwait',180,'Asia/Kuwait',1);
+INSERT INTO "timezone" VALUES(393,'(GMT+03:00) Asia - Qatar',180,'Asia/Qatar',0);
+INSERT INTO "timezone" VALUES(394,'(GMT+03:00) Asia - Riyadh',180,'Asia/Riyadh',0);
+INSERT INTO "timezone" VALUES(395,'(GMT+03:00) Etc - GMT-3',180,'Etc/GMT-3',0);
+INSERT INTO "timezone" VALUES(396,'(GMT+03:00) Europe - Moscow',180,'Europe/Moscow',1);
+INSERT INTO "timezone" VALUES(397,'(GMT+03:00) Europe - Volgograd',180,'Europe/Volgograd',0);
+INSERT INTO "timezone" VALUES(398,'(GMT+03:00) Indian - Antananarivo',180,'Indian/Antananarivo',0);
+INSERT INTO "timezone" VALUES(399,'(GMT+03:00) Indian - Comoro',180,'Indian/Comoro',0);
+INSERT INTO "timezone" VALUES(400,'(GMT+03:00) Indian - Mayotte',180,'Indian/Mayotte',0);
+INSERT INTO "timezone" VALUES(401,'(GMT+03:00) W-SU',180,'W-SU',0);
+INSERT INTO "timezone" VALUES(402,'(GMT+03:07) Asia - Riyadh87',187,'Asia/Riyadh87',0);
+INSERT INTO "timezone" VALUES(403,'(GMT+03:07) Asia - Riyadh88',187,'Asia/Riyadh88',0);
+INSERT INTO "timezone" VALUES(404,'(GMT+03:07) Asia - Riyadh89',187,'Asia/Riyadh89',0);
+INSERT INTO "timezone" VALUES(405,'(GMT+03:07) Mideast - Riyadh87',187,'Mideast/Riyadh87',0);
+INSERT INTO "timezone" VALUES(406,'(GMT+03:07) Mideast - Riyadh88',187,'Mideast/Riyadh88',0);
+INSERT INTO "timezone" VALUES(407,'(GMT+03:07) Mideast - Riyadh89',187,'Mideast/Riyadh89',0);
+INSERT INTO "timezone" VALUES(408,'(GMT+03:30) Asia - Tehran',210,'Asia/Tehran',0);
+INSERT INTO "timezone" VALUES(409,'(GMT+03:30) Iran',210,'Iran',0);
+INSERT INTO "timezone" VALUES(410,'(GMT+04:00) Asia - Baku',240,'Asia/Baku',1);
+INSERT INTO "timezone" VALUES(411,'(GMT+04:00) Asia - Dubai',240,'Asia/Dubai',0);
+INSERT INTO "timezone" VALUES(412,'(GMT+04:00) Asia - Muscat',240,'Asia/Muscat',1);
+INSERT INTO "timezone" VALUES(413,'(GMT+04:00) Asia - Tbilisi',240,'Asia/Tbilisi',1);
+INSERT INTO "timezone" VALUES(414,'(GMT+04:00) Asia - Yerevan',240,'Asia/Yerevan',1);
+INSERT INTO "timezone" VALUES(415,'(GMT+04:00) Etc - GMT-4',240,'Etc/GMT-4',0);
+INSERT INTO "timezone" VALUES(416,'(GMT+04:00) Europe - Samara',240,'Europe/Samara',0);
+INSERT INTO "timezone" VALUES(417,'(GMT+04:00) Indian - Mahe',240,'Indian/Mahe',0);
+INSERT INTO "timezone" VALUES(418,'(GMT+04:00) Indian - Mauritius',240,'Indian/Mauritius',0);
+INSERT INTO "timezone" VALUES(419,'(GMT+04:00) Indian - Reunion',240,'Indian/Reunion',0);
+INSERT INTO "timezone" VALUES(420,'(GMT+04:30) Asia - Kabul',270,'Asia/Kabul',0);
+INSERT INTO "timezone" VALUES(421,'(GMT+05:00) Asia - Aqtau',300,'Asia/Aqtau',0);
+INSERT INTO "timezone" VALUES(422,'(GMT+05:00) Asia - Aqtobe',300,'Asia/Aqtobe',0);
+INSERT INTO "timezone" VALUES(423,'(GMT+05:00) Asia - Ashgabat',300,'Asia/Ashgabat',0);
+INSERT INTO "timezone" VALUES(424,'(GMT+05:00) Asia - Ashkhabad',300,'Asia/Ashkhabad',0);
+INSERT INTO "timezone" VALUES(425,'(GMT+05:00) Asia - Dushanbe',300,'Asia/Dushanbe',0);
+INSERT INTO "timezone" VALUES(426,'(GMT+05:00) Asia - Karachi',300,'Asia/Karachi',1);
+INSERT INTO "timezone" VALUES(427,'(GMT+05:00) Asia - Oral',300,'Asia/Oral',0);
+INSERT INTO "timezone" VALUES(428,'(GMT+05:00) Asia - Samarkand',300,'Asia/Samarkand',0);
+INSERT INTO "timezone" VALUES(429,'(GMT+05:00) Asia - Tashkent',300,'Asia/Tashkent',0);
+INSERT INTO "timezone" VALUES(430,'(GMT+05:00) Asia - Yekaterinburg',300,'Asia/Yekaterinburg',1);
+INSERT INTO "timezone" VALUES(431,'(GMT+05:00) Etc - GMT-5',300,'Etc/GMT-5',0);
+INSERT INTO "timezone" VALUES(432,'(GMT+05:00) Indian - Kerguelen',300,'Indian/Kerguelen',0);
+INSERT INTO "timezone" VALUES(433,'(GMT+05:00) Indian - Maldives',300,'Indian/Maldives',0);
+INSERT INTO "timezone" VALUES(434,'(GMT+05:30) Asia - Calcutta',330,'Asia/Calcutta',0);
+INSERT INTO "timezone" VALUES(435,'(GMT+05:30) Asia - Colombo',330,'Asia/Colombo',0);
+INSERT INTO "timezone" VALUES(436,'(GMT+05:30) Asia - Kolkata',330,'Asia/Kolkata',1);
+INSERT INTO "timezone" VALUES(437,'(GMT+05:45) Asia - Katmandu',345,'Asia/Katmandu',0);
+INSERT INTO "timezone" VALUES(438,'(GMT+06:00) Antarctica - Mawson',360,'Antarctica/Mawson',0);
+INSERT INTO "timezone" VALUES(439,'(GMT+06:00) Antarctica - Vostok',360,'Antarctica/Vostok',0);
+INSERT INTO "timezone" VALUES(440,'(GMT+06:00) Asia - Almaty',360,'Asia/Almaty',1);
+INSERT INTO "timezone" VALUES(441,'(GMT+06:00) Asia - Bishkek',360,'Asia/Bishkek',0);
+INSERT INTO "timezone" VALUES(442,'(GMT+06:00) Asia - Dacca',360,'Asia/Dacca',0);
+INSERT INTO "timezone" VALUES(443,'(GMT+06:00) Asia - Dhaka',360,'Asia/Dhaka',1);
+INSERT INTO "timezone" VALUES(444,'(GMT+06:00) Asia - Novosibirsk',360,'Asia/Novosibirsk',0);
+INSERT INTO "timezone" VALUES(445,'(GMT+06:00) Asia - Omsk',360,'Asia/Omsk',0);
+INSERT INTO "timezone" VALUES(446,'(GMT+06:00) Asia - Qyzylorda',360,'Asia/Qyzylorda',0);
+INSERT INTO "timezone" VALUES(447,'(GMT+06:00) Asia - Thimbu',360,'Asia/Thimbu',0);
+INSERT INTO "timezone" VALUES(448,'(GMT+06:00) Asia - Thimphu',360,'Asia/Thimphu',0);
+INSERT INTO "timezone" VALUES(449,'(GMT+06:00) Etc - GMT-6',360,'Etc/GMT-6',0);
+INSERT INTO "timezone" VALUES(450,'(GMT+06:00) Indian - Chagos',360,'Indian/Chagos',0);
+INSERT INTO "timezone" VALUES(451,'(GMT+06:30) Asia - Rangoon',390,'Asia/Rangoon',0);
+INSERT INTO "timezone" VALUES(452,'(GMT+06:30) Indian - Cocos',390,'Indian/Cocos',0);
+INSERT INTO "timezone" VALUES(453,'(GMT+07:00) Antarctica - Davis',420,'Antarctica/Davis',0);
+INSERT INTO "timezone" VALUES(454,'(GMT+07:00) Asia - Bangkok',420,'Asia/Bangkok',1);
+INSERT INTO "timezone" VALUES(455,'(GMT+07:00) Asia - Ho Chi Minh',420,'Asia/Ho_Chi_Minh',0);
+INSERT INTO "timezone" VALUES(456,'(GMT+07:00) Asia - Hovd',420,'Asia/Hovd',0);
+INSERT INTO "timezone" VALUES(457,'(GMT+07:00) Asia - Jakarta',420,'Asia/Jakarta',0);
+INSERT INTO "timezone" VALUES(458,'(GMT+07:00) Asia - Krasnoyarsk',420,'Asia/Krasnoyarsk',1);
+INSERT INTO "timezone" VALUES(459,'(GMT+07:00) Asia - Phnom Penh',420,'Asia/Phnom_Penh',0);
+INSERT INTO "timezone" VALUES(460,'(GMT+07:00) Asia - Pontianak',420,'Asia/Pontianak',0);
+INSERT INTO "timezone" VALUES(461,'(GMT+07:00) Asia - Saigon',420,'Asia/Saigon',0);
+INSERT INTO "timezone" VALUES(462,'(GMT+07:00) Asia - Vientiane',420,'Asia/Vientiane',0);
+INSERT INTO "timezone" VALUES(463,'(GMT+07:00) Etc - GMT-7',420,'Etc/GMT-7',0);
+INSERT INTO "timezone" VALUES(464,'(GMT+07:00) Indian - Christmas',420,'Indian/Christmas',0);
+INSERT INTO "timezone" VALUES(465,'(GMT+08:00) Antarctica - Casey',480,'Antarctica/Casey',0);
+INSERT INTO "timezone" VALUES(466,'(GMT+08:00) Asia - Brunei',480,'Asia/Brunei',0);
+INSERT INTO "timezone" VALUES(467,'(GMT+08:00) Asia - Choibalsan',480,'Asia/Choibalsan',0);
+INSERT INTO "timezone" VALUES(468,'(GMT+08:00) Asia - Chongqing',480,'Asia/Chongqing',0);
+INSERT INTO "timezone" VALUES(469,'(GMT+08:00) Asia - Chungking',480,'Asia/Chungking',0);
+INSERT INTO "timezone" VALUES(470,'(GMT+08:00) Asia - Harbin',480,'Asia/Harbin',0);
+INSERT INTO "timezone" VALUES(471,'(GMT+08:00) Asia - Hong Kong',480,'Asia/Hong_Kong',0);
+INSERT INTO "timezone" VALUES(472,'(GMT+08:00) Asia - Irkutsk',480,'Asia/Irkutsk',1);
+INSERT INTO "timezone" VALUES(473,'(GMT+08:00) Asia - Kashgar',480,'Asia/Kashgar',0);
+INSERT INTO "timezone" VALUES(474,'(GMT+08:00) Asia - Kuala Lumpur',480,'Asia/Kuala_Lumpur',1);
+INSERT INTO "timezone" VALUES(475,'(GMT+08:00) Asia - Kuching',480,'Asia/Kuching',0);
+INSERT INTO "timezone" VALUES(476,'(GMT+08:00) Asia - Macao',480,'Asia/Macao',0);
+INSERT INTO "timezone" VALUES(477,'(GMT+08:00) Asia - Macau',480,'Asia/Macau',0);
+INSERT INTO "timezone" VALUES(478,'(GMT+08:00) Asia - Makassar',480,'Asia/Makassar',0);
+INSERT INTO "timezone" VALUES(479,'(GMT+08:00) Asia - Manila',480,'Asia/Manila',0);
+INSERT INTO "timezone" VALUES(480,'(GMT+08:00) Asia - Shanghai',480,'Asia/Shanghai',1);
+INSERT INTO "timezone" VALUES(481,'(GMT+08:00) Asia - Singapore',480,'Asia/Singapore',0);
+INSERT INTO "timezone" VALUES(482,'(GMT+08:00) Asia - Taipei',480,'Asia/Taipei',1);
+INSERT INTO "timezone" VALUES(483,'(GMT+08:00) Asia - Ujung Pandang',480,'Asia/Ujung_Pandang',0);
+INSERT INTO "timezone" VALUES(484,'(GMT+08:00) Asia - Ulaanbaatar',480,'Asia/Ulaanbaatar',0);
+INSERT INTO "timezone" VALUES(485,'(GMT+08:00) Asia - Ulan Bator',480,'Asia/Ulan_Bator',0);
+INSERT INTO "timezone" VALUES(486,'(GMT+08:00) Asia - Urumqi',480,'Asia/Urumqi',0);
+INSERT INTO "timezone" VALUES(487,'(GMT+08:00) Australia - Perth',480,'Australia/Perth',1);
+INSERT INTO "timezone" VALUES(488,'(GMT+08:00) Australia - West',480,'Australia/West',0);
+INSERT INTO "timezone" VALUES(489,'(GMT+08:00) Etc - GMT-8',480,'Etc/GMT-8',0);
+INSERT INTO "timezone" VALUES(490,'(GMT+08:00) Hongkong',480,'Hongkong',0);
+INSERT INTO "timezone" VALUES(491,'(GMT+08:00) PRC',480,'PRC',0);
+INSERT INTO "timezone" VALUES(492,'(GMT+08:00) ROC',480,'ROC',0);
+INSERT INTO "timezone" VALUES(493,'(GMT+08:00) Singapore',480,'Singapore',0);
+INSERT INTO "timezone" VALUES(494,'(GMT+08:45) Australia - Eucla',525,'Australia/Eucla',0);
+INSERT INTO "timezone" VALUES(495,'(GMT+09:00) Asia - Dili',540,'Asia/Dili',0);
+INSERT INTO "timezone" VALUES(496,'(GMT+09:00) Asia - Jayapura',540,'Asia/Jayapura',0);
+INSERT INTO "timezone" VALUES(497,'(GMT+09:00) Asia - Pyongyang',540,'Asia/Pyongyang',0);
+INSERT INTO "timezone" VALUES(498,'(GMT+09:00) Asia - Seoul',540,'Asia/Seoul',1);
+INSERT INTO "timezone" VALUES(499,'(GMT+09:00) Asia - Tokyo',540,'Asia/Tokyo',1);
+INSERT INTO "timezone" VALUES(500,'(GMT+09:00) Asia - Yakutsk',540,'Asia/Yakutsk',1);
+INSERT INTO "timezone" VALUES(501,'(GMT+09:00) Etc - GMT-9',540,'Etc/GMT-9',0);
+INSERT INTO "timezone" VALUES(502,'(GMT+09:00) Japan',540,'Japan',0);
+INSERT INTO "timezone" VALUES(503,'(GMT+09:00) Pacific - Palau',540,'Pacific/Palau',0);
+INSERT INTO "timezone" VALUES(504,'(GMT+09:00) ROK',540,'ROK',0);
+INSERT INTO "timezone" VALUES(505,'(GMT+09:30) Australia - Adelaide',570,'Australia/Adelaide',1);
+INSERT INTO "timezone" VALUES(506,'(GMT+09:30) Australia - Broken Hill',570,'Australia/Broken_Hill',0);
+INSERT INTO "timezone" VALUES(507,'(GMT+09:30) Australia - Darwin',570,'Australia/Darwin',0);
+INSERT INTO "timezone" VALUES(508,'(GMT+09:30) Australia - North',570,'Australia/North',0);
+INSERT INTO "timezone" VALUES(509,'(GMT+09:30) Australia - South',570,'Australia/South',0);
+INSERT INTO "timezone" VALUES(510,'(GMT+09:30) Australia - Yancowinna',570,'Australia/Yancowinna',0);
+INSERT INTO "timezone" VALUES(511,'(GMT+10:00) Antarctica - Dumont DUrvilleUrville',600,'Antarctica/DumontDUrville',0);
+INSERT INTO "timezone" VALUES(512,'(GMT+10:00) Asia - Sakhalin',600,'Asia/Sakhalin',0);
+INSERT INTO "timezone" VALUES(513,'(GMT+10:00) Asia - Vladivostok',600,'Asia/Vladivostok',1);
+INSERT INTO "timezone" VALUES(514,'(GMT+10:00) Australia - ACT',600,'Australia/ACT',0);
+INSERT INTO "timezone" VALUES(515,'(GMT+10:00) Australia - Brisbane',600,'Australia/Brisbane',1);
+INSERT INTO "timezone" VALUES(516,'(GMT+10:00) Australia - Canberra',600,'Australia/Canberra',0);
+INSERT INTO "timezone" VALUES(517,'(GMT+10:00) Australia - Currie',600,'Australia/Currie',0);
+INSERT INTO "timezone" VALUES(518,'(GMT+10:00) Australia - Hobart',600,'Australia/Hobart',1);
+INSERT INTO "timezone" VALUES(519,'(GMT+10:00) Australia - Lindeman',600,'Australia/Lindeman',0);
+INSERT INTO "timezone" VALUES(520,'(GMT+10:00) Australia - Melbourne',600,'Australia/Melbourne',1);
+INSERT INTO "timezone" VALUES(521,'(GMT+10:00) Australia - NSW',600,'Australia/NSW',0);
+INSERT INTO "timezone" VALUES(522,'(GMT+10:00) Australia - Queensland',600,'Australia/Queensland',0);
+INSERT INTO "timezone" VALUES(523,'(GMT+10:00) Australia - Sydney',600,'Australia/Sydney',0);
+INSERT INTO "timezone" VALUES(524,'(GMT+10:00) Australia - Tasmania',600,'Australia/Tasmania',0);
+INSERT INTO "timezone" VALUES(525,'(GMT+10:00) Australia - Victoria',600,'Australia/Victoria',0);
+INSERT INTO "timezone" VALUES(526,'(GMT+10:00) Etc - GMT-10',600,'Etc/GMT-10',0);
+INSERT INTO "timezone" VALUES(527,'(GMT+10:00) Pacific - Guam',600,'Pacific/Guam',0);
+INSERT INTO "timezone" VALUES(528,'(GMT+10:00) Pacific - Port Moresby',600,'Pacific/Port_Moresby',1);
+INSERT INTO "timezone" VALUES(529,'(GMT+10:00) Pacific - Saipan',600,'Pacific/Saipan',0);
+INSERT INTO "timezone" VALUES(530,'(GMT+10:00) Pacific - Truk',600,'Pacific/Truk',0);
+INSERT INTO "timezone" VALUES(531,'(GMT+10:00) Pacific - Yap',600,'Pacific/Yap',0);
+INSERT INTO "timezone" VALUES(532,'(GMT+10:30) Australia - LHI',630,'Australia/LHI',0);
+INSERT INTO "timezone" VALUES(533,'(GMT+10:30) Australia - Lord Howe',630,'Australia/Lord_Howe',0);
+INSERT INTO "timezone" VALUES(534,'(GMT+11:00) Asia - Magadan',660,'Asia/Magadan',1);
+INSERT INTO "timezone" VALUES(535,'(GMT+11:00) Etc - GMT-11',660,'Etc/GMT-11',0);
+INSERT INTO "timezone" VALUES(536,'(GMT+11:00) Pacific - Efate',660,'Pacific/Efate',0);
+INSERT INTO "timezone" VALUES(537,'(GMT+11:00) Pacific - Guadalcanal',660,'Pacific/Guadalcanal',0);
+INSERT INTO "timezone" VALUES(538,'(GMT+11:00) Pacific - Kosrae',660,'Pacific/Kosrae',0);
+INSERT INTO "timezone" VALUES(539,'(GMT+11:00) Pacific - Noumea',660,'Pacific/Noumea',0);
+INSERT INTO "timezone" VALUES(540,'(GMT+11:00) Pacific - Ponape',660,'Pacific/Ponape',0);
+INSERT INTO "timezone" VALUES(541,'(GMT+11:30) Pacific - Norfolk',690,'Pacific/Norfolk',0);
+INSERT INTO "timezone" VALUES(542,'(GMT+12:00) Antarctica - McMurdo',720,'Antarctica/McMurdo',0);
+INSERT INTO "timezone" VALUES(543,'(GMT+12:00) Antarctica - South Pole',720,'Antarctica/South_Pole',0);
+INSERT INTO "timezone" VALUES(544,'(GMT+12:00) Asia - Anadyr',720,'Asia/Anadyr',0);
+INSERT INTO "timezone" VALUES(545,'(GMT+12:00) Asia - Kamchatka',720,'Asia/Kamchatka',0);
+INSERT INTO "timezone" VALUES(546,'(GMT+12:00) Etc - GMT-12',720,'Etc/GMT-12',0);
+INSERT INTO "timezone" VALUES(547,'(GMT+12:00) Kwajalein',720,'Kwajalein',0);
+INSERT INTO "timezone" VALUES(548,'(GMT+12:00) NZ',720,'NZ',0);
+INSERT INTO "timezone" VALUES(549,'(GMT+12:00) Pacific - Auckland',720,'Pacific/Auckland',1);
+INSERT INTO "timezone" VALUES(550,'(GMT+12:00) Pacific - Fiji',720,'Pacific/Fiji',1);
+INSERT INTO "timezone" VALUES(551,'(GMT+12:00) Pacific - Funafuti',720,'Pacific/Funafuti',0);
+INSERT INTO "timezone" VALUES(552,'(GMT+12:00) Pacific - Kwajalein',720,'Pacific/Kwajalein',0);
+INSERT INTO "timezone" VALUES(553,'(GMT+12:00) Pacific - Majuro',720,'Pacific/Majuro',0);
+INSERT INTO "timezone" VALUES(554,'(GMT+12:00) Pacific - Nauru',720,'Pacific/Nauru',0);
+INSERT INTO "timezone" VALUES(555,'(GMT+12:00) Pacific - Tarawa',720,'Pacific/Tarawa',0);
+INSERT INTO "timezone" VALUES(556,'(GMT+12:00) Pacific - Wake',720,'Pacific/Wake',0);
+INSERT INTO "timezone" VALUES(557,'(GMT+12:00) Pacific - Wallis',720,'Pacific/Wallis',0);
+INSERT INTO "timezone" VALUES(558,'(GMT+12:45) NZ-CHAT',765,'NZ-CHAT',0);
+INSERT INTO "timezone" VALUES(559,'(GMT+12:45) Pacific - Chatham',765,'Pacific/Chatham',0);
+INSERT INTO "timezone" VALUES(560,'(GMT+13:00) Etc - GMT-13',780,'Etc/GMT-13',0);
+INSERT INTO "timezone" VALUES(561,'(GMT+13:00) Pacific - Enderbury',780,'Pacific/Enderbury',0);
+INSERT INTO "timezone" VALUES(562,'(GMT+13:00) Pacific - Tongatapu',780,'Pacific/Tongatapu',1);
+INSERT INTO "timezone" VALUES(563,'(GMT+14:00) Etc - GMT-14',840,'Etc/GMT-14',0);
+INSERT INTO "timezone" VALUES(564,'(GMT+14:00) Pacific - Kiritimati',840,'Pacific/Kiritimati',0);
+
+INSERT INTO "channel" VALUES(1,'CCTV1','CCTV-1 综合','','CN','','Asia/Shanghai',1,'2025-11-12 07:26:03','2025-12-01 05:43:09','^cctv-?1(\s*综合)?');
+INSERT INTO "channel" VALUES(2,'CCTV2','CCTV-2 财经','','CN','','Asia/Shanghai',1,'2025-11-12 07:46:22','2025-12-01 05:43:37','^cctv-?2(\s*财经)?');
+INSERT INTO "channel" VALUES(3,'CCTV3','CCTV-3 综艺','','CN','','Asia/Shanghai',1,'2025-11-12 08:01:19','2025-12-01 05:44:37','^cctv-?3(\s*综艺)?');
+INSERT INTO "channel" VALUES(4,'CCTV4','CCTV-4 中文国际','','CN','','Asia/Shanghai',1,'2025-11-12 08:01:19','2025-12-01 05:45:17','^cctv-?4(\s*(中文国际|亚洲))?');
+INSERT INTO "channel" VALUES(5,'CCTV5','CCTV-5 体育','','CN','','Asia/Shanghai',1,'2025-11-13 12:44:08','2025-12-01 05:48:39','^cctv-?5(\s*体育)?');
+INSERT INTO "channel" VALUES(6,'CCTV5+','CCTV-5 体育赛事','','CN','','Asia/Shanghai',1,'2025-11-13 12:44:08','2025-12-01 05:49:01','^cctv-?5(\s*体育赛事)?');
+INSERT INTO "channel" VALUES(7,'CCTV6','CCTV-6 电影','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:35','^cctv-?6(\s*电影)?');
+INSERT INTO "channel" VALUES(8,'CCTV7','CCTV-7 国防军事','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 05:51:16','^cctv-?7(\s*国防军事)?');
+INSERT INTO "channel" VALUES(9,'CCTV8','CCTV-8 电视剧','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:27','^cctv-?8(\s*电视剧)?');
+INSERT INTO "channel" VALUES(10,'CCTV9','CCTV-9 纪录','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:23','^cctv-?9(\s*纪录)?');
+INSERT INTO "channel" VALUES(11,'CCTV10','CCTV-10 科教','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:20','^cctv-?10(\s*记录)?');
+INSERT INTO "channel" VALUES(12,'CCTV11','CCTV-11 戏曲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:16','^cctv-?11(\s*戏曲)?');
+INSERT INTO "channel" VALUES(13,'CCTV12','CCTV-12 社会与法','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:13','^cctv-?12(\s*社会与法)?');
+INSERT INTO "channel" VALUES(14,'CCTV13','CCTV-13 新闻','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:10','^cctv-?13(\s*新闻)?');
+INSERT INTO "channel" VALUES(15,'CCTV14','CCTV-14 少儿','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:06','^cctv-?14(\s*少儿)?');
+INSERT INTO "channel" VALUES(16,'CCTV15','CCTV-15 音乐','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:13:02','^cctv-?15(\s*音乐)?');
+INSERT INTO "channel" VALUES(17,'CCTV16','CCTV-16 奥林匹克','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:59','^cctv-?16(\s*(奥林匹克|奥运))?');
+INSERT INTO "channel" VALUES(18,'CCTV17','CCTV-17 农业农村','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:56','^cctv-?17(\s*农业农村)?');
+INSERT INTO "channel" VALUES(19,'CCTV4K','CCTV-4K 超高清','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:51','^cctv-?4k(\s*超高清)?');
+INSERT INTO "channel" VALUES(20,'CCTV8K','CCTV-8K 超高清','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:47','^cctv-?8k(\s*超高清)?');
+INSERT INTO "channel" VALUES(21,'CCTV4欧洲','CCTV-4 欧洲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:35','^cctv-?4(\s*欧洲)?');
+INSERT INTO "channel" VALUES(22,'CCTV4美洲','CCTV-4 美洲','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 05:56:03','^cctv-?4(\s*美洲)?');
+INSERT INTO "channel" VALUES(23,'CGTN','CGTN','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:03:15','^cgtn$');
+INSERT INTO "channel" VALUES(24,'CGTN俄语','CGTN俄语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:03:03','^cgtn\s*(俄语|Russian)');
+INSERT INTO "channel" VALUES(25,'CGTN西语','CGTN西语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:12:16','^cgtn\s*(西语|西班牙语|Spanish)');
+INSERT INTO "channel" VALUES(26,'CGTN阿语','CGTN阿语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:13','^cgtn\s*(阿语|阿拉伯语|Arabic)');
+INSERT INTO "channel" VALUES(27,'CGTN法语','CGTN法语','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:34','^cgtn\s*(法语|French)');
+INSERT INTO "channel" VALUES(28,'CGTN记录','CGTN记录','','CN','','Asia/Shanghai',1,'2025-11-12 08:28:20','2025-12-01 06:05:55','^cgtn\s*(记录|documentary)');
+INSERT INTO "channel" VALUES(29,'CCTV风云剧场','CCTV-风云剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:41','(cctv-?)?风云剧场');
+INSERT INTO "channel" VALUES(30,'CCTV第一剧场','CCTV-第一剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:58','(cctv-?)?第一剧场');
+INSERT INTO "channel" VALUES(31,'CCTV怀旧剧场','CCTV-怀旧剧场','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:04','(cctv-?)?怀旧剧场');
+INSERT INTO "channel" VALUES(32,'CCTV世界地理','CCTV-世界地理','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:06','(cctv-?)?世界地理');
+INSERT INTO "channel" VALUES(33,'CCTV风云音乐','CCTV-风云音乐','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:07','(cctv-?)?风云音乐');
+INSERT INTO "channel" VALUES(34,'CCTV兵器科技','CCTV-兵器科技','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:09','(cctv-?)?兵器科技');
+INSERT INTO "channel" VALUES(35,'CCTV风云足球','CCTV-风云足球','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:11','(cctv-?)?风云足球');
+INSERT INTO "channel" VALUES(36,'CCTV高尔夫网球','CCTV-高尔夫网球','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:21','(cctv-?)?高尔夫·?网球');
+INSERT INTO "channel" VALUES(37,'CCTV女性时尚','CCTV-女性时尚','','CN','','Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:09:56','(cctv-?)?女性时尚');
+INSERT INTO "channel" VALUES(38,'CCTV央视文化精品','CCTV-央视文化精品',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:44','(cctv-?)?央视文化精品');
+INSERT INTO "channel" VALUES(39,'CCTV央视台球','CCTV-央视台球',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:10:57','(cctv-?)?央视台球');
+INSERT INTO "channel" VALUES(40,'CCTV电视指南','CCTV-电视指南',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:11:12','(cctv-?)?电视指南');
+INSERT INTO "channel" VALUES(41,'CCTV卫生健康','CCTV-卫生健康',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:42:12','2025-12-01 06:11:20','(cctv-?)?卫生健康');
+INSERT INTO "channel" VALUES(42,'北京卫视','北京卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:11:40','^北京卫视');
+INSERT INTO "channel" VALUES(43,'江苏卫视','江苏卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:12:01','^江苏卫视');
+INSERT INTO "channel" VALUES(44,'东方卫视','东方卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:30','^东方卫视');
+INSERT INTO "channel" VALUES(45,'浙江卫视','浙江卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:24','^浙江卫视');
+INSERT INTO "channel" VALUES(46,'湖南卫视','湖南卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:18','^湖南卫视');
+INSERT INTO "channel" VALUES(47,'湖北卫视','湖北卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:46','^湖北卫视');
+INSERT INTO "channel" VALUES(48,'广东卫视','广东卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:14:56','^广东卫视');
+INSERT INTO "channel" VALUES(49,'广西卫视','广西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:06','^广西卫视');
+INSERT INTO "channel" VALUES(50,'黑龙江卫视','黑龙江卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:16','^黑龙江卫视');
+INSERT INTO "channel" VALUES(51,'海南卫视','海南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:24','^海南卫视');
+INSERT INTO "channel" VALUES(52,'重庆卫视','重庆卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:33','^重庆卫视');
+INSERT INTO "channel" VALUES(53,'深圳卫视','深圳卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:41','^深圳卫视');
+INSERT INTO "channel" VALUES(54,'四川卫视','四川卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:49','^四川卫视');
+INSERT INTO "channel" VALUES(55,'河南卫视','河南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:15:57','^河南卫视');
+INSERT INTO "channel" VALUES(56,'东南卫视','东南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:32','(福建)?东南卫视');
+INSERT INTO "channel" VALUES(57,'贵州卫视','贵州卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:40','^贵州卫视');
+INSERT INTO "channel" VALUES(58,'江西卫视','江西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:49','^江西卫视');
+INSERT INTO "channel" VALUES(59,'辽宁卫视','辽宁卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:16:57','^辽宁卫视');
+INSERT INTO "channel" VALUES(60,'安徽卫视','安徽卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:05','^安徽卫视');
+INSERT INTO "channel" VALUES(61,'河北卫视','河北卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:15','^河北卫视');
+INSERT INTO "channel" VALUES(62,'山东卫视','山东卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:26','^山东卫视');
+INSERT INTO "channel" VALUES(63,'天津卫视','天津卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:33','^天津卫视');
+INSERT INTO "channel" VALUES(64,'吉林卫视','吉林卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:42','^吉林卫视');
+INSERT INTO "channel" VALUES(65,'陕西卫视','陕西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:03','^陕西卫视');
+INSERT INTO "channel" VALUES(66,'宁夏卫视','宁夏卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:13','^宁夏卫视');
+INSERT INTO "channel" VALUES(67,'内蒙古卫视','内蒙古卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:17:53','^内蒙古卫视');
+INSERT INTO "channel" VALUES(68,'云南卫视','云南卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:24','^云南卫视');
+INSERT INTO "channel" VALUES(69,'山西卫视','山西卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:33','^山西卫视');
+INSERT INTO "channel" VALUES(70,'青海卫视','青海卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:54','^青海卫视');
+INSERT INTO "channel" VALUES(71,'西藏卫视','西藏卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:18:43','^西藏卫视');
+INSERT INTO "channel" VALUES(72,'新疆卫视','新疆卫视','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:19:01','^新疆卫视');
+INSERT INTO "channel" VALUES(73,'三沙卫视','三沙卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:02:59','2025-12-01 06:19:10','^三沙卫视');
+INSERT INTO "channel" VALUES(74,'延边卫视','延边卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:07:25','2025-12-01 06:19:18','^延边卫视');
+INSERT INTO "channel" VALUES(75,'厦门卫视','厦门卫视','','CN','','Asia/Shanghai',1,'2025-11-16 02:08:51','2025-12-01 06:19:38','^厦门卫视');
+INSERT INTO "channel" VALUES(76,'兵团卫视','兵团卫视','','CN','','Asia/Shanghai',1,'2025-11-15 02:20:30','2025-12-01 06:20:47','^兵团卫视');
+INSERT INTO "channel" VALUES(77,'大湾区卫视','大湾区卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:29','^大湾区卫视');
+INSERT INTO "channel" VALUES(78,'海峡卫视','海峡卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:39','^海峡卫视');
+INSERT INTO "channel" VALUES(79,'农林卫视','中国农林卫视','','CN','','Asia/Shanghai',1,'2025-11-15 06:00:45','2025-12-01 06:20:11','(中国)?农林卫视');
+INSERT INTO "channel" VALUES(80,'CETV1','CETV-1','','CN','','Asia/Shanghai',1,'2025-11-12 08:56:06','2025-12-01 06:29:25','(cetv-?1)|(中国教育-?1)');
+INSERT INTO "channel" VALUES(81,'CHC影迷电影','CHC影迷电影','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:29:41','^CHC影迷电影');
+INSERT INTO "channel" VALUES(82,'CHC动作电影','CHC动作电影','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:29:50','^CHC动作电影');
+INSERT INTO "channel" VALUES(83,'CHC家庭影院','CHC家庭影院','','CN','','Asia/Shanghai',1,'2025-11-12 09:00:49','2025-12-01 06:30:00','^CHC家庭影院');
+INSERT INTO "channel" VALUES(84,'凤凰中文','凤凰卫视中文台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:30:54','凤凰(卫视)?中文(台)?');
+INSERT INTO "channel" VALUES(85,'凤凰资讯','凤凰卫视资讯台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:30:43','凤凰(卫视)?资讯(台)?');
+INSERT INTO "channel" VALUES(86,'凤凰香港','凤凰卫视香港台','','HK','','Asia/Shanghai',1,'2025-11-12 09:03:42','2025-12-01 06:31:14','凤凰(卫视)?香港(台)?');
+INSERT INTO "channel" VALUES(87,'上海新闻综合','上海新闻综合','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:31:39','^上海新闻综合');
+INSERT INTO "channel" VALUES(88,'第一财经','第一财经','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:14','^(上海)?第一财经');
+INSERT INTO "channel" VALUES(89,'新纪实','新纪实','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:32:27','^(上海)新纪实');
+INSERT INTO "channel" VALUES(90,'五星体育','五星体育','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:30','^(上海)五星体育');
+INSERT INTO "channel" VALUES(91,'哈哈炫动','哈哈炫动','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:43','^(上海)哈哈炫动');
+INSERT INTO "channel" VALUES(92,'上海都市频道','上海都市频道','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:33:54','^上海都市频道');
+INSERT INTO "channel" VALUES(93,'东方影视','东方影视','','CN','','Asia/Shanghai',1,'2025-11-14 11:26:40','2025-12-01 06:34:09','^(上海)东方影视');
+INSERT INTO "channel" VALUES(94,'南京新闻综合','南京新闻综合频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:34:27','^南京新闻综合');
+INSERT INTO "channel" VALUES(95,'南京教科','南京教科频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:42:06','^南京教科');
+INSERT INTO "channel" VALUES(96,'南京十八','南京十八频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^南京十八');
+INSERT INTO "channel" VALUES(97,'江苏体育休闲','江苏体育休闲频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏体育休闲');
+INSERT INTO "channel" VALUES(98,'江苏城市','江苏城市频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏城市');
+INSERT INTO "channel" VALUES(99,'江苏国际','江苏国际频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏国际');
+INSERT INTO "channel" VALUES(100,'江苏教育','江苏教育频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏教育');
+INSERT INTO "channel" VALUES(101,'江苏影视','江苏影视频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏影视');
+INSERT INTO "channel" VALUES(102,'江苏综艺','江苏综艺频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏综艺');
+INSERT INTO "channel" VALUES(103,'江苏新闻','江苏新闻频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江苏新闻');
+INSERT INTO "channel" VALUES(104,'盐城新闻综合','盐城新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^盐城新闻综合');
+INSERT INTO "channel" VALUES(105,'淮安综合','淮安综合频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^淮安综合');
+INSERT INTO "channel" VALUES(106,'泰州新闻综合','泰州新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^泰州新闻综合');
+INSERT INTO "channel" VALUES(107,'连云港新闻综合','连云港新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^连云港新闻综合');
+INSERT INTO "channel" VALUES(108,'宿迁新闻综合','宿迁新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^宿迁新闻综合');
+INSERT INTO "channel" VALUES(109,'徐州新闻综合','徐州新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^徐州新闻综合');
+INSERT INTO "channel" VALUES(110,'优漫卡通','优漫卡通频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^优漫卡通');
+INSERT INTO "channel" VALUES(111,'江阴新闻综合','江阴新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^江阴新闻综合');
+INSERT INTO "channel" VALUES(112,'南通新闻综合','南通新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^南通新闻综合');
+INSERT INTO "channel" VALUES(113,'宜兴新闻综合','宜兴新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^宜兴新闻综合');
+INSERT INTO "channel" VALUES(114,'溧水新闻综合','溧水新闻综合',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^溧水新闻综合');
+INSERT INTO "channel" VALUES(115,'陕西银龄','陕西银龄频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西银龄');
+INSERT INTO "channel" VALUES(116,'陕西都市青春','陕西都市青春频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西都市青春');
+INSERT INTO "channel" VALUES(117,'陕西体育休闲','陕西体育休闲频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西体育休闲');
+INSERT INTO "channel" VALUES(118,'陕西秦腔','陕西秦腔频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西秦腔');
+INSERT INTO "channel" VALUES(119,'陕西新闻资讯','陕西新闻资讯频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^陕西新闻资讯');
+INSERT INTO "channel" VALUES(120,'财富天下','江苏财富天下',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:43:22','2025-12-01 06:41:31','^财富天下');
+INSERT INTO "channel" VALUES(121,'北京文艺','北京文艺频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:43:28','^(北京|BRTV)文艺');
+INSERT INTO "channel" VALUES(122,'北京纪实科教','北京纪实科教频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:10','^(北京|BRTV)纪实科教');
+INSERT INTO "channel" VALUES(123,'北京影视','北京影视频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:31','^(北京|BRTV)影视');
+INSERT INTO "channel" VALUES(124,'北京财经','北京财经频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:40','^(北京|BRTV)财经');
+INSERT INTO "channel" VALUES(125,'北京体育休闲','北京体育休闲频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:44:49','^(北京|BRTV)体育休闲');
+INSERT INTO "channel" VALUES(126,'北京生活','北京生活频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:45:01','^(北京|BRTV)生活');
+INSERT INTO "channel" VALUES(127,'北京新闻','北京新闻频道','','CN','','Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:45:15','^(北京|BRTV)新闻');
+INSERT INTO "channel" VALUES(128,'卡酷少儿','卡酷少儿频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 10:53:30','2025-12-01 06:41:31','^卡酷少儿');
+INSERT INTO "channel" VALUES(129,'广东珠江','广东珠江',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东珠江');
+INSERT INTO "channel" VALUES(130,'广东新闻','广东新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东新闻');
+INSERT INTO "channel" VALUES(131,'广东民生','广东民生',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东民生');
+INSERT INTO "channel" VALUES(132,'广东体育','广东体育',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东体育');
+INSERT INTO "channel" VALUES(133,'广东影视','广东影视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东影视');
+INSERT INTO "channel" VALUES(134,'广东少儿','广东少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东少儿');
+INSERT INTO "channel" VALUES(135,'嘉佳卡通','嘉佳卡通',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^嘉佳卡通');
+INSERT INTO "channel" VALUES(136,'岭南戏曲','岭南戏曲',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^岭南戏曲');
+INSERT INTO "channel" VALUES(137,'广东移动','广东移动',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东移动');
+INSERT INTO "channel" VALUES(138,'现代教育','现代教育',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^现代教育');
+INSERT INTO "channel" VALUES(139,'广东台经典剧','广东台经典剧',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-15 12:04:28','2025-12-01 06:41:31','^广东台经典剧');
+INSERT INTO "channel" VALUES(140,'山东齐鲁','齐鲁频道','','CN','','Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:45:58','^(山东)齐鲁(频道)?');
+INSERT INTO "channel" VALUES(141,'山东体育','山东体育频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东体育');
+INSERT INTO "channel" VALUES(142,'山东生活','山东生活频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东生活');
+INSERT INTO "channel" VALUES(143,'山东综艺','山东综艺频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东综艺');
+INSERT INTO "channel" VALUES(144,'山东新闻','山东新闻频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东新闻');
+INSERT INTO "channel" VALUES(145,'山东农科','山东农科频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东农科');
+INSERT INTO "channel" VALUES(146,'山东文旅','山东文旅频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东文旅');
+INSERT INTO "channel" VALUES(147,'山东少儿','山东少儿频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-16 02:25:28','2025-12-01 06:41:31','^山东少儿');
+INSERT INTO "channel" VALUES(148,'黄河电视台','黄河电视台',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^黄河电视台');
+INSERT INTO "channel" VALUES(149,'山西经济与科技','山西经济与科技',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西经济与科技');
+INSERT INTO "channel" VALUES(150,'山西影视','山西影视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西影视');
+INSERT INTO "channel" VALUES(151,'山西社会与法制','山西社会与法制',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西社会与法制');
+INSERT INTO "channel" VALUES(152,'山西文体生活','山西文体生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 12:04:15','2025-12-01 06:41:31','^山西文体生活');
+INSERT INTO "channel" VALUES(153,'苏州4K','苏州4K',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-17 13:23:39','2025-12-01 06:41:31','^苏州4K');
+INSERT INTO "channel" VALUES(154,'海南自贸','海南自贸',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南自贸');
+INSERT INTO "channel" VALUES(155,'海南新闻','海南新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南新闻');
+INSERT INTO "channel" VALUES(156,'海南公共','海南公共',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南公共');
+INSERT INTO "channel" VALUES(157,'海南文旅','海南文旅',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南文旅');
+INSERT INTO "channel" VALUES(158,'海南少儿','海南少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 11:37:26','2025-12-01 06:41:31','^海南少儿');
+INSERT INTO "channel" VALUES(159,'中国天气','中国天气频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-18 16:07:39','2025-12-01 06:41:31','^中国天气');
+INSERT INTO "channel" VALUES(161,'国学频道','国学频道',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-26 03:25:21','2025-12-01 06:41:31','^国学频道');
+INSERT INTO "channel" VALUES(162,'厦视一套','厦视一套',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:12:07','2025-12-01 06:41:31','^厦视一套');
+INSERT INTO "channel" VALUES(163,'厦视二套','厦视二套',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:12:07','2025-12-01 06:41:31','^厦视二套');
+INSERT INTO "channel" VALUES(164,'江西都市','江西都市',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西都市');
+INSERT INTO "channel" VALUES(165,'江西经济生活','江西经济生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西经济生活');
+INSERT INTO "channel" VALUES(166,'江西公共农业','江西公共农业',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西公共农业');
+INSERT INTO "channel" VALUES(167,'江西少儿','江西少儿',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西少儿');
+INSERT INTO "channel" VALUES(168,'江西新闻','江西新闻',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^江西新闻');
+INSERT INTO "channel" VALUES(169,'重温经典','重温经典',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-11-28 08:13:51','2025-12-01 06:41:31','^重温经典');
+INSERT INTO "channel" VALUES(170,'河南新闻','河南新闻频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:45:14','2025-12-06 10:45:32','^河南新闻');
+INSERT INTO "channel" VALUES(171,'河南都市','河南都市频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:48:42','^河南都市');
+INSERT INTO "channel" VALUES(172,'河南民生','河南民生频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:48:50','^河南民生');
+INSERT INTO "channel" VALUES(173,'河南法治','河南法治频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:02','^河南法治');
+INSERT INTO "channel" VALUES(174,'河南公共','河南公共频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:51:41','^河南公共');
+INSERT INTO "channel" VALUES(175,'河南乡村','河南乡村频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:52:11','^河南乡村');
+INSERT INTO "channel" VALUES(176,'河南电视剧','河南电视剧频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:52:02','^河南电视剧');
+INSERT INTO "channel" VALUES(177,'河南梨园','河南梨园频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:51:53','^河南梨园');
+INSERT INTO "channel" VALUES(178,'河南文物宝库','河南文物宝库','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:50:52','^河南文物宝库');
+INSERT INTO "channel" VALUES(179,'河南武术','河南武术频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:53','^河南武术');
+INSERT INTO "channel" VALUES(180,'睛彩中原','睛彩中原','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:38','^睛彩中原');
+INSERT INTO "channel" VALUES(181,'河南移动戏曲','河南移动戏曲频道','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:30','^河南移动戏曲');
+INSERT INTO "channel" VALUES(182,'象视界','象视界','','CN','','Asia/Shanghai',1,'2025-12-06 10:48:23','2025-12-06 10:49:16','象视界');
+INSERT INTO "channel" VALUES(184,'陕西移动电视','陕西移动电视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-06 11:09:06','2025-12-06 11:09:06','^陕西移动电视');
+INSERT INTO "channel" VALUES(185,'甘肃卫视','甘肃卫视',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:06:18','2025-12-08 15:06:18','^甘肃卫视');
+INSERT INTO "channel" VALUES(186,'河北经济生活','河北经济生活',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:14:21','2025-12-08 15:14:21','^河北经济生活');
+INSERT INTO "channel" VALUES(187,'河北三农','河北三农',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:14:21','2025-12-08 15:14:21','^河北三农');
+INSERT INTO "channel" VALUES(188,'河北都市','河北都市',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:14:21','2025-12-08 15:14:21','^河北都市');
+INSERT INTO "channel" VALUES(189,'河北影视剧','河北影视剧',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:14:21','2025-12-08 15:14:21','^河北影视剧');
+INSERT INTO "channel" VALUES(190,'河北少儿科教','河北少儿科教',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:14:21','2025-12-08 15:14:21','^河北少儿科教');
+INSERT INTO "channel" VALUES(191,'河北文旅公共','河北文旅公共',NULL,'CN',NULL,'Asia/Shanghai',1,'2025-12-08 15:14:21','2025-12-08 15:14:21','^河北文旅公共');
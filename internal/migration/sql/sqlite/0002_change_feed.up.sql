@@ -0,0 +1,31 @@
+CREATE TABLE "program_change" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"channel_id" VARCHAR(50) NOT NULL,
+	"provider_id" VARCHAR(50) NULL,
+	"date" VARCHAR(10) NOT NULL,
+	"change_type" VARCHAR(20) NOT NULL,
+	"title" VARCHAR(500) NULL,
+	"old_title" VARCHAR(500) NULL,
+	"start_time" DATETIME NULL,
+	"end_time" DATETIME NULL,
+	"old_start_time" DATETIME NULL,
+	"old_end_time" DATETIME NULL,
+	"created_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "idx_change_channel_date" ON "program_change" ("channel_id", "date");
+CREATE INDEX "idx_change_created_at" ON "program_change" ("created_at");
+
+CREATE TABLE "webhook" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"name" VARCHAR(100) NOT NULL,
+	"url" VARCHAR(500) NOT NULL,
+	"secret" VARCHAR(255) NULL,
+	"events" VARCHAR(255) NULL,
+	"channel_ids" TEXT NULL,
+	"is_active" TINYINT NULL DEFAULT 1,
+	"last_status" INTEGER NULL,
+	"last_error" VARCHAR(500) NULL,
+	"last_sent_at" DATETIME NULL,
+	"created_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+	"updated_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP
+);
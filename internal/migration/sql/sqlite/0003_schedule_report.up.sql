@@ -0,0 +1,14 @@
+CREATE TABLE "schedule_report" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"channel_id" VARCHAR(50) NOT NULL,
+	"provider_id" VARCHAR(50) NULL,
+	"date" VARCHAR(10) NOT NULL,
+	"program_count" INTEGER NULL DEFAULT 0,
+	"coverage" FLOAT NULL DEFAULT 0,
+	"issue_count" INTEGER NULL DEFAULT 0,
+	"repair_count" INTEGER NULL DEFAULT 0,
+	"issues" TEXT NULL,
+	"created_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX "uk_report_channel_date" ON "schedule_report" ("channel_id", "date");
+CREATE INDEX "idx_report_date" ON "schedule_report" ("date");
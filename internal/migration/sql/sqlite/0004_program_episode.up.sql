@@ -0,0 +1 @@
+ALTER TABLE "program" ADD COLUMN "episode" INTEGER NOT NULL DEFAULT 0;
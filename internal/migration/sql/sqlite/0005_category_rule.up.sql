@@ -0,0 +1,45 @@
+CREATE TABLE "category_rule" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"category" VARCHAR(50) NOT NULL,
+	"match_type" VARCHAR(20) NOT NULL,
+	"pattern" VARCHAR(255) NOT NULL,
+	"channel_id" VARCHAR(100) NULL,
+	"priority" INTEGER NOT NULL DEFAULT 0,
+	"is_active" TINYINT NULL DEFAULT 1,
+	"created_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+	"updated_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "idx_priority" ON "category_rule" ("priority");
+
+INSERT INTO "category_rule" VALUES(1,'kids','keyword','动画',NULL,30,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(2,'kids','keyword','少儿',NULL,30,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(3,'kids','keyword','卡通',NULL,30,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(4,'kids','keyword','动漫',NULL,30,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(5,'sports','keyword','体育',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(6,'sports','keyword','足球',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(7,'sports','keyword','篮球',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(8,'sports','keyword','NBA',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(9,'sports','keyword','CBA',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(10,'sports','keyword','赛事',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(11,'sports','keyword','奥运',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(12,'news','keyword','新闻',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(13,'news','keyword','联播',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(14,'news','keyword','资讯',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(15,'news','keyword','焦点访谈',NULL,20,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(16,'documentary','keyword','纪录',NULL,15,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(17,'documentary','keyword','纪实',NULL,15,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(18,'movie','keyword','电影',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(19,'movie','keyword','影院',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(20,'series','keyword','电视剧',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(21,'series','keyword','剧场',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(22,'series','regex','第\d+集',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(23,'series','regex','\(\d+\)$',NULL,5,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(24,'variety','keyword','综艺',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(25,'variety','keyword','晚会',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(26,'variety','keyword','真人秀',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(27,'music','keyword','音乐',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(28,'music','keyword','演唱会',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(29,'music','keyword','戏曲',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(30,'education','keyword','讲堂',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(31,'education','keyword','课堂',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
+INSERT INTO "category_rule" VALUES(32,'education','keyword','科教',NULL,10,1,'2026-10-18 00:00:00','2026-10-18 00:00:00');
@@ -0,0 +1,3 @@
+ALTER TABLE "program" DROP COLUMN "episode_label";
+ALTER TABLE "program" DROP COLUMN "season";
+ALTER TABLE "program" DROP COLUMN "series";
@@ -0,0 +1,3 @@
+ALTER TABLE "program" ADD COLUMN "series" VARCHAR(255) NULL;
+ALTER TABLE "program" ADD COLUMN "season" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "program" ADD COLUMN "episode_label" VARCHAR(50) NULL;
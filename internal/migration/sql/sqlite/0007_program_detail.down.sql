@@ -0,0 +1,4 @@
+ALTER TABLE "program" DROP COLUMN "detail_fetched_at";
+ALTER TABLE "program" DROP COLUMN "rating";
+ALTER TABLE "program" DROP COLUMN "cast";
+ALTER TABLE "program" DROP COLUMN "image_url";
@@ -0,0 +1,4 @@
+ALTER TABLE "program" ADD COLUMN "image_url" VARCHAR(500) NULL;
+ALTER TABLE "program" ADD COLUMN "cast" VARCHAR(500) NULL;
+ALTER TABLE "program" ADD COLUMN "rating" VARCHAR(20) NULL;
+ALTER TABLE "program" ADD COLUMN "detail_fetched_at" DATETIME NULL;
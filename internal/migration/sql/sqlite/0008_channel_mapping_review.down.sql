@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "idx_status";
+ALTER TABLE "channel_mapping" DROP COLUMN "reviewed_at";
+ALTER TABLE "channel_mapping" DROP COLUMN "status";
@@ -0,0 +1,6 @@
+ALTER TABLE "channel_mapping" ADD COLUMN "status" VARCHAR(20) NOT NULL DEFAULT 'pending';
+ALTER TABLE "channel_mapping" ADD COLUMN "reviewed_at" DATETIME NULL;
+CREATE INDEX "idx_status" ON "channel_mapping" ("status");
+
+-- mappings verified before the review queue existed stay verified
+UPDATE "channel_mapping" SET "status" = 'verified' WHERE "is_verified" = 1;
@@ -0,0 +1 @@
+ALTER TABLE "channel_mapping" DROP COLUMN "priority";
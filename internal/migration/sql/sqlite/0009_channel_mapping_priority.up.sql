@@ -0,0 +1 @@
+ALTER TABLE "channel_mapping" ADD COLUMN "priority" INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,16 @@
+ALTER TABLE "channel" ADD COLUMN "regexp" VARCHAR(100) NULL;
+
+-- the first regex alias of each channel goes back, unwrapped again
+UPDATE "channel" SET "regexp" = (
+	SELECT CASE
+		WHEN a."alias" LIKE '.*(?:%).*' THEN substr(a."alias", 6, length(a."alias") - 8)
+		WHEN a."alias" LIKE '%.*' THEN substr(a."alias", 1, length(a."alias") - 2)
+		ELSE a."alias"
+	END
+	FROM "channel_alias" a
+	WHERE a."channel_id" = "channel"."channel_id" AND a."match_type" = 'regex'
+	ORDER BY a."id"
+	LIMIT 1
+);
+
+DROP TABLE IF EXISTS "channel_alias";
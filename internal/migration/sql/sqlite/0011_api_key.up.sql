@@ -0,0 +1,16 @@
+CREATE TABLE "api_key" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"user_id" INTEGER NOT NULL,
+	"name" VARCHAR(100) NOT NULL,
+	"prefix" VARCHAR(20) NOT NULL,
+	"key_hash" VARCHAR(64) NOT NULL,
+	"scopes" VARCHAR(255) NOT NULL,
+	"expires_at" DATETIME NULL,
+	"last_used_at" DATETIME NULL,
+	"last_used_ip" VARCHAR(64) NULL,
+	"revoked_at" DATETIME NULL,
+	"created_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX "uk_api_key_hash" ON "api_key" ("key_hash");
+CREATE INDEX "idx_api_key_user_id" ON "api_key" ("user_id");
@@ -0,0 +1,14 @@
+CREATE TABLE "refresh_token" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"user_id" INTEGER NOT NULL,
+	"session_id" VARCHAR(32) NOT NULL,
+	"token_hash" VARCHAR(64) NOT NULL,
+	"expires_at" DATETIME NOT NULL,
+	"revoked_at" DATETIME NULL,
+	"created_ip" VARCHAR(64) NULL,
+	"created_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX "uk_refresh_token_hash" ON "refresh_token" ("token_hash");
+CREATE INDEX "idx_refresh_token_user_id" ON "refresh_token" ("user_id");
+CREATE INDEX "idx_refresh_token_session_id" ON "refresh_token" ("session_id");
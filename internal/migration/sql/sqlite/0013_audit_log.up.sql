@@ -0,0 +1,15 @@
+CREATE TABLE "audit_log" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"user_id" INTEGER NULL,
+	"username" VARCHAR(50) NOT NULL DEFAULT '',
+	"action" VARCHAR(50) NOT NULL,
+	"resource_type" VARCHAR(50) NOT NULL,
+	"resource_id" VARCHAR(255) NOT NULL DEFAULT '',
+	"ip" VARCHAR(64) NOT NULL DEFAULT '',
+	"before_value" TEXT NULL,
+	"after_value" TEXT NULL,
+	"created_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "idx_audit_log_created_at" ON "audit_log" ("created_at");
+CREATE INDEX "idx_audit_log_user_id" ON "audit_log" ("user_id");
+CREATE INDEX "idx_audit_log_resource" ON "audit_log" ("resource_type", "resource_id");
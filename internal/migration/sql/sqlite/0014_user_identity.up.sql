@@ -0,0 +1,11 @@
+CREATE TABLE "user_identity" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"user_id" INTEGER NOT NULL,
+	"issuer" VARCHAR(255) NOT NULL,
+	"subject" VARCHAR(255) NOT NULL,
+	"created_at" DATETIME NULL DEFAULT CURRENT_TIMESTAMP,
+	"last_login_at" DATETIME NULL,
+	FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX "uk_user_identity_subject" ON "user_identity" ("issuer", "subject");
+CREATE INDEX "idx_user_identity_user_id" ON "user_identity" ("user_id");
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// APIKeyPrefix starts every API key so it can be told apart from a login
+// token in the Authorization header.
+const APIKeyPrefix = "epg_"
+
+const (
+	// ScopeEPGRead reads the public EPG outputs and the admin API
+	ScopeEPGRead = "epg:read"
+	// ScopeSync triggers syncs
+	ScopeSync = "sync"
+	// ScopeAdmin acts with every permission of the owner's role
+	ScopeAdmin = "admin"
+)
+
+// APIKey lets scripts and players authenticate without a login. Only the
+// SHA-256 of the key is stored; the key itself is shown once on creation.
+type APIKey struct {
+	ID         int64      `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
+	UserID     int64      `json:"user_id" gorm:"column:user_id;not null"`
+	Name       string     `json:"name" gorm:"column:name;not null"`
+	Prefix     string     `json:"prefix" gorm:"column:prefix;not null"` // first characters of the key, to tell keys apart
+	KeyHash    string     `json:"-" gorm:"column:key_hash;not null"`
+	Scopes     string     `json:"scopes" gorm:"column:scopes;not null"` // comma separated
+	ExpiresAt  *time.Time `json:"expires_at" gorm:"column:expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at" gorm:"column:last_used_at"`
+	LastUsedIP string     `json:"last_used_ip" gorm:"column:last_used_ip"`
+	RevokedAt  *time.Time `json:"revoked_at" gorm:"column:revoked_at"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"column:created_at"`
+}
+
+func (APIKey) TableName() string {
+	return "api_key"
+}
+
+// APIKeyScopes lists the valid scopes.
+func APIKeyScopes() []string {
+	return []string{ScopeEPGRead, ScopeSync, ScopeAdmin}
+}
+
+func (k *APIKey) ScopeList() []string {
+	if k.Scopes == "" {
+		return nil
+	}
+	return strings.Split(k.Scopes, ",")
+}
+
+func (k *APIKey) HasScope(scope string) bool {
+	return slices.Contains(k.ScopeList(), scope)
+}
+
+// Active reports whether the key is neither revoked nor expired at now.
+func (k *APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// ScopesAllow reports whether the scopes of a key cover a permission. The
+// owner's role still has to grant it as well.
+func ScopesAllow(scopes []string, permission Permission) bool {
+	if slices.Contains(scopes, ScopeAdmin) {
+		return true
+	}
+	switch permission {
+	case PermissionRead:
+		return slices.Contains(scopes, ScopeEPGRead)
+	case PermissionSync:
+		return slices.Contains(scopes, ScopeSync)
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	AuditActionLogin          = "login"
+	AuditActionLoginFailed    = "login_failed"
+	AuditActionPasswordChange = "password_change"
+	AuditActionPasswordReset  = "password_reset"
+	AuditActionCreate         = "create"
+	AuditActionUpdate         = "update"
+	AuditActionDelete         = "delete"
+	AuditActionApprove        = "approve"
+	AuditActionReject         = "reject"
+	AuditActionImport         = "import"
+)
+
+const (
+	AuditResourceUser           = "user"
+	AuditResourceChannel        = "channel"
+	AuditResourceChannelAlias   = "channel_alias"
+	AuditResourceChannelMapping = "channel_mapping"
+)
+
+// AuditLog records who did what to which resource, from where, with the
+// resource as it was before and after the change.
+type AuditLog struct {
+	ID           int64           `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
+	UserID       *int64          `json:"user_id" gorm:"column:user_id"` // nil for failed logins of unknown users
+	Username     string          `json:"username" gorm:"column:username"`
+	Action       string          `json:"action" gorm:"column:action;not null"`
+	ResourceType string          `json:"resource_type" gorm:"column:resource_type;not null"`
+	ResourceID   string          `json:"resource_id" gorm:"column:resource_id"`
+	IP           string          `json:"ip" gorm:"column:ip"`
+	BeforeJSON   *string         `json:"-" gorm:"column:before_value"`
+	AfterJSON    *string         `json:"-" gorm:"column:after_value"`
+	Before       json.RawMessage `json:"before,omitempty" gorm:"-"`
+	After        json.RawMessage `json:"after,omitempty" gorm:"-"`
+	CreatedAt    time.Time       `json:"created_at" gorm:"column:created_at"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_log"
+}
@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+const (
+	CategoryNews        = "news"
+	CategorySports      = "sports"
+	CategoryMovie       = "movie"
+	CategorySeries      = "series"
+	CategoryKids        = "kids"
+	CategoryDocumentary = "documentary"
+	CategoryVariety     = "variety"
+	CategoryMusic       = "music"
+	CategoryEducation   = "education"
+)
+
+const (
+	CategoryMatchKeyword = "keyword"
+	CategoryMatchRegex   = "regex"
+)
+
+// CategoryGenres maps categories to the DVB content descriptor names used as
+// XMLTV <category> values, which players such as Kodi and Tvheadend colour code.
+var CategoryGenres = map[string]string{
+	CategoryNews:        "News / Current affairs",
+	CategorySports:      "Sports",
+	CategoryMovie:       "Movie / Drama",
+	CategorySeries:      "Movie / Drama",
+	CategoryKids:        "Children's / Youth programs",
+	CategoryDocumentary: "Education / Science / Factual topics",
+	CategoryVariety:     "Show / Game show",
+	CategoryMusic:       "Music / Ballet / Dance",
+	CategoryEducation:   "Education / Science / Factual topics",
+}
+
+type CategoryRule struct {
+	ID        int64     `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
+	Category  string    `json:"category" gorm:"column:category;not null"`
+	MatchType string    `json:"match_type" gorm:"column:match_type;not null"`
+	Pattern   string    `json:"pattern" gorm:"column:pattern;not null"`
+	ChannelID string    `json:"channel_id,omitempty" gorm:"column:channel_id"` // empty applies to every channel
+	Priority  int       `json:"priority" gorm:"column:priority"`
+	IsActive  int       `json:"is_active" gorm:"column:is_active;default:1"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+const (
+	ChangeTypeAdded        = "added"
+	ChangeTypeRemoved      = "removed"
+	ChangeTypeTitleChanged = "title_changed"
+	ChangeTypeTimeShifted  = "time_shifted"
+)
+
+type ProgramChange struct {
+	ID           int64      `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
+	ChannelID    string     `json:"channel_id" gorm:"column:channel_id"`
+	ProviderID   string     `json:"provider_id" gorm:"column:provider_id"`
+	Date         string     `json:"date" gorm:"column:date"`
+	ChangeType   string     `json:"change_type" gorm:"column:change_type"`
+	Title        string     `json:"title" gorm:"column:title"`
+	OldTitle     string     `json:"old_title,omitempty" gorm:"column:old_title"`
+	StartTime    *time.Time `json:"start_time,omitempty" gorm:"column:start_time"`
+	EndTime      *time.Time `json:"end_time,omitempty" gorm:"column:end_time"`
+	OldStartTime *time.Time `json:"old_start_time,omitempty" gorm:"column:old_start_time"`
+	OldEndTime   *time.Time `json:"old_end_time,omitempty" gorm:"column:old_end_time"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"column:created_at"`
+}
+
+type Webhook struct {
+	ID         int64      `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
+	Name       string     `json:"name" gorm:"column:name;not null"`
+	URL        string     `json:"url" gorm:"column:url;not null"`
+	Secret     string     `json:"-" gorm:"column:secret"`
+	Events     string     `json:"events" gorm:"column:events"`           // comma separated change types, empty for all
+	ChannelIDs string     `json:"channel_ids" gorm:"column:channel_ids"` // comma separated channel ids, empty for all
+	IsActive   int        `json:"is_active" gorm:"column:is_active;default:1"`
+	LastStatus int        `json:"last_status" gorm:"column:last_status"`
+	LastError  string     `json:"last_error,omitempty" gorm:"column:last_error"`
+	LastSentAt *time.Time `json:"last_sent_at,omitempty" gorm:"column:last_sent_at"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt  time.Time  `json:"updated_at" gorm:"column:updated_at"`
+}
+
+type WebhookPayload struct {
+	Event      string           `json:"event"`
+	ProviderID string           `json:"provider_id"`
+	Date       string           `json:"date"`
+	Changes    []*ProgramChange `json:"changes"`
+	Timestamp  int64            `json:"timestamp"`
+}
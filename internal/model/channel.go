@@ -10,22 +10,30 @@ type Channel struct {
 	Area        string    `json:"area" gorm:"column:area;default:CN"`
 	LogoURL     string    `json:"logo_url" gorm:"column:logo_url;default:null"`
 	IsActive    int       `json:"is_active" gorm:"column:is_active;default:1"`
-	Regexp      string    `json:"regexp" gorm:"column:regexp;default:null"`
 	Timezone    string    `json:"timezone" gorm:"column:timezone;default:Asia/Shanghai"`
 	CreatedAt   time.Time `json:"created_at" gorm:"column:created_at"`
 	UpdatedAt   time.Time `json:"updated_at" gorm:"column:updated_at"`
 }
 
+const (
+	MappingStatusPending  = "pending"
+	MappingStatusVerified = "verified"
+	MappingStatusRejected = "rejected"
+)
+
 type ChannelMapping struct {
-	ID                  int64     `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
-	CanonicalID         string    `json:"canonical_id" gorm:"column:canonical_id"`
-	ProviderID          string    `json:"provider_id" gorm:"column:provider_id"`
-	ProviderChannelID   string    `json:"provider_channel_id" gorm:"column:provider_channel_id"`
-	ProviderChannelName string    `json:"provider_channel_name,omitempty" gorm:"column:provider_channel_name"`
-	Confidence          float64   `json:"confidence" gorm:"column:confidence"`
-	IsVerified          int       `json:"is_verified" gorm:"column:is_verified;default:0"`
-	CreatedAt           time.Time `json:"created_at" gorm:"column:created_at"`
-	UpdatedAt           time.Time `json:"updated_at" gorm:"column:updated_at"`
+	ID                  int64      `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
+	CanonicalID         string     `json:"canonical_id" gorm:"column:canonical_id"`
+	ProviderID          string     `json:"provider_id" gorm:"column:provider_id"`
+	ProviderChannelID   string     `json:"provider_channel_id" gorm:"column:provider_channel_id"`
+	ProviderChannelName string     `json:"provider_channel_name,omitempty" gorm:"column:provider_channel_name"`
+	Confidence          float64    `json:"confidence" gorm:"column:confidence"`
+	IsVerified          int        `json:"is_verified" gorm:"column:is_verified;default:0"`
+	Status              string     `json:"status" gorm:"column:status;default:pending"`
+	Priority            int        `json:"priority" gorm:"column:priority;default:0"` // overrides the provider priority when set
+	ReviewedAt          *time.Time `json:"reviewed_at,omitempty" gorm:"column:reviewed_at"`
+	CreatedAt           time.Time  `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt           time.Time  `json:"updated_at" gorm:"column:updated_at"`
 }
 
 type ProviderChannel struct {
@@ -40,3 +48,30 @@ type ChannelMappingInfo struct {
 	Confidence        float64 `json:"confidence"`
 	ProviderID        string  `json:"provider_id"`
 }
+
+type MappingCandidate struct {
+	ChannelID   string  `json:"channel_id"`
+	DisplayName string  `json:"display_name"`
+	Score       float64 `json:"score"`
+	// Ambiguous marks candidates scoring too close to the best one for an
+	// automatic choice.
+	Ambiguous bool `json:"ambiguous,omitempty"`
+}
+
+// MappingReviewItem is a provider channel waiting for a decision: either it
+// has no mapping yet or its automatic mapping is still pending.
+type MappingReviewItem struct {
+	ProviderID          string              `json:"provider_id"`
+	ProviderChannelID   string              `json:"provider_channel_id"`
+	ProviderChannelName string              `json:"provider_channel_name"`
+	Mapping             *ChannelMapping     `json:"mapping,omitempty"`
+	Candidates          []*MappingCandidate `json:"candidates"`
+}
+
+// MappingImportResult summarises a bulk mapping import. Rows that fail are
+// reported by line and do not stop the rest of the import.
+type MappingImportResult struct {
+	Created int      `json:"created"`
+	Updated int      `json:"updated"`
+	Errors  []string `json:"errors,omitempty"`
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+const (
+	// AliasMatchExact matches the incoming name case-insensitively as is.
+	AliasMatchExact = "exact"
+	// AliasMatchNormalized matches after folding width, script, punctuation
+	// and suffixes such as 高清 or 频道.
+	AliasMatchNormalized = "normalized"
+	// AliasMatchRegex matches when the pattern covers the whole name,
+	// case-insensitively.
+	AliasMatchRegex = "regex"
+)
+
+type ChannelAlias struct {
+	ID        int64     `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
+	ChannelID string    `json:"channel_id" gorm:"column:channel_id;not null"`
+	Alias     string    `json:"alias" gorm:"column:alias;not null"`
+	MatchType string    `json:"match_type" gorm:"column:match_type;not null;default:exact"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
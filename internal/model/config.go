@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// EffectiveConfig is the config the server runs with, secrets redacted.
+type EffectiveConfig struct {
+	Path     string         `json:"path"`
+	LoadedAt time.Time      `json:"loaded_at"`
+	Config   map[string]any `json:"config"`
+	// Overrides maps the keys set from the environment to their variable
+	Overrides map[string]string `json:"overrides"`
+	// RestartRequired lists keys changed in the file since the start that
+	// only take effect after a restart
+	RestartRequired []string `json:"restart_required"`
+}
@@ -6,21 +6,38 @@ import (
 )
 
 type Program struct {
-	ID                int64     `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
-	ChannelID         string    `json:"channel_id" gorm:"column:channel_id"`
-	Title             string    `json:"title" gorm:"column:title"`
-	Description       string    `json:"description" gorm:"column:description"`
-	StartTime         time.Time `json:"start_time" gorm:"column:start_time"`
-	EndTime           time.Time `json:"end_time" gorm:"column:end_time"`
-	Category          string    `json:"category" gorm:"column:category"`
-	ProviderID        string    `json:"provider_id" gorm:"column:provider_id"`
-	ProviderProgramID string    `json:"provider_program_id" gorm:"column:provider_program_id"`
-	OriginalTimezone  string    `json:"original_timezone" gorm:"column:original_timezone;default:Asia/Shanghai"`
-	CreatedAt         time.Time `json:"created_at" gorm:"column:created_at"`
+	ID                int64      `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
+	ChannelID         string     `json:"channel_id" gorm:"column:channel_id"`
+	Title             string     `json:"title" gorm:"column:title"`
+	Description       string     `json:"description" gorm:"column:description"`
+	ImageURL          string     `json:"image_url,omitempty" gorm:"column:image_url"`
+	Cast              string     `json:"cast,omitempty" gorm:"column:cast"` // comma separated
+	Rating            string     `json:"rating,omitempty" gorm:"column:rating"`
+	StartTime         time.Time  `json:"start_time" gorm:"column:start_time"`
+	EndTime           time.Time  `json:"end_time" gorm:"column:end_time"`
+	Category          string     `json:"category" gorm:"column:category"`
+	Series            string     `json:"series,omitempty" gorm:"column:series"`
+	Season            int        `json:"season,omitempty" gorm:"column:season"`
+	Episode           int        `json:"episode,omitempty" gorm:"column:episode"`
+	EpisodeLabel      string     `json:"episode_label,omitempty" gorm:"column:episode_label"` // onscreen form, e.g. S02E15 or 2026-10-16
+	ProviderID        string     `json:"provider_id" gorm:"column:provider_id"`
+	ProviderProgramID string     `json:"provider_program_id" gorm:"column:provider_program_id"`
+	OriginalTimezone  string     `json:"original_timezone" gorm:"column:original_timezone;default:Asia/Shanghai"`
+	CreatedAt         time.Time  `json:"created_at" gorm:"column:created_at"`
+	DetailFetchedAt   *time.Time `json:"-" gorm:"column:detail_fetched_at"`
 
 	Channel *Channel `json:"channel,omitempty" gorm:"foreignKey:ChannelID;references:ChannelID"`
 }
 
+// ProgramDetail is what a provider can tell about a single program beyond
+// its schedule entry.
+type ProgramDetail struct {
+	Description string   `json:"description"`
+	ImageURL    string   `json:"image_url"`
+	Cast        []string `json:"cast"`
+	Rating      string   `json:"rating"`
+}
+
 type XMLTVEPG struct {
 	XMLName    xml.Name        `xml:"tv"`
 	Channels   []*XMLTVChannel `xml:"channel"`
@@ -34,12 +51,34 @@ type XMLTVChannel struct {
 }
 
 type XMLTVProgram struct {
-	XMLName xml.Name `xml:"programme"`
-	Channel string   `xml:"channel,attr"`
-	Start   string   `xml:"start,attr"`
-	Stop    string   `xml:"stop,attr"`
-	Title   string   `xml:"title"`
-	Desc    string   `xml:"desc"`
+	XMLName    xml.Name           `xml:"programme"`
+	Channel    string             `xml:"channel,attr"`
+	Start      string             `xml:"start,attr"`
+	Stop       string             `xml:"stop,attr"`
+	Title      string             `xml:"title"`
+	Desc       string             `xml:"desc"`
+	Credits    *XMLTVCredits      `xml:"credits,omitempty"`
+	Category   []string           `xml:"category,omitempty"`
+	Icon       *XMLTVIcon         `xml:"icon,omitempty"`
+	EpisodeNum []*XMLTVEpisodeNum `xml:"episode-num,omitempty"`
+	Rating     *XMLTVRating       `xml:"rating,omitempty"`
+}
+
+type XMLTVCredits struct {
+	Actor []string `xml:"actor"`
+}
+
+type XMLTVIcon struct {
+	Src string `xml:"src,attr"`
+}
+
+type XMLTVRating struct {
+	Value string `xml:"value"`
+}
+
+type XMLTVEpisodeNum struct {
+	System string `xml:"system,attr"`
+	Value  string `xml:",chardata"`
 }
 
 type DIYPChannelEPG struct {
@@ -12,6 +12,7 @@ type ProviderConfig struct {
 	RateLimit  int            `yaml:"rate_limit"`
 	MaxRetries int            `yaml:"max_retries"`
 	Settings   map[string]any `yaml:"settings"`
+	TitleRules []TitleRule    `yaml:"title_rules"`
 }
 type ProviderHealth struct {
 	ProviderID string    `json:"provider_id"`
@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+const (
+	IssueTypeGap             = "gap"
+	IssueTypeOverlap         = "overlap"
+	IssueTypeDuplicate       = "duplicate"
+	IssueTypeTooShort        = "too_short"
+	IssueTypeTooLong         = "too_long"
+	IssueTypeLowCoverage     = "low_coverage"
+	IssueTypeCrossesMidnight = "crosses_midnight"
+
+	IssueSeverityWarning = "warning"
+	IssueSeverityInfo    = "info"
+)
+
+type ScheduleIssue struct {
+	Type      string     `json:"type"`
+	Severity  string     `json:"severity"`
+	Message   string     `json:"message"`
+	Title     string     `json:"title,omitempty"`
+	StartTime *time.Time `json:"start_time,omitempty"`
+	EndTime   *time.Time `json:"end_time,omitempty"`
+	Repaired  bool       `json:"repaired,omitempty"`
+}
+
+type ScheduleReport struct {
+	ID           int64            `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
+	ChannelID    string           `json:"channel_id" gorm:"column:channel_id"`
+	ProviderID   string           `json:"provider_id" gorm:"column:provider_id"`
+	Date         string           `json:"date" gorm:"column:date"`
+	ProgramCount int              `json:"program_count" gorm:"column:program_count"`
+	Coverage     float64          `json:"coverage" gorm:"column:coverage"`
+	IssueCount   int              `json:"issue_count" gorm:"column:issue_count"`
+	RepairCount  int              `json:"repair_count" gorm:"column:repair_count"`
+	IssuesJSON   string           `json:"-" gorm:"column:issues"`
+	Issues       []*ScheduleIssue `json:"issues" gorm:"-"`
+	CreatedAt    time.Time        `json:"created_at" gorm:"column:created_at"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// RefreshToken is one link in the chain of refresh tokens of a login
+// session. Every refresh revokes the presented token and issues the next one
+// with the same SessionID; only the SHA-256 of the token is stored.
+type RefreshToken struct {
+	ID        int64      `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
+	UserID    int64      `json:"user_id" gorm:"column:user_id;not null"`
+	SessionID string     `json:"session_id" gorm:"column:session_id;not null"`
+	TokenHash string     `json:"-" gorm:"column:token_hash;not null"`
+	ExpiresAt time.Time  `json:"expires_at" gorm:"column:expires_at;not null"`
+	RevokedAt *time.Time `json:"revoked_at" gorm:"column:revoked_at"`
+	CreatedIP string     `json:"created_ip" gorm:"column:created_ip"`
+	CreatedAt time.Time  `json:"created_at" gorm:"column:created_at"`
+}
+
+func (RefreshToken) TableName() string {
+	return "refresh_token"
+}
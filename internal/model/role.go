@@ -0,0 +1,51 @@
+package model
+
+import "slices"
+
+const (
+	RoleViewer   = "viewer"   // read-only access to the admin API
+	RoleOperator = "operator" // runs syncs and reviews channel mappings
+	RoleAdmin    = "admin"    // everything, including user management
+)
+
+type Permission string
+
+const (
+	// PermissionRead covers every GET under /admin
+	PermissionRead Permission = "read"
+	// PermissionSync triggers EPG syncs
+	PermissionSync Permission = "sync"
+	// PermissionMappings creates, edits, imports and reviews channel mappings
+	PermissionMappings Permission = "mappings"
+	// PermissionConfigure edits channels, aliases, category rules and webhooks
+	PermissionConfigure Permission = "configure"
+	// PermissionManageUsers lists users, changes their roles and deletes them
+	PermissionManageUsers Permission = "manage_users"
+)
+
+var rolePermissions = map[string][]Permission{
+	RoleViewer:   {PermissionRead},
+	RoleOperator: {PermissionRead, PermissionSync, PermissionMappings},
+	RoleAdmin:    {PermissionRead, PermissionSync, PermissionMappings, PermissionConfigure, PermissionManageUsers},
+}
+
+// Roles lists the known roles from least to most privileged.
+func Roles() []string {
+	return []string{RoleViewer, RoleOperator, RoleAdmin}
+}
+
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// RoleHasPermission reports whether the role grants the permission. Unknown
+// roles grant nothing.
+func RoleHasPermission(role string, permission Permission) bool {
+	return slices.Contains(rolePermissions[role], permission)
+}
+
+// RolePermissions returns the permissions of a role.
+func RolePermissions(role string) []Permission {
+	return slices.Clone(rolePermissions[role])
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+)
+
+const (
+	TitleRuleRegexReplace = "regex_replace"
+	TitleRuleTrim         = "trim"
+	TitleRuleHalfWidth    = "half_width"
+	TitleRuleSimplified   = "simplified"
+	TitleRuleEpisode      = "episode"
+)
+
+// TitleRule is one step of the title normalization chain. Pattern and Replace
+// are used by regex_replace; episode uses Pattern with a single capture group
+// for the episode number and removes the whole match from the title.
+type TitleRule struct {
+	Name    string `yaml:"name" json:"name,omitempty"`
+	Type    string `yaml:"type" json:"type"`
+	Pattern string `yaml:"pattern" json:"pattern,omitempty"`
+	Replace string `yaml:"replace" json:"replace,omitempty"`
+}
+
+// TitleRuleError tells which field of a title rule is wrong.
+type TitleRuleError struct {
+	Field  string
+	Reason string
+}
+
+func (e *TitleRuleError) Error() string {
+	return e.Field + ": " + e.Reason
+}
+
+// Compile checks the rule and returns its compiled pattern, which is nil for
+// the types that take none. Errors are *TitleRuleError.
+func (r TitleRule) Compile() (*regexp.Regexp, error) {
+	switch r.Type {
+	case TitleRuleTrim, TitleRuleHalfWidth, TitleRuleSimplified:
+		return nil, nil
+	case TitleRuleRegexReplace, TitleRuleEpisode:
+	default:
+		return nil, &TitleRuleError{Field: "type", Reason: fmt.Sprintf("unsupported title rule type: %s", r.Type)}
+	}
+
+	if r.Pattern == "" {
+		return nil, &TitleRuleError{Field: "pattern", Reason: fmt.Sprintf("pattern is required for %s", r.Type)}
+	}
+	re, err := regexp.Compile(r.Pattern)
+	if err != nil {
+		return nil, &TitleRuleError{Field: "pattern", Reason: fmt.Sprintf("invalid pattern %q: %v", r.Pattern, err)}
+	}
+	if r.Type == TitleRuleEpisode && re.NumSubexp() < 1 {
+		return nil, &TitleRuleError{Field: "pattern", Reason: "episode pattern needs a capture group for the episode number"}
+	}
+	return re, nil
+}
+
+type TitleRuleStep struct {
+	Rule    string `json:"rule"`
+	Title   string `json:"title"`
+	Episode int    `json:"episode,omitempty"`
+	Changed bool   `json:"changed"`
+}
+
+type TitleDryRunResult struct {
+	ProviderID string           `json:"provider_id"`
+	Original   string           `json:"original"`
+	Result     string           `json:"result"`
+	Episode    int              `json:"episode,omitempty"`
+	Steps      []*TitleRuleStep `json:"steps"`
+}
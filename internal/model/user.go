@@ -7,7 +7,7 @@ type User struct {
 	Username  string    `json:"username" gorm:"column:username;unique;not null"`
 	Password  string    `json:"-" gorm:"column:password;not null"`
 	Email     string    `json:"email" gorm:"column:email"`
-	Role      string    `json:"role" gorm:"column:role;default:admin"` // admin
+	Role      string    `json:"role" gorm:"column:role;default:admin"` // viewer, operator or admin
 	IsActive  int       `json:"is_active" gorm:"column:is_active;default:1"`
 	CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at"`
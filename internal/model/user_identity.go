@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// UserIdentity links a user to an account at an OIDC provider. The issuer
+// and subject together identify the account; the username it had at the
+// provider is not used for the link since it may change.
+type UserIdentity struct {
+	ID          int64      `json:"id" gorm:"column:id;primaryKey;autoIncrement;not null"`
+	UserID      int64      `json:"user_id" gorm:"column:user_id;not null"`
+	Issuer      string     `json:"issuer" gorm:"column:issuer;not null"`
+	Subject     string     `json:"subject" gorm:"column:subject;not null"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"column:created_at"`
+	LastLoginAt *time.Time `json:"last_login_at" gorm:"column:last_login_at"`
+}
+
+func (UserIdentity) TableName() string {
+	return "user_identity"
+}
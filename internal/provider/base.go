@@ -23,6 +23,12 @@ type MultiDayEPGFetcher interface {
 	FetchEPGMultiDay(ctx context.Context, providerChannelID, channelID string, startDate, endDate time.Time) (map[string][]*model.Program, error)
 }
 
+// ProgramDetailFetcher is implemented by providers whose API can describe a
+// single program. Details are fetched lazily after the schedule is stored.
+type ProgramDetailFetcher interface {
+	FetchProgramDetail(ctx context.Context, program *model.Program) (*model.ProgramDetail, error)
+}
+
 type BaseProvider struct {
 	config     *model.ProviderConfig
 	httpClient *HTTPClient
@@ -315,6 +321,7 @@ func (p *BaseProvider) FetchEPGBatch(ctx context.Context, fetcher EPGFetcher, ch
 					)
 
 					if fetchErr == nil {
+						programsByDate = NormalizeProgramsByDate(programsByDate)
 						logger.Debug("Fetched multi-day EPG and putting to cache",
 							logger.String("provider", fetcher.GetID()),
 							logger.String("channel", channelInfo.CanonicalID),
@@ -327,7 +334,7 @@ func (p *BaseProvider) FetchEPGBatch(ctx context.Context, fetcher EPGFetcher, ch
 						err = fetchErr
 					}
 				} else {
-					programs, err = fetcher.FetchEPG(ctx, channelInfo.ProviderChannelID, channelInfo.CanonicalID, date)
+					programs, err = fetchDay(ctx, fetcher, channelInfo.ProviderChannelID, channelInfo.CanonicalID, date)
 					if err == nil && programs != nil {
 						p.putToCache(ctx, channelInfo.ProviderChannelID, channelInfo.CanonicalID, date, programs)
 					}
@@ -401,20 +408,13 @@ func parseTimestamp(timestamp int64) time.Time {
 	return time.Unix(timestamp, 0)
 }
 
+// validateTimeRange checks the date the programs were requested for. Times are
+// returned unclamped so programs crossing midnight keep their real boundaries;
+// NormalizePrograms decides which day they belong to.
 func (p *BaseProvider) validateTimeRange(st, et time.Time, date string, location *time.Location) (time.Time, time.Time, error) {
-	d, err := time.ParseInLocation("2006-01-02", date, location)
-	if err != nil {
+	if _, err := time.ParseInLocation("2006-01-02", date, location); err != nil {
 		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse date: %w", err)
 	}
-	startOfDay := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, location)
-	endOfDay := time.Date(d.Year(), d.Month(), d.Day(), 23, 59, 59, 0, location)
-	if st.Before(startOfDay) {
-		st = startOfDay
-	}
-
-	if et.After(endOfDay) {
-		et = endOfDay
-	}
 
 	return st, et, nil
 }
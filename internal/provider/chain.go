@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/epg-sync/epgsync/internal/cache"
@@ -14,37 +15,60 @@ import (
 )
 
 type Chain struct {
+	mu        sync.RWMutex
 	providers []Provider
 }
 
 func NewChain(cache cache.Cache, providers ...Provider) *Chain {
-	chain := &Chain{
-		providers: make([]Provider, 0, len(providers)),
+	return &Chain{
+		providers: enabledByPriority(cache, providers),
 	}
+}
+
+// Replace swaps the providers of the chain, as on a config reload. Everyone
+// holding the chain sees the new providers from their next call on; fetches
+// already running finish with the old ones.
+func (c *Chain) Replace(cache cache.Cache, providers ...Provider) {
+	enabled := enabledByPriority(cache, providers)
 
+	c.mu.Lock()
+	c.providers = enabled
+	c.mu.Unlock()
+}
+
+func enabledByPriority(cache cache.Cache, providers []Provider) []Provider {
+	enabled := make([]Provider, 0, len(providers))
 	for _, p := range providers {
 		if p.IsEnabled() {
 			p.SetCache(cache)
-			chain.providers = append(chain.providers, p)
+			enabled = append(enabled, p)
 		}
 	}
 
-	chain.sortByPriority()
-	return chain
-}
-
-func (c *Chain) sortByPriority() {
-	sort.Slice(c.providers, func(i, j int) bool {
-		return c.providers[i].GetPriority() < c.providers[j].GetPriority()
+	sort.Slice(enabled, func(i, j int) bool {
+		return enabled[i].GetPriority() < enabled[j].GetPriority()
 	})
+	return enabled
 }
 
 func (c *Chain) GetProviders() []Provider {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.providers
 }
 
+// GetProvider returns the enabled provider with the given ID.
+func (c *Chain) GetProvider(id string) (Provider, bool) {
+	for _, p := range c.GetProviders() {
+		if p.GetID() == id {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
 func (c *Chain) FetchEPG(ctx context.Context, channelMappingInfo *model.ChannelMappingInfo, date time.Time) ([]*model.Program, error) {
-	providers := c.providers
+	providers := c.GetProviders()
 	if len(providers) == 0 {
 		return nil, errors.New(errors.ErrCodeProviderNotFound, "no enabled providers")
 	}
@@ -69,7 +93,7 @@ func (c *Chain) FetchEPG(ctx context.Context, channelMappingInfo *model.ChannelM
 			logger.Time("date", date),
 		)
 
-		data, err := provider.FetchEPG(ctx, providerChannelID, channelID, date)
+		data, err := fetchDay(ctx, provider, providerChannelID, channelID, date)
 		if err != nil {
 			logger.Warn("Provider fetch failed",
 				logger.String("provider_id", provider.GetID()),
@@ -94,7 +118,7 @@ func (c *Chain) FetchEPG(ctx context.Context, channelMappingInfo *model.ChannelM
 }
 
 func (c *Chain) FetchEPGParallel(ctx context.Context, channelMappingInfo []*model.ChannelMappingInfo, date time.Time) ([]*model.Program, error) {
-	providers := c.providers
+	providers := c.GetProviders()
 
 	if len(providers) == 0 {
 		return nil, errors.New(errors.ErrCodeProviderNotFound, "no enabled providers")
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 
@@ -62,6 +63,46 @@ func (f *Factory) CreateProviders(configs []model.ProviderConfig) ([]Provider, e
 	return providers, nil
 }
 
+// BuildProviders builds new providers from configs without touching the
+// cached ones, so changed settings such as the timeout take effect once they
+// are passed to SetProviders. Unlike CreateProviders it fails when any
+// enabled provider cannot be built, naming each of them.
+func (f *Factory) BuildProviders(configs []model.ProviderConfig) ([]Provider, error) {
+	providers := make([]Provider, 0, len(configs))
+	var errs []error
+
+	for _, cfg := range configs {
+		if !cfg.Enabled {
+			continue
+		}
+
+		p, err := f.registry.Create(cfg.ID, &cfg)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("create provider %s: %w", cfg.ID, err))
+			continue
+		}
+
+		providers = append(providers, p)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return providers, nil
+}
+
+// SetProviders replaces the cached providers with ones from BuildProviders.
+func (f *Factory) SetProviders(providers []Provider) {
+	created := make(map[string]Provider, len(providers))
+	for _, p := range providers {
+		created[p.GetID()] = p
+	}
+
+	f.mu.Lock()
+	f.providers = created
+	f.mu.Unlock()
+}
+
 func (f *Factory) CreateChain(configs []model.ProviderConfig, cache cache.Cache) (*Chain, error) {
 	providers, err := f.CreateProviders(configs)
 	if err != nil {
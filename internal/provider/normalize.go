@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/cache"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/pkg/logger"
+)
+
+// boundaryTolerance is how far apart the two halves of a program split at
+// midnight may be and still be stitched back together.
+const boundaryTolerance = time.Minute
+
+// NormalizePrograms prepares one day of parsed programs for persistence:
+// programs split at midnight are stitched back together, missing end times
+// are inferred from the next start, and programs that started on another day
+// are dropped so the overlap between day N and day N+1 fetches is stored once.
+func NormalizePrograms(programs []*model.Program, date string) []*model.Program {
+	if len(programs) == 0 {
+		return programs
+	}
+
+	result := make([]*model.Program, 0, len(programs))
+	for _, p := range normalizeSequence(programs) {
+		dayStart, dayEnd, err := dayBounds(date, ProgramLocation(p))
+		if err != nil || p.StartTime.Before(dayStart) || !p.StartTime.Before(dayEnd) {
+			continue
+		}
+		result = append(result, p)
+	}
+
+	return result
+}
+
+// sourceCacheTTL is how long a day as returned by the provider is kept, the
+// same as the normalized days in BaseProvider.
+const sourceCacheTTL = 20 * time.Minute
+
+// fetchDay fetches and normalizes one day of programs. When the last program
+// of the day may continue past midnight, the next day is fetched as well so
+// the program is stitched with its second half and keeps its real end time,
+// as it does on the multi-day path. Failing to fetch the next day only costs
+// the stitching. Both days are cached as returned by the provider, so syncing
+// the next day does not fetch it again.
+func fetchDay(ctx context.Context, fetcher EPGFetcher, providerChannelID, channelID string, date time.Time) ([]*model.Program, error) {
+	programs, err := fetchSourceDay(ctx, fetcher, providerChannelID, channelID, date)
+	if err != nil || len(programs) == 0 {
+		return programs, err
+	}
+
+	dateStr := date.Format("2006-01-02")
+	if !endsOpen(programs, dateStr) {
+		return NormalizePrograms(programs, dateStr), nil
+	}
+
+	nextDate := date.AddDate(0, 0, 1)
+	next, err := fetchSourceDay(ctx, fetcher, providerChannelID, channelID, nextDate)
+	if err != nil {
+		logger.Debug("Failed to fetch the next day for stitching",
+			logger.String("provider_id", fetcher.GetID()),
+			logger.String("channel_id", channelID),
+			logger.Err(err),
+		)
+		return NormalizePrograms(programs, dateStr), nil
+	}
+
+	return NormalizeProgramsByDate(map[string][]*model.Program{
+		dateStr:                       programs,
+		nextDate.Format("2006-01-02"): next,
+	})[dateStr], nil
+}
+
+// fetchSourceDay returns one day as the provider returns it, from the cache
+// of the provider when it has one.
+func fetchSourceDay(ctx context.Context, fetcher EPGFetcher, providerChannelID, channelID string, date time.Time) ([]*model.Program, error) {
+	var c cache.Cache
+	if cached, ok := fetcher.(interface{ GetCache() cache.Cache }); ok {
+		c = cached.GetCache()
+	}
+	if c == nil {
+		return fetcher.FetchEPG(ctx, providerChannelID, channelID, date)
+	}
+
+	key := fmt.Sprintf("epg:provider_source_%s:%s_%s_%s",
+		fetcher.GetID(),
+		providerChannelID,
+		channelID,
+		date.Format("2006-01-02"),
+	)
+	var programs []*model.Program
+	if err := c.Get(ctx, key, &programs); err == nil {
+		return programs, nil
+	}
+
+	programs, err := fetcher.FetchEPG(ctx, providerChannelID, channelID, date)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Set(ctx, key, programs, sourceCacheTTL); err != nil {
+		logger.Error("Failed to set cache",
+			logger.String("key", key),
+			logger.Err(err),
+		)
+	}
+	return programs, nil
+}
+
+// endsOpen reports whether the last program starting on date has no end time
+// or ends at a day boundary, where the source may have split it.
+func endsOpen(programs []*model.Program, date string) bool {
+	var last *model.Program
+	for _, p := range programs {
+		dayStart, dayEnd, err := dayBounds(date, ProgramLocation(p))
+		if err != nil || p.StartTime.Before(dayStart) || !p.StartTime.Before(dayEnd) {
+			continue
+		}
+		if last == nil || p.StartTime.After(last.StartTime) {
+			last = p
+		}
+	}
+	return last != nil && (!hasEndTime(last) || isDayBoundary(last.EndTime, ProgramLocation(last)))
+}
+
+// NormalizeProgramsByDate is NormalizePrograms for multi-day fetchers. All
+// days are normalized as one sequence, so the last program of a day gets its
+// end time from the first program of the next, and are then regrouped by the
+// local date each program starts on. Only dates present in the input are kept.
+func NormalizeProgramsByDate(programsByDate map[string][]*model.Program) map[string][]*model.Program {
+	var all []*model.Program
+	for _, programs := range programsByDate {
+		all = append(all, programs...)
+	}
+
+	result := make(map[string][]*model.Program, len(programsByDate))
+	for _, p := range normalizeSequence(all) {
+		date := p.StartTime.In(ProgramLocation(p)).Format("2006-01-02")
+		if _, ok := programsByDate[date]; !ok {
+			continue
+		}
+		result[date] = append(result[date], p)
+	}
+
+	for date := range programsByDate {
+		if _, ok := result[date]; !ok {
+			result[date] = []*model.Program{}
+		}
+	}
+
+	return result
+}
+
+func normalizeSequence(programs []*model.Program) []*model.Program {
+	var channelOrder []string
+	byChannel := make(map[string][]*model.Program)
+	for _, p := range programs {
+		if _, ok := byChannel[p.ChannelID]; !ok {
+			channelOrder = append(channelOrder, p.ChannelID)
+		}
+		byChannel[p.ChannelID] = append(byChannel[p.ChannelID], p)
+	}
+
+	result := make([]*model.Program, 0, len(programs))
+	for _, channelID := range channelOrder {
+		result = append(result, normalizeChannel(byChannel[channelID])...)
+	}
+
+	return result
+}
+
+func normalizeChannel(programs []*model.Program) []*model.Program {
+	sorted := make([]*model.Program, len(programs))
+	copy(sorted, programs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StartTime.Before(sorted[j].StartTime)
+	})
+
+	var merged []*model.Program
+	for _, p := range sorted {
+		if len(merged) == 0 {
+			merged = append(merged, p)
+			continue
+		}
+
+		prev := merged[len(merged)-1]
+
+		// the same program returned by two day fetches, keep the better end time
+		if prev.StartTime.Equal(p.StartTime) && prev.Title == p.Title {
+			if p.EndTime.After(prev.EndTime) {
+				prev.EndTime = p.EndTime
+			}
+			continue
+		}
+
+		// a program the source split at midnight
+		if prev.Title == p.Title && hasEndTime(prev) && isDayBoundary(prev.EndTime, ProgramLocation(prev)) &&
+			AbsDuration(p.StartTime.Sub(prev.EndTime)) <= boundaryTolerance {
+			if p.EndTime.After(prev.EndTime) {
+				prev.EndTime = p.EndTime
+			} else if !hasEndTime(p) {
+				prev.EndTime = time.Time{}
+			}
+			continue
+		}
+
+		merged = append(merged, p)
+	}
+
+	for i, p := range merged {
+		if hasEndTime(p) {
+			continue
+		}
+		if i+1 < len(merged) {
+			p.EndTime = merged[i+1].StartTime
+			continue
+		}
+		loc := ProgramLocation(p)
+		local := p.StartTime.In(loc)
+		p.EndTime = time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+	}
+
+	return merged
+}
+
+func hasEndTime(p *model.Program) bool {
+	return !p.EndTime.IsZero() && p.EndTime.After(p.StartTime)
+}
+
+// isDayBoundary reports whether t is local midnight or the 23:59:xx end time
+// some sources use to close the last program of a day.
+func isDayBoundary(t time.Time, loc *time.Location) bool {
+	h, m, _ := t.In(loc).Clock()
+	return (h == 0 && m == 0) || (h == 23 && m == 59)
+}
+
+func dayBounds(date string, loc *time.Location) (time.Time, time.Time, error) {
+	dayStart, err := time.ParseInLocation("2006-01-02", date, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return dayStart, dayStart.AddDate(0, 0, 1), nil
+}
+
+// ProgramLocation returns the time zone a program was published in, or UTC
+// when it is unknown.
+func ProgramLocation(p *model.Program) *time.Location {
+	loc, err := time.LoadLocation(p.OriginalTimezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// AbsDuration returns the absolute value of d.
+func AbsDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/cache"
+	"github.com/epg-sync/epgsync/internal/model"
+)
+
+// testProgram parses start and end as "01-02 15:04" in October 2026,
+// Asia/Shanghai. An empty end leaves the end time unset.
+func testProgram(t *testing.T, start, end, title string) *model.Program {
+	t.Helper()
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip("time zone data is not available")
+	}
+	parse := func(s string) time.Time {
+		if s == "" {
+			return time.Time{}
+		}
+		v, err := time.ParseInLocation("2006-01-02 15:04", "2026-"+s, loc)
+		if err != nil {
+			t.Fatalf("bad time %q: %v", s, err)
+		}
+		return v
+	}
+	return &model.Program{
+		ChannelID:        "CCTV6",
+		Title:            title,
+		StartTime:        parse(start),
+		EndTime:          parse(end),
+		OriginalTimezone: "Asia/Shanghai",
+	}
+}
+
+func describePrograms(programs []*model.Program) []string {
+	out := make([]string, len(programs))
+	for i, p := range programs {
+		loc := ProgramLocation(p)
+		out[i] = fmt.Sprintf("%s~%s %s", p.StartTime.In(loc).Format("01-02 15:04"), p.EndTime.In(loc).Format("01-02 15:04"), p.Title)
+	}
+	return out
+}
+
+func TestNormalizePrograms(t *testing.T) {
+	tests := []struct {
+		name     string
+		programs [][3]string
+		want     []string
+	}{
+		{
+			name: "missing end times are inferred from the next start",
+			programs: [][3]string{
+				{"10-18 08:00", "", "朝闻天下"},
+				{"10-18 12:00", "", "新闻30分"},
+				{"10-18 20:00", "", "电影"},
+			},
+			want: []string{
+				"10-18 08:00~10-18 12:00 朝闻天下",
+				"10-18 12:00~10-18 20:00 新闻30分",
+				"10-18 20:00~10-19 00:00 电影",
+			},
+		},
+		{
+			name: "programs starting on other days are dropped",
+			programs: [][3]string{
+				{"10-17 23:00", "10-18 01:00", "昨晚电影"},
+				{"10-18 01:00", "10-18 06:00", "午夜剧场"},
+				{"10-19 00:30", "10-19 02:00", "明天"},
+			},
+			want: []string{
+				"10-18 01:00~10-18 06:00 午夜剧场",
+			},
+		},
+		{
+			name: "program split at midnight is stitched",
+			programs: [][3]string{
+				{"10-18 21:00", "10-18 22:30", "新闻联播"},
+				{"10-18 22:30", "10-18 23:59", "电影"},
+				{"10-19 00:00", "10-19 00:45", "电影"},
+			},
+			want: []string{
+				"10-18 21:00~10-18 22:30 新闻联播",
+				"10-18 22:30~10-19 00:45 电影",
+			},
+		},
+		{
+			name: "duplicates keep the later end and unsorted input is sorted",
+			programs: [][3]string{
+				{"10-18 12:00", "10-18 13:00", "午间新闻"},
+				{"10-18 08:00", "10-18 09:00", "早间新闻"},
+				{"10-18 12:00", "10-18 13:30", "午间新闻"},
+			},
+			want: []string{
+				"10-18 08:00~10-18 09:00 早间新闻",
+				"10-18 12:00~10-18 13:30 午间新闻",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			programs := make([]*model.Program, len(tt.programs))
+			for i, p := range tt.programs {
+				programs[i] = testProgram(t, p[0], p[1], p[2])
+			}
+			got := describePrograms(NormalizePrograms(programs, "2026-10-18"))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("NormalizePrograms() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeProgramsByDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		programs map[string][][3]string
+		want     map[string][]string
+	}{
+		{
+			name: "overlap between day fetches is stored once",
+			programs: map[string][][3]string{
+				"2026-10-18": {
+					{"10-18 22:00", "10-19 00:00", "晚间剧场"},
+					{"10-19 00:00", "10-19 01:00", "午夜新闻"},
+				},
+				"2026-10-19": {
+					{"10-19 00:00", "10-19 01:00", "午夜新闻"},
+					{"10-19 01:00", "10-19 06:00", "重播"},
+				},
+			},
+			want: map[string][]string{
+				"2026-10-18": {"10-18 22:00~10-19 00:00 晚间剧场"},
+				"2026-10-19": {"10-19 00:00~10-19 01:00 午夜新闻", "10-19 01:00~10-19 06:00 重播"},
+			},
+		},
+		{
+			name: "program split across the two days is stitched",
+			programs: map[string][][3]string{
+				"2026-10-18": {{"10-18 22:30", "10-18 23:59", "电影"}},
+				"2026-10-19": {{"10-19 00:00", "10-19 00:45", "电影"}, {"10-19 00:45", "10-19 02:00", "重播"}},
+			},
+			want: map[string][]string{
+				"2026-10-18": {"10-18 22:30~10-19 00:45 电影"},
+				"2026-10-19": {"10-19 00:45~10-19 02:00 重播"},
+			},
+		},
+		{
+			name: "last program of a day ends at the first of the next",
+			programs: map[string][][3]string{
+				"2026-10-18": {{"10-18 20:00", "", "电视剧"}, {"10-18 22:00", "", "晚间新闻"}},
+				"2026-10-19": {{"10-19 00:30", "", "午夜剧场"}},
+			},
+			want: map[string][]string{
+				"2026-10-18": {"10-18 20:00~10-18 22:00 电视剧", "10-18 22:00~10-19 00:30 晚间新闻"},
+				"2026-10-19": {"10-19 00:30~10-20 00:00 午夜剧场"},
+			},
+		},
+		{
+			name: "only requested dates are returned",
+			programs: map[string][][3]string{
+				"2026-10-18": {{"10-20 08:00", "10-20 09:00", "后天"}},
+				"2026-10-19": {},
+			},
+			want: map[string][]string{
+				"2026-10-18": {},
+				"2026-10-19": {},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			programsByDate := make(map[string][]*model.Program, len(tt.programs))
+			for date, programs := range tt.programs {
+				programsByDate[date] = []*model.Program{}
+				for _, p := range programs {
+					programsByDate[date] = append(programsByDate[date], testProgram(t, p[0], p[1], p[2]))
+				}
+			}
+
+			got := NormalizeProgramsByDate(programsByDate)
+			if len(got) != len(tt.want) {
+				t.Errorf("NormalizeProgramsByDate() returned %d dates, want %d", len(got), len(tt.want))
+			}
+			for date, want := range tt.want {
+				if programs := describePrograms(got[date]); !slices.Equal(programs, want) {
+					t.Errorf("%s = %q, want %q", date, programs, want)
+				}
+			}
+		})
+	}
+}
+
+// countingFetcher serves fixed days and counts the fetches of each.
+type countingFetcher struct {
+	t     *testing.T
+	days  map[string][][3]string
+	calls map[string]int
+	cache cache.Cache
+}
+
+func (f *countingFetcher) GetID() string { return "test" }
+
+func (f *countingFetcher) GetCache() cache.Cache { return f.cache }
+
+func (f *countingFetcher) FetchEPG(_ context.Context, _, _ string, date time.Time) ([]*model.Program, error) {
+	dateStr := date.Format("2006-01-02")
+	f.calls[dateStr]++
+
+	var programs []*model.Program
+	for _, p := range f.days[dateStr] {
+		programs = append(programs, testProgram(f.t, p[0], p[1], p[2]))
+	}
+	return programs, nil
+}
+
+func TestFetchDay(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip("time zone data is not available")
+	}
+	day := time.Date(2026, 10, 18, 0, 0, 0, 0, loc)
+
+	fetcher := &countingFetcher{
+		t: t,
+		days: map[string][][3]string{
+			"2026-10-17": {{"10-17 20:00", "10-17 23:00", "电视剧"}},
+			"2026-10-18": {{"10-18 22:30", "10-18 23:59", "电影"}},
+			"2026-10-19": {{"10-19 00:00", "10-19 00:45", "电影"}, {"10-19 00:45", "10-19 22:00", "重播"}},
+		},
+		calls: map[string]int{},
+		cache: cache.NewMemoryCache(),
+	}
+
+	// a day that ends before midnight needs no stitching
+	if _, err := fetchDay(context.Background(), fetcher, "6", "CCTV6", day.AddDate(0, 0, -1)); err != nil {
+		t.Fatal(err)
+	}
+	if fetcher.calls["2026-10-18"] != 0 {
+		t.Errorf("closed day fetched the next day %d times", fetcher.calls["2026-10-18"])
+	}
+
+	programs, err := fetchDay(context.Background(), fetcher, "6", "CCTV6", day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"10-18 22:30~10-19 00:45 电影"}
+	if got := describePrograms(programs); !slices.Equal(got, want) {
+		t.Errorf("fetchDay() = %q, want %q", got, want)
+	}
+
+	programs, err = fetchDay(context.Background(), fetcher, "6", "CCTV6", day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// served from the cache, the fetch of the day before is not known here
+	want = []string{"10-19 00:00~10-19 00:45 电影", "10-19 00:45~10-19 22:00 重播"}
+	if got := describePrograms(programs); !slices.Equal(got, want) {
+		t.Errorf("fetchDay() of the next day = %q, want %q", got, want)
+	}
+
+	for date, calls := range fetcher.calls {
+		if calls != 1 {
+			t.Errorf("%s was fetched %d times, want once", date, calls)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/epg-sync/epgsync/internal/model"
@@ -21,6 +23,20 @@ type ProgramResponse struct {
 	} `json:"event_list"`
 }
 
+// EventDetailResponse is the event list requested with the description and
+// posters attached.
+type EventDetailResponse struct {
+	Total     int `json:"total"`
+	EventList []struct {
+		EventName string `json:"event_name"`
+		StartTime int64  `json:"start_time"`
+		EventDesc string `json:"event_desc"`
+		Posters   []struct {
+			URL string `json:"url"`
+		} `json:"posters"`
+	} `json:"event_list"`
+}
+
 var (
 	channelList = []*model.ProviderChannel{
 		{
@@ -76,28 +92,93 @@ func (p *XiamenProvider) FetchEPG(ctx context.Context, providerChannelID, channe
 	startTime := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location)
 	endTime := startTime.Add(24 * time.Hour)
 
-	res, err := p.GetWithHeaders(ctx, "/media/event/get_list", map[string]string{
+	res, err := p.GetWithHeaders(ctx, "/media/event/get_list", eventListParams(providerChannelID, startTime, endTime, false), headers)
+
+	if err != nil {
+		return nil, err
+	}
+
+	programData, err := p.ParseEPGResponse(res, providerChannelID, channelID, date.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+	return programData, nil
+}
+
+// FetchProgramDetail asks for the events of the program's start minute again,
+// this time with the description and posters the schedule leaves out.
+func (p *XiamenProvider) FetchProgramDetail(ctx context.Context, program *model.Program) (*model.ProgramDetail, error) {
+	providerChannelID, start, ok := parseProgramID(program.ProviderProgramID)
+	if !ok {
+		return nil, nil
+	}
+
+	headers := map[string]string{
+		provider.HeaderUserAgent: provider.DefaultUserAgent,
+	}
+	startTime := time.Unix(start, 0)
+	res, err := p.GetWithHeaders(ctx, "/media/event/get_list", eventListParams(providerChannelID, startTime, startTime.Add(time.Minute), true), headers)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EventDetailResponse
+	if err := json.Unmarshal(res, &resp); err != nil {
+		return nil, errors.ProviderParseFailed(p.GetID(), err)
+	}
+
+	for _, event := range resp.EventList {
+		if event.StartTime != start {
+			continue
+		}
+		detail := &model.ProgramDetail{Description: strings.TrimSpace(event.EventDesc)}
+		for _, poster := range event.Posters {
+			if poster.URL != "" {
+				detail.ImageURL = poster.URL
+				break
+			}
+		}
+		return detail, nil
+	}
+
+	return nil, nil
+}
+
+func eventListParams(providerChannelID string, startTime, endTime time.Time, withDetail bool) map[string]string {
+	flag := "0"
+	if withDetail {
+		flag = "1"
+	}
+	return map[string]string{
 		"chnlid":      providerChannelID,
 		"pageidx":     "1",
 		"vcontrol":    "0",
-		"attachdesc":  "0",
+		"attachdesc":  flag,
 		"repeat":      "0",
 		"pagenum":     "2048",
-		"flagposter":  "0",
+		"flagposter":  flag,
 		"accesstoken": accessToken,
 		"starttime":   fmt.Sprintf("%d", startTime.Unix()),
 		"endtime":     fmt.Sprintf("%d", endTime.Unix()),
-	}, headers)
-
-	if err != nil {
-		return nil, err
 	}
+}
 
-	programData, err := p.ParseEPGResponse(res, providerChannelID, channelID, date.Format("2006-01-02"))
+// programID identifies an event by its channel and start time, the API has
+// no lookup by event.
+func programID(providerChannelID string, start int64) string {
+	return fmt.Sprintf("%s:%d", providerChannelID, start)
+}
+
+func parseProgramID(id string) (string, int64, bool) {
+	providerChannelID, start, ok := strings.Cut(id, ":")
+	if !ok {
+		return "", 0, false
+	}
+	startUnix, err := strconv.ParseInt(start, 10, 64)
 	if err != nil {
-		return nil, err
+		return "", 0, false
 	}
-	return programData, nil
+	return providerChannelID, startUnix, true
 }
 
 func (p *XiamenProvider) FetchEPGBatch(ctx context.Context, channelMappingInfo []*model.ChannelMappingInfo, date time.Time) ([]*model.Program, error) {
@@ -130,12 +211,13 @@ func (p *XiamenProvider) ParseEPGResponse(data []byte, providerChannelID, channe
 			continue
 		}
 		result = append(result, &model.Program{
-			ChannelID:        channelID,
-			Title:            programData.EventName,
-			StartTime:        startTime,
-			EndTime:          endTime,
-			OriginalTimezone: provider.UTC8Location,
-			ProviderID:       p.GetID(),
+			ChannelID:         channelID,
+			Title:             programData.EventName,
+			StartTime:         startTime,
+			EndTime:           endTime,
+			OriginalTimezone:  provider.UTC8Location,
+			ProviderID:        p.GetID(),
+			ProviderProgramID: programID(providerChannelID, programData.StartTime),
 		})
 	}
 
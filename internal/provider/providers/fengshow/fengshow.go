@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"slices"
 	"time"
 
 	"github.com/epg-sync/epgsync/internal/model"
@@ -107,29 +106,21 @@ func (p *IfengProvider) ParseEPGResponse(data []byte, providerChannelID, channel
 	if len(resp) == 0 {
 		return programs, nil
 	}
-	var nextEndTime time.Time
-	for i := len(resp) - 1; i >= 0; i-- {
-		programData := resp[i]
+	// the API only returns start times, NormalizePrograms infers the end times
+	for _, programData := range resp {
 		startTime, err := time.ParseInLocation("2006-01-02T15:04:05.000Z", programData.EventTime, time.UTC)
 		if err != nil {
 			logger.Error("Failed to parse event time", logger.Err(err))
 			continue
 		}
 
-		if nextEndTime.IsZero() {
-			nextEndTime = time.Date(startTime.Year(), startTime.Month(), startTime.Day(), 15, 59, 59, 0, time.UTC).Add(24 * time.Hour)
-		}
-
 		programs = append(programs, &model.Program{
 			ChannelID:        channelID,
 			Title:            programData.Title,
 			StartTime:        startTime,
-			EndTime:          nextEndTime,
 			OriginalTimezone: provider.UTC8Location,
 			ProviderID:       p.GetID(),
 		})
-		nextEndTime = startTime
 	}
-	slices.Reverse(programs)
 	return programs, nil
 }
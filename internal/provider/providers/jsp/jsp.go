@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"slices"
 	"time"
 
 	"github.com/epg-sync/epgsync/internal/model"
@@ -127,29 +126,21 @@ func (p *JspProvider) ParseEPGResponse(data []byte, providerChannelID, channelID
 		return nil, err
 	}
 
-	var nextEndTime time.Time
-
-	for i := len(resp.Result) - 1; i >= 0; i-- {
-		programData := resp.Result[i]
-
+	// the API only returns start times, NormalizePrograms infers the end times
+	for _, programData := range resp.Result {
 		startTime, err := time.ParseInLocation("2006-01-02 15:04:05", programData.PlayTime, location)
 		if err != nil {
 			logger.Error("Failed to parse event time", logger.Err(err))
 			continue
 		}
-		if nextEndTime.IsZero() {
-			nextEndTime = time.Date(startTime.Year(), startTime.Month(), startTime.Day(), 23, 59, 59, 0, location).Add(24 * time.Hour)
-		}
 
 		result = append(result, &model.Program{
 			ChannelID:        channelID,
 			Title:            programData.ProgramName,
 			StartTime:        startTime,
-			EndTime:          nextEndTime,
 			OriginalTimezone: provider.UTC8Location,
 			ProviderID:       p.GetID(),
 		})
 	}
-	slices.Reverse(result)
 	return result, nil
 }
@@ -3,7 +3,6 @@ package ysp
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/epg-sync/epgsync/internal/model"
@@ -101,10 +100,9 @@ func (p *YSPProvider) ParseEPGResponse(data []byte, providerChannelID, channelID
 			logger.Warn(errors.ErrProgramDateRangeProcess(err, channelID, date).Error())
 			continue
 		}
-		programName := strings.ReplaceAll(programData.Name, "（版权原因不可回看）", " ")
 		programs = append(programs, &model.Program{
 			ChannelID:        channelID,
-			Title:            programName,
+			Title:            programData.Name,
 			StartTime:        startTime,
 			EndTime:          endTime,
 			OriginalTimezone: provider.UTC8Location,
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/epg-sync/epgsync/internal/model"
@@ -54,6 +56,41 @@ func (r *Registry) Create(providerType string, config *model.ProviderConfig) (Pr
 	return factory(config)
 }
 
+// Types lists the registered provider types.
+func (r *Registry) Types() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]string, 0, len(r.factories))
+	for providerType := range r.factories {
+		types = append(types, providerType)
+	}
+	slices.Sort(types)
+	return types
+}
+
+// ValidateConfig checks a provider config against its registered type: the
+// type has to exist and the provider built from the config has to pass its
+// own Validate. Nothing is fetched.
+func (r *Registry) ValidateConfig(config *model.ProviderConfig) error {
+	r.mu.RLock()
+	factory, exists := r.factories[config.ID]
+	r.mu.RUnlock()
+
+	if !exists {
+		return errors.New(
+			errors.ErrCodeProviderNotFound,
+			fmt.Sprintf("provider type not registered: %s, known types: %s", config.ID, strings.Join(r.Types(), ", ")),
+		)
+	}
+
+	p, err := factory(config)
+	if err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
 var globalRegistry = NewRegistry()
 
 func GlobalRegistry() *Registry {
@@ -0,0 +1,106 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type apiKeyRepo struct {
+	*BaseRepository
+}
+
+func NewAPIKeyRepository(db *gorm.DB) repository.APIKeyRepository {
+	return &apiKeyRepo{BaseRepository: NewBaseRepository(db)}
+}
+
+func (r *apiKeyRepo) Create(ctx context.Context, key *model.APIKey) error {
+	key.CreatedAt = time.Now()
+
+	if err := r.db.WithContext(ctx).Create(key).Error; err != nil {
+		logger.Error("Failed to create API key",
+			logger.Err(err),
+			logger.Int64("user_id", key.UserID),
+			logger.String("name", key.Name),
+		)
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to create API key")
+	}
+
+	return nil
+}
+
+func (r *apiKeyRepo) GetByID(ctx context.Context, id int64) (*model.APIKey, error) {
+	var key model.APIKey
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&key).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("API key", fmt.Sprintf("%d", id))
+		}
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to get API key")
+	}
+
+	return &key, nil
+}
+
+func (r *apiKeyRepo) GetByHash(ctx context.Context, hash string) (*model.APIKey, error) {
+	var key model.APIKey
+	err := r.db.WithContext(ctx).Where("key_hash = ?", hash).First(&key).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("API key", "")
+		}
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to get API key")
+	}
+
+	return &key, nil
+}
+
+func (r *apiKeyRepo) List(ctx context.Context, userID int64) ([]*model.APIKey, error) {
+	var keys []*model.APIKey
+	query := r.db.WithContext(ctx)
+	if userID > 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+	if err := query.Order("id ASC").Find(&keys).Error; err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list API keys")
+	}
+
+	return keys, nil
+}
+
+func (r *apiKeyRepo) Revoke(ctx context.Context, id int64, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to revoke API key")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NotFound("API key", fmt.Sprintf("%d", id))
+	}
+
+	return nil
+}
+
+func (r *apiKeyRepo) TouchLastUsed(ctx context.Context, id int64, at time.Time, ip string) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.APIKey{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"last_used_at": at,
+			"last_used_ip": ip,
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to update API key usage")
+	}
+
+	return nil
+}
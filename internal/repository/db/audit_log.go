@@ -0,0 +1,83 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type auditLogRepo struct {
+	*BaseRepository
+}
+
+func NewAuditLogRepository(db *gorm.DB) repository.AuditLogRepository {
+	return &auditLogRepo{BaseRepository: NewBaseRepository(db)}
+}
+
+func (r *auditLogRepo) Create(ctx context.Context, entry *model.AuditLog) error {
+	entry.CreatedAt = time.Now()
+
+	if err := r.db.WithContext(ctx).Create(entry).Error; err != nil {
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to create audit log")
+	}
+
+	return nil
+}
+
+func (r *auditLogRepo) List(ctx context.Context, filter *repository.AuditLogFilter, page, pageSize int) ([]*model.AuditLog, int64, error) {
+	var entries []*model.AuditLog
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.AuditLog{})
+	if filter != nil {
+		if filter.UserID > 0 {
+			query = query.Where("user_id = ?", filter.UserID)
+		}
+		if filter.Username != "" {
+			query = query.Where("username = ?", filter.Username)
+		}
+		if filter.Action != "" {
+			query = query.Where("action = ?", filter.Action)
+		}
+		if filter.ResourceType != "" {
+			query = query.Where("resource_type = ?", filter.ResourceType)
+		}
+		if filter.ResourceID != "" {
+			query = query.Where("resource_id = ?", filter.ResourceID)
+		}
+		if !filter.Since.IsZero() {
+			query = query.Where("created_at >= ?", filter.Since)
+		}
+		if !filter.Until.IsZero() {
+			query = query.Where("created_at < ?", filter.Until)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to count audit logs")
+	}
+
+	err := query.
+		Order("id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&entries).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list audit logs")
+	}
+
+	for _, entry := range entries {
+		if entry.BeforeJSON != nil {
+			entry.Before = []byte(*entry.BeforeJSON)
+		}
+		if entry.AfterJSON != nil {
+			entry.After = []byte(*entry.AfterJSON)
+		}
+	}
+
+	return entries, total, nil
+}
@@ -2,10 +2,19 @@ package mysql
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/epg-sync/epgsync/internal/repository"
 	"gorm.io/gorm"
 )
 
+// likeEscape is passed explicitly in every LIKE clause. MySQL defaults to a
+// backslash, SQLite to nothing, and '!' needs no quoting in either.
+const likeEscape = "!"
+
+var likeEscaper = strings.NewReplacer(likeEscape, likeEscape+likeEscape, "%", likeEscape+"%", "_", likeEscape+"_")
+
 type BaseRepository struct {
 	db *gorm.DB
 }
@@ -29,3 +38,29 @@ func (r *BaseRepository) Close() error {
 	}
 	return sqlDB.Close()
 }
+
+// containsPattern builds a LIKE pattern matching values that contain s
+// literally, to be used with ESCAPE likeEscape.
+func containsPattern(s string) string {
+	return "%" + likeEscaper.Replace(strings.ToLower(s)) + "%"
+}
+
+// orderClause turns list options into an ORDER BY clause. Only columns in
+// allowed are accepted, anything else falls back to the default column, so
+// options coming from a request never reach the SQL as is.
+func orderClause(opts *repository.ListOptions, allowed []string, fallback string) string {
+	column := fallback
+	for _, c := range allowed {
+		if opts.OrderBy == c {
+			column = c
+			break
+		}
+	}
+
+	direction := "ASC"
+	if strings.EqualFold(opts.Order, "desc") {
+		direction = "DESC"
+	}
+
+	return fmt.Sprintf("%s %s", column, direction)
+}
@@ -0,0 +1,115 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type categoryRuleRepo struct {
+	*BaseRepository
+}
+
+func NewCategoryRuleRepository(db *gorm.DB) repository.CategoryRuleRepository {
+	return &categoryRuleRepo{BaseRepository: NewBaseRepository(db)}
+}
+
+func (r *categoryRuleRepo) Create(ctx context.Context, rule *model.CategoryRule) error {
+	rule.CreatedAt = time.Now()
+	rule.UpdatedAt = time.Now()
+
+	if err := r.db.WithContext(ctx).Create(rule).Error; err != nil {
+		logger.Error("Failed to create category rule",
+			logger.Err(err),
+			logger.String("category", rule.Category),
+			logger.String("pattern", rule.Pattern),
+		)
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to create category rule")
+	}
+
+	return nil
+}
+
+func (r *categoryRuleRepo) GetByID(ctx context.Context, id int64) (*model.CategoryRule, error) {
+	var rule model.CategoryRule
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&rule).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("category rule", fmt.Sprintf("%d", id))
+		}
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to get category rule")
+	}
+
+	return &rule, nil
+}
+
+func (r *categoryRuleRepo) List(ctx context.Context) ([]*model.CategoryRule, error) {
+	var rules []*model.CategoryRule
+	if err := r.db.WithContext(ctx).Order("priority DESC, id ASC").Find(&rules).Error; err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list category rules")
+	}
+
+	return rules, nil
+}
+
+func (r *categoryRuleRepo) ListActive(ctx context.Context) ([]*model.CategoryRule, error) {
+	var rules []*model.CategoryRule
+	err := r.db.WithContext(ctx).
+		Where("is_active = ?", 1).
+		Order("priority DESC, id ASC").
+		Find(&rules).Error
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list active category rules")
+	}
+
+	return rules, nil
+}
+
+func (r *categoryRuleRepo) Update(ctx context.Context, rule *model.CategoryRule) error {
+	rule.UpdatedAt = time.Now()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.CategoryRule{}).
+		Where("id = ?", rule.ID).
+		Updates(map[string]any{
+			"category":   rule.Category,
+			"match_type": rule.MatchType,
+			"pattern":    rule.Pattern,
+			"channel_id": rule.ChannelID,
+			"priority":   rule.Priority,
+			"is_active":  rule.IsActive,
+			"updated_at": rule.UpdatedAt,
+		})
+	if result.Error != nil {
+		logger.Error("Failed to update category rule",
+			logger.Err(result.Error),
+			logger.Int64("id", rule.ID),
+		)
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to update category rule")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NotFound("category rule", fmt.Sprintf("%d", rule.ID))
+	}
+
+	return nil
+}
+
+func (r *categoryRuleRepo) Delete(ctx context.Context, id int64) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.CategoryRule{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to delete category rule")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NotFound("category rule", fmt.Sprintf("%d", id))
+	}
+
+	return nil
+}
@@ -3,7 +3,9 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/epg-sync/epgsync/internal/model"
@@ -13,6 +15,8 @@ import (
 	"gorm.io/gorm"
 )
 
+var channelOrderColumns = []string{"id", "channel_id", "display_name", "category", "area", "created_at", "updated_at"}
+
 type channelRepo struct {
 	*BaseRepository
 }
@@ -80,11 +84,16 @@ func (r *channelRepo) GetByID(ctx context.Context, channelID string) (*model.Cha
 	return &channel, nil
 }
 
+// ListByProviderID returns the channels a provider has mappings for.
 func (r *channelRepo) ListByProviderID(ctx context.Context, providerID string) ([]*model.Channel, error) {
+	mapped := r.db.Model(&model.ChannelMapping{}).
+		Select("canonical_id").
+		Where("provider_id = ?", providerID)
+
 	var channels []*model.Channel
 	err := r.db.WithContext(ctx).
-		Where("provider_id = ?", providerID).
-		Order("number ASC").
+		Where("channel_id IN (?)", mapped).
+		Order("id ASC").
 		Find(&channels).Error
 	if err != nil {
 		logger.Error("Failed to list channels by provider",
@@ -99,7 +108,14 @@ func (r *channelRepo) ListByProviderID(ctx context.Context, providerID string) (
 
 func (r *channelRepo) GetByChannelName(ctx context.Context, channelName string) (*model.Channel, error) {
 	var channel model.Channel
-	err := r.db.WithContext(ctx).Where("? REGEXP `regexp`", channelName).First(&channel).Error
+	name := strings.ToLower(strings.TrimSpace(channelName))
+	aliases := r.db.Model(&model.ChannelAlias{}).
+		Select("channel_id").
+		Where("match_type = ? AND LOWER(alias) = ?", model.AliasMatchExact, name)
+	err := r.db.WithContext(ctx).
+		Where("LOWER(channel_id) = ? OR LOWER(display_name) = ? OR channel_id IN (?)", name, name, aliases).
+		Order("id ASC").
+		First(&channel).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -117,18 +133,19 @@ func (r *channelRepo) Search(ctx context.Context, query string, opts *repository
 		opts = &repository.ListOptions{
 			Page:     1,
 			PageSize: 50,
-			OrderBy:  "name",
+			OrderBy:  "channel_id",
 			Order:    "asc",
 		}
 	}
 
-	searchPattern := "%" + query + "%"
+	searchPattern := containsPattern(query)
 	offset := (opts.Page - 1) * opts.PageSize
 
 	var channels []*model.Channel
 	err := r.db.WithContext(ctx).
-		Where("name LIKE ? OR `group` LIKE ?", searchPattern, searchPattern).
-		Order(fmt.Sprintf("%s %s", opts.OrderBy, opts.Order)).
+		Where(fmt.Sprintf("LOWER(channel_id) LIKE @q ESCAPE '%[1]s' OR LOWER(display_name) LIKE @q ESCAPE '%[1]s' OR LOWER(category) LIKE @q ESCAPE '%[1]s'", likeEscape),
+			sql.Named("q", searchPattern)).
+		Order(orderClause(opts, channelOrderColumns, "channel_id")).
 		Limit(opts.PageSize).
 		Offset(offset).
 		Find(&channels).Error
@@ -155,7 +172,6 @@ func (r *channelRepo) Update(ctx context.Context, channel *model.Channel) error
 			"logo_url":     channel.LogoURL,
 			"category":     channel.Category,
 			"area":         channel.Area,
-			"regexp":       channel.Regexp,
 			"is_active":    channel.IsActive,
 			"timezone":     channel.Timezone,
 			"updated_at":   channel.UpdatedAt,
@@ -192,26 +208,6 @@ func (r *channelRepo) Delete(ctx context.Context, channelID string) error {
 	return nil
 }
 
-func (r *channelRepo) DeleteByProviderID(ctx context.Context, providerID string) error {
-	result := r.db.WithContext(ctx).
-		Where("provider_id = ?", providerID).
-		Delete(&model.Channel{})
-	if result.Error != nil {
-		logger.Error("Failed to delete channels by provider",
-			logger.Err(result.Error),
-			logger.String("provider_id", providerID),
-		)
-		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to delete channels")
-	}
-
-	logger.Info("Deleted channels by provider",
-		logger.String("provider_id", providerID),
-		logger.Int64("count", result.RowsAffected),
-	)
-
-	return nil
-}
-
 func (r *channelRepo) Count(ctx context.Context) (int64, error) {
 	var count int64
 	err := r.db.WithContext(ctx).Model(&model.Channel{}).Count(&count).Error
@@ -0,0 +1,119 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type channelAliasRepo struct {
+	*BaseRepository
+}
+
+func NewChannelAliasRepository(db *gorm.DB) repository.ChannelAliasRepository {
+	return &channelAliasRepo{BaseRepository: NewBaseRepository(db)}
+}
+
+func (r *channelAliasRepo) Create(ctx context.Context, alias *model.ChannelAlias) error {
+	alias.CreatedAt = time.Now()
+	alias.UpdatedAt = time.Now()
+
+	if err := r.db.WithContext(ctx).Create(alias).Error; err != nil {
+		logger.Error("Failed to create channel alias",
+			logger.Err(err),
+			logger.String("channel_id", alias.ChannelID),
+			logger.String("alias", alias.Alias),
+		)
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to create channel alias")
+	}
+
+	return nil
+}
+
+func (r *channelAliasRepo) GetByID(ctx context.Context, id int64) (*model.ChannelAlias, error) {
+	var alias model.ChannelAlias
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&alias).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("channel alias", fmt.Sprintf("%d", id))
+		}
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to get channel alias")
+	}
+
+	return &alias, nil
+}
+
+func (r *channelAliasRepo) List(ctx context.Context) ([]*model.ChannelAlias, error) {
+	var aliases []*model.ChannelAlias
+	if err := r.db.WithContext(ctx).Order("id ASC").Find(&aliases).Error; err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list channel aliases")
+	}
+
+	return aliases, nil
+}
+
+func (r *channelAliasRepo) ListByChannelID(ctx context.Context, channelID string) ([]*model.ChannelAlias, error) {
+	var aliases []*model.ChannelAlias
+	err := r.db.WithContext(ctx).
+		Where("channel_id = ?", channelID).
+		Order("id ASC").
+		Find(&aliases).Error
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list channel aliases")
+	}
+
+	return aliases, nil
+}
+
+func (r *channelAliasRepo) Update(ctx context.Context, alias *model.ChannelAlias) error {
+	alias.UpdatedAt = time.Now()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.ChannelAlias{}).
+		Where("id = ?", alias.ID).
+		Updates(map[string]any{
+			"alias":      alias.Alias,
+			"match_type": alias.MatchType,
+			"updated_at": alias.UpdatedAt,
+		})
+	if result.Error != nil {
+		logger.Error("Failed to update channel alias",
+			logger.Err(result.Error),
+			logger.Int64("id", alias.ID),
+		)
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to update channel alias")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NotFound("channel alias", fmt.Sprintf("%d", alias.ID))
+	}
+
+	return nil
+}
+
+func (r *channelAliasRepo) Delete(ctx context.Context, id int64) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.ChannelAlias{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to delete channel alias")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NotFound("channel alias", fmt.Sprintf("%d", id))
+	}
+
+	return nil
+}
+
+func (r *channelAliasRepo) DeleteByChannelID(ctx context.Context, channelID string) error {
+	if err := r.db.WithContext(ctx).Where("channel_id = ?", channelID).Delete(&model.ChannelAlias{}).Error; err != nil {
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to delete channel aliases")
+	}
+
+	return nil
+}
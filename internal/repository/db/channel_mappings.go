@@ -2,10 +2,12 @@ package mysql
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/epg-sync/epgsync/internal/model"
 	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
 	"github.com/epg-sync/epgsync/pkg/logger"
 	"gorm.io/gorm"
 )
@@ -119,3 +121,104 @@ func (r *channelMappingsRepo) DeleteByProviderID(ctx context.Context, providerID
 		Where("provider_id = ?", providerID).
 		Delete(&model.ChannelMapping{}).Error
 }
+
+func (r *channelMappingsRepo) GetByID(ctx context.Context, id int64) (*model.ChannelMapping, error) {
+	var mapping model.ChannelMapping
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&mapping).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("channel mapping", fmt.Sprintf("%d", id))
+		}
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to get channel mapping")
+	}
+	return &mapping, nil
+}
+
+func (r *channelMappingsRepo) Update(ctx context.Context, mapping *model.ChannelMapping) error {
+	mapping.UpdatedAt = time.Now()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.ChannelMapping{}).
+		Where("id = ?", mapping.ID).
+		Updates(map[string]any{
+			"canonical_id":          mapping.CanonicalID,
+			"provider_channel_id":   mapping.ProviderChannelID,
+			"provider_channel_name": mapping.ProviderChannelName,
+			"priority":              mapping.Priority,
+			"confidence":            mapping.Confidence,
+			"is_verified":           mapping.IsVerified,
+			"status":                mapping.Status,
+			"reviewed_at":           mapping.ReviewedAt,
+			"updated_at":            mapping.UpdatedAt,
+		})
+	if result.Error != nil {
+		logger.Error("Failed to update channel mapping",
+			logger.Err(result.Error),
+			logger.Int64("id", mapping.ID),
+		)
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to update channel mapping")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NotFound("channel mapping", fmt.Sprintf("%d", mapping.ID))
+	}
+
+	return nil
+}
+
+func (r *channelMappingsRepo) Delete(ctx context.Context, id int64) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.ChannelMapping{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to delete channel mapping")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NotFound("channel mapping", fmt.Sprintf("%d", id))
+	}
+
+	return nil
+}
+
+func (r *channelMappingsRepo) ListByStatus(ctx context.Context, status string) ([]*model.ChannelMapping, error) {
+	var mappings []*model.ChannelMapping
+	err := r.db.WithContext(ctx).
+		Where("status = ?", status).
+		Order("confidence ASC, id ASC").
+		Find(&mappings).Error
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list channel mappings")
+	}
+	return mappings, nil
+}
+
+func (r *channelMappingsRepo) ListSyncableByProviderID(ctx context.Context, providerID string, verifiedOnly bool) ([]*model.ChannelMapping, error) {
+	var mappings []*model.ChannelMapping
+	err := r.syncable(ctx, verifiedOnly).
+		Where("provider_id = ?", providerID).
+		Find(&mappings).Error
+	if err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
+
+func (r *channelMappingsRepo) ListSyncableByCanonicalID(ctx context.Context, canonicalChannelID string, verifiedOnly bool) ([]*model.ChannelMapping, error) {
+	var mappings []*model.ChannelMapping
+	err := r.syncable(ctx, verifiedOnly).
+		Where("canonical_id = ?", canonicalChannelID).
+		Find(&mappings).Error
+	if err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
+
+// syncable excludes rejected mappings, and pending ones too when only
+// verified mappings may be used.
+func (r *channelMappingsRepo) syncable(ctx context.Context, verifiedOnly bool) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if verifiedOnly {
+		return query.Where("status = ?", model.MappingStatusVerified)
+	}
+	return query.Where("status <> ?", model.MappingStatusRejected)
+}
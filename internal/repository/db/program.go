@@ -217,6 +217,97 @@ func (r *programRepo) DeleteByDateAndProviderID(ctx context.Context, date time.T
 	return nil
 }
 
+// ListByDateAndChannelIDs returns the programs of the channels starting on
+// date, whichever provider they came from.
+func (r *programRepo) ListByDateAndChannelIDs(ctx context.Context, date time.Time, channelIDs []string) ([]*model.Program, error) {
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	endOfDay := startOfDay.Add(24 * time.Hour)
+	startOfDay = startOfDay.In(time.UTC)
+	endOfDay = endOfDay.In(time.UTC)
+
+	var programs []*model.Program
+	if len(channelIDs) == 0 {
+		return programs, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("channel_id IN ? AND start_time >= ? AND start_time < ?", channelIDs, startOfDay, endOfDay).
+		Order("channel_id ASC, start_time ASC").
+		Find(&programs).Error
+	if err != nil {
+		logger.Error("Failed to list programs by date",
+			logger.Err(err),
+			logger.Int("channel_count", len(channelIDs)),
+			logger.Time("date", date),
+		)
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list programs")
+	}
+
+	return programs, nil
+}
+
+// DeleteByDateAndChannelIDs removes the programs of the channels starting on
+// date, whichever provider they came from.
+func (r *programRepo) DeleteByDateAndChannelIDs(ctx context.Context, date time.Time, channelIDs []string) error {
+	if len(channelIDs) == 0 {
+		return nil
+	}
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	endOfDay := startOfDay.Add(24 * time.Hour)
+	startOfDay = startOfDay.In(time.UTC)
+	endOfDay = endOfDay.In(time.UTC)
+	result := r.db.WithContext(ctx).
+		Where("channel_id IN ? AND start_time >= ? AND start_time < ?", channelIDs, startOfDay, endOfDay).
+		Delete(&model.Program{})
+	if result.Error != nil {
+		logger.Error("Failed to delete programs by date",
+			logger.Err(result.Error),
+			logger.Int("channel_count", len(channelIDs)),
+			logger.Time("date", date),
+		)
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to delete programs")
+	}
+
+	logger.Debug("Deleted programs by date",
+		logger.Time("date", date),
+		logger.Int("channel_count", len(channelIDs)),
+		logger.Int64("count", result.RowsAffected),
+	)
+
+	return nil
+}
+
+func (r *programRepo) ListRecent(ctx context.Context, providerID string, limit int) ([]*model.Program, error) {
+	query := r.db.WithContext(ctx).Model(&model.Program{})
+	if providerID != "" {
+		query = query.Where("provider_id = ?", providerID)
+	}
+
+	var programs []*model.Program
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&programs).Error; err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list recent programs")
+	}
+
+	return programs, nil
+}
+
+func (r *programRepo) UpdateDetail(ctx context.Context, program *model.Program) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.Program{}).
+		Where("id = ?", program.ID).
+		Updates(map[string]any{
+			"description":       program.Description,
+			"image_url":         program.ImageURL,
+			"cast":              program.Cast,
+			"rating":            program.Rating,
+			"detail_fetched_at": program.DetailFetchedAt,
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to update program detail")
+	}
+
+	return nil
+}
+
 func (r *programRepo) Exists(ctx context.Context, channelID string, date time.Time) (bool, error) {
 	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
 	endOfDay := startOfDay.Add(24 * time.Hour)
@@ -0,0 +1,92 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type programChangeRepo struct {
+	*BaseRepository
+}
+
+func NewProgramChangeRepository(db *gorm.DB) repository.ProgramChangeRepository {
+	return &programChangeRepo{BaseRepository: NewBaseRepository(db)}
+}
+
+func (r *programChangeRepo) CreateBatch(ctx context.Context, changes []*model.ProgramChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	for _, change := range changes {
+		change.CreatedAt = now
+	}
+
+	if err := r.db.WithContext(ctx).Create(changes).Error; err != nil {
+		logger.Error("Failed to create program changes",
+			logger.Err(err),
+			logger.Int("count", len(changes)),
+		)
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to create program changes")
+	}
+
+	return nil
+}
+
+func (r *programChangeRepo) List(ctx context.Context, filter *repository.ProgramChangeFilter, page, pageSize int) ([]*model.ProgramChange, int64, error) {
+	var changes []*model.ProgramChange
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.ProgramChange{})
+	if filter != nil {
+		if filter.ChannelID != "" {
+			query = query.Where("channel_id = ?", filter.ChannelID)
+		}
+		if filter.ProviderID != "" {
+			query = query.Where("provider_id = ?", filter.ProviderID)
+		}
+		if filter.ChangeType != "" {
+			query = query.Where("change_type = ?", filter.ChangeType)
+		}
+		if !filter.Since.IsZero() {
+			query = query.Where("created_at >= ?", filter.Since)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to count program changes")
+	}
+
+	err := query.
+		Order("id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&changes).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list program changes")
+	}
+
+	return changes, total, nil
+}
+
+func (r *programChangeRepo) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("created_at < ?", before).
+		Delete(&model.ProgramChange{})
+	if result.Error != nil {
+		logger.Error("Failed to delete old program changes",
+			logger.Err(result.Error),
+			logger.Time("before", before),
+		)
+		return 0, errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to delete old program changes")
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,113 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type refreshTokenRepo struct {
+	*BaseRepository
+}
+
+func NewRefreshTokenRepository(db *gorm.DB) repository.RefreshTokenRepository {
+	return &refreshTokenRepo{BaseRepository: NewBaseRepository(db)}
+}
+
+func (r *refreshTokenRepo) Create(ctx context.Context, token *model.RefreshToken) error {
+	token.CreatedAt = time.Now()
+
+	if err := r.db.WithContext(ctx).Create(token).Error; err != nil {
+		logger.Error("Failed to create refresh token",
+			logger.Err(err),
+			logger.Int64("user_id", token.UserID),
+		)
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to create refresh token")
+	}
+
+	return nil
+}
+
+func (r *refreshTokenRepo) GetByHash(ctx context.Context, hash string) (*model.RefreshToken, error) {
+	var token model.RefreshToken
+	err := r.db.WithContext(ctx).Where("token_hash = ?", hash).First(&token).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("refresh token", "")
+		}
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to get refresh token")
+	}
+
+	return &token, nil
+}
+
+func (r *refreshTokenRepo) Revoke(ctx context.Context, id int64, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.RefreshToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to revoke refresh token")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NotFound("refresh token", fmt.Sprintf("%d", id))
+	}
+
+	return nil
+}
+
+func (r *refreshTokenRepo) ActiveSessionIDs(ctx context.Context, userID int64, at time.Time) ([]string, error) {
+	var sessionIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&model.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, at).
+		Distinct().
+		Pluck("session_id", &sessionIDs).Error
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list sessions")
+	}
+
+	return sessionIDs, nil
+}
+
+func (r *refreshTokenRepo) RevokeSession(ctx context.Context, sessionID string, at time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.RefreshToken{}).
+		Where("session_id = ? AND revoked_at IS NULL", sessionID).
+		Update("revoked_at", at).Error
+	if err != nil {
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to revoke session")
+	}
+
+	return nil
+}
+
+func (r *refreshTokenRepo) RevokeByUserID(ctx context.Context, userID int64, at time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", at).Error
+	if err != nil {
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to revoke sessions")
+	}
+
+	return nil
+}
+
+func (r *refreshTokenRepo) DeleteExpired(ctx context.Context, userID int64, before time.Time) error {
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND expires_at < ?", userID, before).
+		Delete(&model.RefreshToken{}).Error
+	if err != nil {
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to delete expired refresh tokens")
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type scheduleReportRepo struct {
+	*BaseRepository
+}
+
+func NewScheduleReportRepository(db *gorm.DB) repository.ScheduleReportRepository {
+	return &scheduleReportRepo{BaseRepository: NewBaseRepository(db)}
+}
+
+// Save replaces the stored report of every channel and date in reports.
+func (r *scheduleReportRepo) Save(ctx context.Context, reports []*model.ScheduleReport) error {
+	if len(reports) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	for _, report := range reports {
+		data, err := json.Marshal(report.Issues)
+		if err != nil {
+			return errors.Wrap(err, errors.ErrCodeInvalidParam, "failed to marshal schedule issues")
+		}
+		report.IssuesJSON = string(data)
+		report.CreatedAt = now
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, report := range reports {
+			if err := tx.Where("channel_id = ? AND date = ?", report.ChannelID, report.Date).
+				Delete(&model.ScheduleReport{}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(reports).Error
+	})
+	if err != nil {
+		logger.Error("Failed to save schedule reports",
+			logger.Err(err),
+			logger.Int("count", len(reports)),
+		)
+		return errors.Wrap(err, errors.ErrCodeDatabaseTransaction, "failed to save schedule reports")
+	}
+
+	return nil
+}
+
+func (r *scheduleReportRepo) List(ctx context.Context, filter *repository.ScheduleReportFilter, page, pageSize int) ([]*model.ScheduleReport, int64, error) {
+	var reports []*model.ScheduleReport
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.ScheduleReport{})
+	if filter != nil {
+		if filter.ChannelID != "" {
+			query = query.Where("channel_id = ?", filter.ChannelID)
+		}
+		if filter.ProviderID != "" {
+			query = query.Where("provider_id = ?", filter.ProviderID)
+		}
+		if filter.Date != "" {
+			query = query.Where("date = ?", filter.Date)
+		}
+		if filter.OnlyIssues {
+			query = query.Where("issue_count > 0")
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to count schedule reports")
+	}
+
+	err := query.
+		Order("date DESC, issue_count DESC, channel_id ASC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&reports).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list schedule reports")
+	}
+
+	for _, report := range reports {
+		if report.IssuesJSON == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(report.IssuesJSON), &report.Issues); err != nil {
+			logger.Warn("Failed to decode schedule issues",
+				logger.Err(err),
+				logger.Int64("report_id", report.ID),
+			)
+		}
+	}
+
+	return reports, total, nil
+}
+
+func (r *scheduleReportRepo) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("created_at < ?", before).
+		Delete(&model.ScheduleReport{})
+	if result.Error != nil {
+		return 0, errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to delete old schedule reports")
+	}
+
+	return result.RowsAffected, nil
+}
@@ -2,9 +2,11 @@ package mysql
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/epg-sync/epgsync/internal/model"
 	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
 	"gorm.io/gorm"
 )
 
@@ -15,6 +17,7 @@ type UserRepository interface {
 	Update(ctx context.Context, user *model.User) error
 	Delete(ctx context.Context, id int64) error
 	List(ctx context.Context, offset, limit int) ([]*model.User, int64, error)
+	CountActiveByRole(ctx context.Context, role string) (int64, error)
 }
 
 type userRepository struct {
@@ -45,7 +48,10 @@ func (r *userRepository) GetByID(ctx context.Context, id int64) (*model.User, er
 	var user model.User
 	err := r.db.WithContext(ctx).Where("id = ?", id).First(&user).Error
 	if err != nil {
-		return nil, err
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("user", fmt.Sprintf("%d", id))
+		}
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to get user")
 	}
 	return &user, nil
 }
@@ -54,7 +60,10 @@ func (r *userRepository) GetByUsername(ctx context.Context, username string) (*m
 	var user model.User
 	err := r.db.WithContext(ctx).Where("username = ?", username).First(&user).Error
 	if err != nil {
-		return nil, err
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("user", username)
+		}
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to get user")
 	}
 	return &user, nil
 }
@@ -64,7 +73,14 @@ func (r *userRepository) Update(ctx context.Context, user *model.User) error {
 }
 
 func (r *userRepository) Delete(ctx context.Context, id int64) error {
-	return r.db.WithContext(ctx).Delete(&model.User{}, id).Error
+	result := r.db.WithContext(ctx).Delete(&model.User{}, id)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to delete user")
+	}
+	if result.RowsAffected == 0 {
+		return errors.NotFound("user", fmt.Sprintf("%d", id))
+	}
+	return nil
 }
 
 func (r *userRepository) List(ctx context.Context, offset, limit int) ([]*model.User, int64, error) {
@@ -75,6 +91,17 @@ func (r *userRepository) List(ctx context.Context, offset, limit int) ([]*model.
 		return nil, 0, err
 	}
 
-	err := r.db.WithContext(ctx).Offset(offset).Limit(limit).Find(&users).Error
+	err := r.db.WithContext(ctx).Order("id").Offset(offset).Limit(limit).Find(&users).Error
 	return users, total, err
 }
+
+func (r *userRepository) CountActiveByRole(ctx context.Context, role string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.User{}).
+		Where("role = ? AND is_active = ?", role, 1).
+		Count(&count).Error
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to count users")
+	}
+	return count, nil
+}
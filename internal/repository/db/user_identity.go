@@ -0,0 +1,76 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type userIdentityRepo struct {
+	*BaseRepository
+}
+
+func NewUserIdentityRepository(db *gorm.DB) repository.UserIdentityRepository {
+	return &userIdentityRepo{BaseRepository: NewBaseRepository(db)}
+}
+
+func (r *userIdentityRepo) GetBySubject(ctx context.Context, issuer, subject string) (*model.UserIdentity, error) {
+	var identity model.UserIdentity
+	err := r.db.WithContext(ctx).
+		Where("issuer = ? AND subject = ?", issuer, subject).
+		First(&identity).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("user identity", subject)
+		}
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to get user identity")
+	}
+
+	return &identity, nil
+}
+
+func (r *userIdentityRepo) CreateWithUser(ctx context.Context, user *model.User, identity *model.UserIdentity) error {
+	now := time.Now()
+	identity.CreatedAt = now
+	identity.LastLoginAt = &now
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		identity.UserID = user.ID
+		return tx.Create(identity).Error
+	})
+	if err != nil {
+		logger.Error("Failed to create user identity",
+			logger.Err(err),
+			logger.String("issuer", identity.Issuer),
+			logger.String("subject", identity.Subject),
+		)
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to create user identity")
+	}
+
+	return nil
+}
+
+func (r *userIdentityRepo) TouchLastLogin(ctx context.Context, id int64, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.UserIdentity{}).
+		Where("id = ?", id).
+		Update("last_login_at", at)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to update user identity")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NotFound("user identity", fmt.Sprintf("%d", id))
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type webhookRepo struct {
+	*BaseRepository
+}
+
+func NewWebhookRepository(db *gorm.DB) repository.WebhookRepository {
+	return &webhookRepo{BaseRepository: NewBaseRepository(db)}
+}
+
+func (r *webhookRepo) Create(ctx context.Context, webhook *model.Webhook) error {
+	webhook.CreatedAt = time.Now()
+	webhook.UpdatedAt = time.Now()
+
+	if err := r.db.WithContext(ctx).Create(webhook).Error; err != nil {
+		logger.Error("Failed to create webhook",
+			logger.Err(err),
+			logger.String("name", webhook.Name),
+		)
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to create webhook")
+	}
+
+	return nil
+}
+
+func (r *webhookRepo) GetByID(ctx context.Context, id int64) (*model.Webhook, error) {
+	var webhook model.Webhook
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&webhook).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("webhook", fmt.Sprintf("%d", id))
+		}
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to get webhook")
+	}
+
+	return &webhook, nil
+}
+
+func (r *webhookRepo) List(ctx context.Context) ([]*model.Webhook, error) {
+	var webhooks []*model.Webhook
+	if err := r.db.WithContext(ctx).Order("id ASC").Find(&webhooks).Error; err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list webhooks")
+	}
+
+	return webhooks, nil
+}
+
+func (r *webhookRepo) ListActive(ctx context.Context) ([]*model.Webhook, error) {
+	var webhooks []*model.Webhook
+	if err := r.db.WithContext(ctx).Where("is_active = ?", 1).Find(&webhooks).Error; err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to list active webhooks")
+	}
+
+	return webhooks, nil
+}
+
+func (r *webhookRepo) Update(ctx context.Context, webhook *model.Webhook) error {
+	webhook.UpdatedAt = time.Now()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.Webhook{}).
+		Where("id = ?", webhook.ID).
+		Updates(map[string]any{
+			"name":        webhook.Name,
+			"url":         webhook.URL,
+			"secret":      webhook.Secret,
+			"events":      webhook.Events,
+			"channel_ids": webhook.ChannelIDs,
+			"is_active":   webhook.IsActive,
+			"updated_at":  webhook.UpdatedAt,
+		})
+	if result.Error != nil {
+		logger.Error("Failed to update webhook",
+			logger.Err(result.Error),
+			logger.Int64("id", webhook.ID),
+		)
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to update webhook")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NotFound("webhook", fmt.Sprintf("%d", webhook.ID))
+	}
+
+	return nil
+}
+
+func (r *webhookRepo) UpdateDeliveryStatus(ctx context.Context, id int64, status int, errMsg string, sentAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.Webhook{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"last_status":  status,
+			"last_error":   errMsg,
+			"last_sent_at": sentAt,
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to update webhook delivery status")
+	}
+
+	return nil
+}
+
+func (r *webhookRepo) Delete(ctx context.Context, id int64) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.Webhook{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, errors.ErrCodeDatabaseQuery, "failed to delete webhook")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.NotFound("webhook", fmt.Sprintf("%d", id))
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package repository
+
+import "context"
+
+type replicaReadsKey struct{}
+
+// WithReplicaReads marks ctx so that reads made with it may be served by a
+// read replica. Unmarked reads always go to the primary, so only callers
+// that can live with replication lag should set it.
+func WithReplicaReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaReadsKey{}, true)
+}
+
+// ReplicaReadsAllowed reports whether ctx was marked by WithReplicaReads.
+func ReplicaReadsAllowed(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	allowed, _ := ctx.Value(replicaReadsKey{}).(bool)
+	return allowed
+}
@@ -25,10 +25,21 @@ type ChannelRepository interface {
 	Search(ctx context.Context, query string, opts *ListOptions) ([]*model.Channel, error)
 	Update(ctx context.Context, channel *model.Channel) error
 	Delete(ctx context.Context, id string) error
-	DeleteByProviderID(ctx context.Context, providerID string) error
 	Count(ctx context.Context) (int64, error)
 }
 
+type ChannelAliasRepository interface {
+	Repository
+
+	Create(ctx context.Context, alias *model.ChannelAlias) error
+	GetByID(ctx context.Context, id int64) (*model.ChannelAlias, error)
+	List(ctx context.Context) ([]*model.ChannelAlias, error)
+	ListByChannelID(ctx context.Context, channelID string) ([]*model.ChannelAlias, error)
+	Update(ctx context.Context, alias *model.ChannelAlias) error
+	Delete(ctx context.Context, id int64) error
+	DeleteByChannelID(ctx context.Context, channelID string) error
+}
+
 type ProgramRepository interface {
 	Repository
 
@@ -41,6 +52,10 @@ type ProgramRepository interface {
 	GetCurrentProgram(ctx context.Context, channelID string) (*model.Program, error)
 	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
 	DeleteByDateAndProviderID(ctx context.Context, date time.Time, providerID string) error
+	ListByDateAndChannelIDs(ctx context.Context, date time.Time, channelIDs []string) ([]*model.Program, error)
+	DeleteByDateAndChannelIDs(ctx context.Context, date time.Time, channelIDs []string) error
+	ListRecent(ctx context.Context, providerID string, limit int) ([]*model.Program, error)
+	UpdateDetail(ctx context.Context, program *model.Program) error
 	Exists(ctx context.Context, channelID string, date time.Time) (bool, error)
 }
 
@@ -54,6 +69,12 @@ type ChannelMappingsRepository interface {
 	ListAllChannelMappings(ctx context.Context) ([]*model.ChannelMapping, error)
 	ListByProviderID(ctx context.Context, providerID string) ([]*model.ChannelMapping, error)
 	DeleteByProviderID(ctx context.Context, providerID string) error
+	GetByID(ctx context.Context, id int64) (*model.ChannelMapping, error)
+	Update(ctx context.Context, mapping *model.ChannelMapping) error
+	Delete(ctx context.Context, id int64) error
+	ListByStatus(ctx context.Context, status string) ([]*model.ChannelMapping, error)
+	ListSyncableByProviderID(ctx context.Context, providerID string, verifiedOnly bool) ([]*model.ChannelMapping, error)
+	ListSyncableByCanonicalID(ctx context.Context, canonicalChannelID string, verifiedOnly bool) ([]*model.ChannelMapping, error)
 }
 
 type TimezoneRepository interface {
@@ -70,6 +91,109 @@ type UserRepository interface {
 	Update(ctx context.Context, user *model.User) error
 	Delete(ctx context.Context, id int64) error
 	List(ctx context.Context, offset, limit int) ([]*model.User, int64, error)
+	CountActiveByRole(ctx context.Context, role string) (int64, error)
+}
+
+type APIKeyRepository interface {
+	Repository
+
+	Create(ctx context.Context, key *model.APIKey) error
+	GetByID(ctx context.Context, id int64) (*model.APIKey, error)
+	GetByHash(ctx context.Context, hash string) (*model.APIKey, error)
+	// List returns the keys of a user, or of every user when userID is 0
+	List(ctx context.Context, userID int64) ([]*model.APIKey, error)
+	Revoke(ctx context.Context, id int64, at time.Time) error
+	TouchLastUsed(ctx context.Context, id int64, at time.Time, ip string) error
+}
+
+type RefreshTokenRepository interface {
+	Repository
+
+	Create(ctx context.Context, token *model.RefreshToken) error
+	GetByHash(ctx context.Context, hash string) (*model.RefreshToken, error)
+	// Revoke revokes a single token and returns NotFound when it was already
+	// revoked, so two refreshes racing with the same token cannot both win
+	Revoke(ctx context.Context, id int64, at time.Time) error
+	// ActiveSessionIDs returns the sessions of a user that can still refresh
+	ActiveSessionIDs(ctx context.Context, userID int64, at time.Time) ([]string, error)
+	RevokeSession(ctx context.Context, sessionID string, at time.Time) error
+	RevokeByUserID(ctx context.Context, userID int64, at time.Time) error
+	DeleteExpired(ctx context.Context, userID int64, before time.Time) error
+}
+
+type UserIdentityRepository interface {
+	Repository
+
+	GetBySubject(ctx context.Context, issuer, subject string) (*model.UserIdentity, error)
+	// CreateWithUser creates a new user together with its first identity, so
+	// a failed link does not leave an account behind
+	CreateWithUser(ctx context.Context, user *model.User, identity *model.UserIdentity) error
+	TouchLastLogin(ctx context.Context, id int64, at time.Time) error
+}
+
+type AuditLogRepository interface {
+	Repository
+	Create(ctx context.Context, entry *model.AuditLog) error
+	List(ctx context.Context, filter *AuditLogFilter, page, pageSize int) ([]*model.AuditLog, int64, error)
+}
+
+type ProgramChangeRepository interface {
+	Repository
+	CreateBatch(ctx context.Context, changes []*model.ProgramChange) error
+	List(ctx context.Context, filter *ProgramChangeFilter, page, pageSize int) ([]*model.ProgramChange, int64, error)
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type WebhookRepository interface {
+	Repository
+	Create(ctx context.Context, webhook *model.Webhook) error
+	GetByID(ctx context.Context, id int64) (*model.Webhook, error)
+	List(ctx context.Context) ([]*model.Webhook, error)
+	ListActive(ctx context.Context) ([]*model.Webhook, error)
+	Update(ctx context.Context, webhook *model.Webhook) error
+	UpdateDeliveryStatus(ctx context.Context, id int64, status int, errMsg string, sentAt time.Time) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type ScheduleReportRepository interface {
+	Repository
+	Save(ctx context.Context, reports []*model.ScheduleReport) error
+	List(ctx context.Context, filter *ScheduleReportFilter, page, pageSize int) ([]*model.ScheduleReport, int64, error)
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type CategoryRuleRepository interface {
+	Repository
+	Create(ctx context.Context, rule *model.CategoryRule) error
+	GetByID(ctx context.Context, id int64) (*model.CategoryRule, error)
+	List(ctx context.Context) ([]*model.CategoryRule, error)
+	ListActive(ctx context.Context) ([]*model.CategoryRule, error)
+	Update(ctx context.Context, rule *model.CategoryRule) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type ProgramChangeFilter struct {
+	ChannelID  string
+	ProviderID string
+	ChangeType string
+	Since      time.Time
+}
+
+type AuditLogFilter struct {
+	UserID       int64
+	Username     string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Since        time.Time
+	Until        time.Time
+}
+
+type ScheduleReportFilter struct {
+	ChannelID  string
+	ProviderID string
+	Date       string
+	OnlyIssues bool
 }
 
 type ListOptions struct {
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"github.com/epg-sync/epgsync/pkg/utils"
+)
+
+const (
+	// random characters after APIKeyPrefix
+	apiKeyLength = 40
+	// characters of the key kept in clear so users can tell keys apart
+	apiKeyDisplayPrefix = 12
+	// last_used_at is written at most this often per key
+	apiKeyTouchInterval = time.Minute
+)
+
+type APIKeyService struct {
+	apiKeyRepo repository.APIKeyRepository
+	userRepo   repository.UserRepository
+}
+
+func NewAPIKeyService(apiKeyRepo repository.APIKeyRepository, userRepo repository.UserRepository) *APIKeyService {
+	return &APIKeyService{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// CreateKey 为用户生成 API Key，返回的明文只在此处出现一次
+func (s *APIKeyService) CreateKey(ctx context.Context, userID int64, name string, scopes []string, expiresAt *time.Time) (*model.APIKey, string, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	scopes = slices.Compact(slices.Sorted(slices.Values(scopes)))
+	if len(scopes) == 0 {
+		return nil, "", errors.InvalidParam("scopes", "at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(model.APIKeyScopes(), scope) {
+			return nil, "", errors.InvalidParam("scopes", "must be epg:read, sync or admin")
+		}
+		if !roleAllowsScope(user.Role, scope) {
+			return nil, "", errors.New(errors.ErrCodeForbidden, "your role cannot grant the "+scope+" scope")
+		}
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", errors.InvalidParam("expires_at", "must be in the future")
+	}
+
+	random, err := utils.GenerateRandomString(apiKeyLength)
+	if err != nil {
+		return nil, "", err
+	}
+	plaintext := model.APIKeyPrefix + random
+
+	key := &model.APIKey{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    plaintext[:apiKeyDisplayPrefix],
+		KeyHash:   utils.HashToken(plaintext),
+		Scopes:    strings.Join(scopes, ","),
+		ExpiresAt: expiresAt,
+	}
+	if err := s.apiKeyRepo.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+
+	return key, plaintext, nil
+}
+
+// roleAllowsScope keeps keys from promising more than their owner can do.
+// The admin scope is reserved for admins.
+func roleAllowsScope(role, scope string) bool {
+	switch scope {
+	case model.ScopeEPGRead:
+		return model.RoleHasPermission(role, model.PermissionRead)
+	case model.ScopeSync:
+		return model.RoleHasPermission(role, model.PermissionSync)
+	case model.ScopeAdmin:
+		return role == model.RoleAdmin
+	}
+	return false
+}
+
+// Authenticate 校验 API Key 并返回它和所属用户。撤销、过期的 Key 以及停用用户的 Key 都会被拒绝
+func (s *APIKeyService) Authenticate(ctx context.Context, plaintext, ip string) (*model.APIKey, *model.User, error) {
+	invalid := errors.New(errors.ErrCodeUnauthorized, "invalid API key")
+	if !strings.HasPrefix(plaintext, model.APIKeyPrefix) {
+		return nil, nil, invalid
+	}
+
+	key, err := s.apiKeyRepo.GetByHash(ctx, utils.HashToken(plaintext))
+	if err != nil {
+		if errors.Is(err, errors.ErrCodeNotFound) {
+			return nil, nil, invalid
+		}
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	if !key.Active(now) {
+		return nil, nil, errors.New(errors.ErrCodeUnauthorized, "API key is revoked or expired")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, key.UserID)
+	if err != nil {
+		if errors.Is(err, errors.ErrCodeNotFound) {
+			return nil, nil, invalid
+		}
+		return nil, nil, err
+	}
+	if user.IsActive != 1 {
+		return nil, nil, errors.New(errors.ErrCodeForbidden, "user account is disabled")
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval || key.LastUsedIP != ip {
+		if err := s.apiKeyRepo.TouchLastUsed(ctx, key.ID, now, ip); err != nil {
+			logger.Warn("Failed to record API key usage", logger.Err(err), logger.Int64("id", key.ID))
+		} else {
+			key.LastUsedAt, key.LastUsedIP = &now, ip
+		}
+	}
+
+	return key, user, nil
+}
+
+// ListKeys 列出用户的 API Key，userID 为 0 时列出所有用户的
+func (s *APIKeyService) ListKeys(ctx context.Context, userID int64) ([]*model.APIKey, error) {
+	return s.apiKeyRepo.List(ctx, userID)
+}
+
+// RevokeKey 撤销 API Key。只有 Key 的所有者或可以管理用户的角色才能撤销
+func (s *APIKeyService) RevokeKey(ctx context.Context, actorID int64, actorRole string, id int64) error {
+	key, err := s.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if key.UserID != actorID && !model.RoleHasPermission(actorRole, model.PermissionManageUsers) {
+		return errors.NotFound("API key", strconv.FormatInt(id, 10))
+	}
+	if key.RevokedAt != nil {
+		return errors.New(errors.ErrCodeInvalidParam, "API key is already revoked")
+	}
+
+	return s.apiKeyRepo.Revoke(ctx, id, time.Now())
+}
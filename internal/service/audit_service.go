@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/logger"
+)
+
+// AuditActor is who a change is recorded against.
+type AuditActor struct {
+	UserID   int64
+	Username string
+	IP       string
+}
+
+type auditActorKey struct{}
+
+// WithAuditActor attaches the actor of a request to its context so the
+// services it calls can record their changes against it.
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+func auditActorFrom(ctx context.Context) AuditActor {
+	actor, _ := ctx.Value(auditActorKey{}).(AuditActor)
+	return actor
+}
+
+type AuditService struct {
+	auditRepo repository.AuditLogRepository
+}
+
+func NewAuditService(auditRepo repository.AuditLogRepository) *AuditService {
+	return &AuditService{
+		auditRepo: auditRepo,
+	}
+}
+
+// Record 记录一次操作。before 和 after 以 JSON 保存，为 nil 时留空。
+// 写入失败只记日志，不影响操作本身
+func (s *AuditService) Record(ctx context.Context, action, resourceType, resourceID string, before, after any) {
+	actor := auditActorFrom(ctx)
+	entry := &model.AuditLog{
+		Username:     actor.Username,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		IP:           actor.IP,
+		BeforeJSON:   auditValue(before),
+		AfterJSON:    auditValue(after),
+	}
+	if actor.UserID > 0 {
+		entry.UserID = &actor.UserID
+	}
+
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		logger.Error("Failed to write audit log",
+			logger.Err(err),
+			logger.String("action", action),
+			logger.String("resource_type", resourceType),
+			logger.String("resource_id", resourceID),
+		)
+	}
+}
+
+func auditValue(v any) *string {
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil || string(data) == "null" {
+		return nil
+	}
+	value := string(data)
+	return &value
+}
+
+// ListEntries 分页查询审计日志，最新的在前
+func (s *AuditService) ListEntries(ctx context.Context, filter *repository.AuditLogFilter, page, pageSize int) ([]*model.AuditLog, int64, error) {
+	return s.auditRepo.List(ctx, filter, page, pageSize)
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+)
+
+// categoryAliases resolves free-text categories, as found in Channel.Category
+// or provider data, to one of the known categories. Order matters because
+// labels like "少儿动画" should resolve to the first match.
+var categoryAliases = []struct {
+	category string
+	labels   []string
+}{
+	{model.CategoryKids, []string{"少儿", "儿童", "动画", "卡通", "kids", "children"}},
+	{model.CategoryNews, []string{"新闻", "资讯", "news"}},
+	{model.CategorySports, []string{"体育", "赛事", "sport"}},
+	{model.CategoryMovie, []string{"电影", "movie", "film"}},
+	{model.CategorySeries, []string{"电视剧", "剧场", "影视", "series", "drama"}},
+	{model.CategoryDocumentary, []string{"纪录", "纪实", "documentary"}},
+	{model.CategoryVariety, []string{"综艺", "娱乐", "variety", "show"}},
+	{model.CategoryMusic, []string{"音乐", "戏曲", "music"}},
+	{model.CategoryEducation, []string{"教育", "科教", "科普", "education"}},
+}
+
+type categoryMatcher struct {
+	rule *model.CategoryRule
+	re   *regexp.Regexp
+}
+
+func (m *categoryMatcher) match(program *model.Program) bool {
+	if m.rule.ChannelID != "" && m.rule.ChannelID != program.ChannelID {
+		return false
+	}
+	if m.re != nil {
+		return m.re.MatchString(program.Title)
+	}
+	return strings.Contains(strings.ToLower(program.Title), strings.ToLower(m.rule.Pattern))
+}
+
+type CategoryService struct {
+	ruleRepo     repository.CategoryRuleRepository
+	channelIndex *ChannelIndex
+
+	mu       sync.RWMutex
+	loaded   bool
+	matchers []*categoryMatcher
+}
+
+func NewCategoryService(ruleRepo repository.CategoryRuleRepository, channelIndex *ChannelIndex) *CategoryService {
+	return &CategoryService{
+		ruleRepo:     ruleRepo,
+		channelIndex: channelIndex,
+	}
+}
+
+// Categorize fills Program.Category in place. A category supplied by the
+// provider wins when it resolves to a known category; otherwise the first
+// matching rule by priority is used, then the channel's own category.
+func (s *CategoryService) Categorize(ctx context.Context, programs []*model.Program) []*model.Program {
+	if len(programs) == 0 {
+		return programs
+	}
+
+	matchers, err := s.getMatchers(ctx)
+	if err != nil {
+		logger.Warn("Failed to load category rules", logger.Err(err))
+	}
+
+	channelDefaults := make(map[string]string)
+	channelDefault := func(channelID string) string {
+		category, ok := channelDefaults[channelID]
+		if ok {
+			return category
+		}
+		channel, err := s.channelIndex.Channel(ctx, channelID)
+		if err != nil {
+			logger.Warn("Failed to load channel categories", logger.Err(err))
+		} else if channel != nil {
+			category = ResolveCategory(channel.Category)
+		}
+		channelDefaults[channelID] = category
+		return category
+	}
+
+	for _, p := range programs {
+		if category := ResolveCategory(p.Category); category != "" {
+			p.Category = category
+			continue
+		}
+
+		matched := false
+		for _, m := range matchers {
+			if m.match(p) {
+				p.Category = m.rule.Category
+				matched = true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+
+		if category := channelDefault(p.ChannelID); category != "" {
+			p.Category = category
+		}
+	}
+
+	return programs
+}
+
+// ResolveCategory maps a category key or a free-text label to a known
+// category, returning an empty string when nothing matches.
+func ResolveCategory(label string) string {
+	label = strings.ToLower(strings.TrimSpace(label))
+	if label == "" {
+		return ""
+	}
+	if _, ok := model.CategoryGenres[label]; ok {
+		return label
+	}
+	for _, alias := range categoryAliases {
+		for _, l := range alias.labels {
+			if strings.Contains(label, l) {
+				return alias.category
+			}
+		}
+	}
+	return ""
+}
+
+func (s *CategoryService) ListRules(ctx context.Context) ([]*model.CategoryRule, error) {
+	return s.ruleRepo.List(ctx)
+}
+
+func (s *CategoryService) CreateRule(ctx context.Context, rule *model.CategoryRule) error {
+	if err := validateCategoryRule(rule); err != nil {
+		return err
+	}
+	if err := s.ruleRepo.Create(ctx, rule); err != nil {
+		return err
+	}
+	s.invalidate()
+	return nil
+}
+
+func (s *CategoryService) GetRule(ctx context.Context, id int64) (*model.CategoryRule, error) {
+	return s.ruleRepo.GetByID(ctx, id)
+}
+
+func (s *CategoryService) UpdateRule(ctx context.Context, rule *model.CategoryRule) error {
+	if err := validateCategoryRule(rule); err != nil {
+		return err
+	}
+	if err := s.ruleRepo.Update(ctx, rule); err != nil {
+		return err
+	}
+	s.invalidate()
+	return nil
+}
+
+func (s *CategoryService) DeleteRule(ctx context.Context, id int64) error {
+	if err := s.ruleRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.invalidate()
+	return nil
+}
+
+func (s *CategoryService) getMatchers(ctx context.Context) ([]*categoryMatcher, error) {
+	s.mu.RLock()
+	if s.loaded {
+		matchers := s.matchers
+		s.mu.RUnlock()
+		return matchers, nil
+	}
+	s.mu.RUnlock()
+
+	rules, err := s.ruleRepo.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matchers := make([]*categoryMatcher, 0, len(rules))
+	for _, rule := range rules {
+		m := &categoryMatcher{rule: rule}
+		if rule.MatchType == model.CategoryMatchRegex {
+			re, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				logger.Warn("Skipping invalid category rule",
+					logger.Int64("id", rule.ID),
+					logger.String("pattern", rule.Pattern),
+					logger.Err(err),
+				)
+				continue
+			}
+			m.re = re
+		}
+		matchers = append(matchers, m)
+	}
+
+	s.mu.Lock()
+	s.matchers = matchers
+	s.loaded = true
+	s.mu.Unlock()
+
+	return matchers, nil
+}
+
+func (s *CategoryService) invalidate() {
+	s.mu.Lock()
+	s.loaded = false
+	s.matchers = nil
+	s.mu.Unlock()
+}
+
+func validateCategoryRule(rule *model.CategoryRule) error {
+	if _, ok := model.CategoryGenres[rule.Category]; !ok {
+		return errors.InvalidParam("category", fmt.Sprintf("unknown category %s", rule.Category))
+	}
+	switch rule.MatchType {
+	case model.CategoryMatchKeyword:
+	case model.CategoryMatchRegex:
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return errors.InvalidParam("pattern", err.Error())
+		}
+	default:
+		return errors.InvalidParam("match_type", fmt.Sprintf("unsupported match type %s", rule.MatchType))
+	}
+	if strings.TrimSpace(rule.Pattern) == "" {
+		return errors.InvalidParam("pattern", "pattern is required")
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/provider"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/logger"
+)
+
+type ChangeFeedService struct {
+	changeRepo     repository.ProgramChangeRepository
+	webhookService *WebhookService
+}
+
+func NewChangeFeedService(changeRepo repository.ProgramChangeRepository, webhookService *WebhookService) *ChangeFeedService {
+	return &ChangeFeedService{
+		changeRepo:     changeRepo,
+		webhookService: webhookService,
+	}
+}
+
+// RecordChanges diffs the programs published before a sync against the ones
+// that replaced them, stores the differences and notifies webhooks. Every
+// sync that replaces programs calls it. A channel with no data before is an
+// initial publication, not a change, and is skipped, as are channels that got
+// no data now, since that is almost always a failed fetch rather than a real
+// schedule change.
+func (s *ChangeFeedService) RecordChanges(ctx context.Context, providerID string, date time.Time, oldPrograms, newPrograms []*model.Program) ([]*model.ProgramChange, error) {
+	oldByChannel := groupProgramsByChannel(oldPrograms)
+	newByChannel := groupProgramsByChannel(newPrograms)
+	dateStr := date.Format("2006-01-02")
+
+	channelIDs := make([]string, 0, len(newByChannel))
+	for channelID := range newByChannel {
+		channelIDs = append(channelIDs, channelID)
+	}
+	sort.Strings(channelIDs)
+
+	var changes []*model.ProgramChange
+	for _, channelID := range channelIDs {
+		previous, current := oldByChannel[channelID], newByChannel[channelID]
+		if len(previous) == 0 || len(current) == 0 {
+			continue
+		}
+
+		for _, change := range diffPrograms(previous, current) {
+			change.ChannelID = channelID
+			change.ProviderID = providerID
+			change.Date = dateStr
+			changes = append(changes, change)
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	if err := s.changeRepo.CreateBatch(ctx, changes); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Recorded EPG changes",
+		logger.String("provider_id", providerID),
+		logger.String("date", dateStr),
+		logger.Int("count", len(changes)),
+	)
+
+	if s.webhookService != nil {
+		s.webhookService.Dispatch(ctx, &model.WebhookPayload{
+			Event:      "epg.changed",
+			ProviderID: providerID,
+			Date:       dateStr,
+			Changes:    changes,
+			Timestamp:  time.Now().Unix(),
+		})
+	}
+
+	return changes, nil
+}
+
+func (s *ChangeFeedService) ListChanges(ctx context.Context, filter *repository.ProgramChangeFilter, page, pageSize int) ([]*model.ProgramChange, int64, error) {
+	return s.changeRepo.List(ctx, filter, page, pageSize)
+}
+
+func (s *ChangeFeedService) Cleanup(ctx context.Context, keepDays int) (int64, error) {
+	return s.changeRepo.DeleteBefore(ctx, time.Now().AddDate(0, 0, -keepDays))
+}
+
+func groupProgramsByChannel(programs []*model.Program) map[string][]*model.Program {
+	grouped := make(map[string][]*model.Program)
+	for _, p := range programs {
+		grouped[p.ChannelID] = append(grouped[p.ChannelID], p)
+	}
+	return grouped
+}
+
+// diffPrograms pairs programs in three passes: identical start and title are
+// unchanged, the same title at another time is a time shift, and another title
+// in the same slot is a title change. Whatever is left over is reported as
+// removed or added.
+func diffPrograms(oldPrograms, newPrograms []*model.Program) []*model.ProgramChange {
+	var changes []*model.ProgramChange
+
+	matchedOld := make(map[*model.Program]bool)
+	matchedNew := make(map[*model.Program]bool)
+
+	oldBySlot := make(map[string]*model.Program, len(oldPrograms))
+	for _, p := range oldPrograms {
+		oldBySlot[programSlotKey(p)] = p
+	}
+	for _, p := range newPrograms {
+		if old, ok := oldBySlot[programSlotKey(p)]; ok && !matchedOld[old] {
+			matchedOld[old] = true
+			matchedNew[p] = true
+		}
+	}
+
+	oldByTitle := make(map[string][]*model.Program)
+	for _, p := range oldPrograms {
+		if !matchedOld[p] {
+			oldByTitle[p.Title] = append(oldByTitle[p.Title], p)
+		}
+	}
+	for _, p := range newPrograms {
+		candidates := oldByTitle[p.Title]
+		if matchedNew[p] || len(candidates) == 0 {
+			continue
+		}
+
+		nearest := 0
+		for i, c := range candidates {
+			if provider.AbsDuration(c.StartTime.Sub(p.StartTime)) < provider.AbsDuration(candidates[nearest].StartTime.Sub(p.StartTime)) {
+				nearest = i
+			}
+		}
+		old := candidates[nearest]
+		oldByTitle[p.Title] = append(candidates[:nearest], candidates[nearest+1:]...)
+		matchedOld[old] = true
+		matchedNew[p] = true
+
+		changes = append(changes, &model.ProgramChange{
+			ChangeType:   model.ChangeTypeTimeShifted,
+			Title:        p.Title,
+			OldTitle:     old.Title,
+			StartTime:    timePtr(p.StartTime),
+			EndTime:      timePtr(p.EndTime),
+			OldStartTime: timePtr(old.StartTime),
+			OldEndTime:   timePtr(old.EndTime),
+		})
+	}
+
+	oldByStart := make(map[int64]*model.Program)
+	for _, p := range oldPrograms {
+		if !matchedOld[p] {
+			oldByStart[p.StartTime.Unix()] = p
+		}
+	}
+	for _, p := range newPrograms {
+		if matchedNew[p] {
+			continue
+		}
+		old, ok := oldByStart[p.StartTime.Unix()]
+		if ok && !matchedOld[old] {
+			matchedOld[old] = true
+			changes = append(changes, &model.ProgramChange{
+				ChangeType:   model.ChangeTypeTitleChanged,
+				Title:        p.Title,
+				OldTitle:     old.Title,
+				StartTime:    timePtr(p.StartTime),
+				EndTime:      timePtr(p.EndTime),
+				OldStartTime: timePtr(old.StartTime),
+				OldEndTime:   timePtr(old.EndTime),
+			})
+			continue
+		}
+
+		changes = append(changes, &model.ProgramChange{
+			ChangeType: model.ChangeTypeAdded,
+			Title:      p.Title,
+			StartTime:  timePtr(p.StartTime),
+			EndTime:    timePtr(p.EndTime),
+		})
+	}
+
+	for _, p := range oldPrograms {
+		if matchedOld[p] {
+			continue
+		}
+		changes = append(changes, &model.ProgramChange{
+			ChangeType:   model.ChangeTypeRemoved,
+			OldTitle:     p.Title,
+			OldStartTime: timePtr(p.StartTime),
+			OldEndTime:   timePtr(p.EndTime),
+		})
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changeSortTime(changes[i]).Before(changeSortTime(changes[j]))
+	})
+
+	return changes
+}
+
+func programSlotKey(p *model.Program) string {
+	return fmt.Sprintf("%d|%s", p.StartTime.Unix(), p.Title)
+}
+
+func changeSortTime(c *model.ProgramChange) time.Time {
+	if c.StartTime != nil {
+		return *c.StartTime
+	}
+	if c.OldStartTime != nil {
+		return *c.OldStartTime
+	}
+	return time.Time{}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+)
+
+var diffDay = time.Date(2026, 10, 18, 0, 0, 0, 0, time.Local)
+
+func program(channelID, start, end, title string) *model.Program {
+	at := func(clock string) time.Time {
+		t, err := time.ParseInLocation("2006-01-02 15:04", diffDay.Format("2006-01-02 ")+clock, time.Local)
+		if err != nil {
+			panic(err)
+		}
+		return t
+	}
+	return &model.Program{ChannelID: channelID, StartTime: at(start), EndTime: at(end), Title: title}
+}
+
+// describeChanges renders changes as "type old@start -> new@start" so a test
+// table can list them compactly.
+func describeChanges(changes []*model.ProgramChange) []string {
+	clock := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format("15:04")
+	}
+	out := make([]string, len(changes))
+	for i, c := range changes {
+		out[i] = fmt.Sprintf("%s %s@%s -> %s@%s", c.ChangeType, c.OldTitle, clock(c.OldStartTime), c.Title, clock(c.StartTime))
+	}
+	return out
+}
+
+func TestDiffPrograms(t *testing.T) {
+	schedule := []*model.Program{
+		program("CCTV1", "08:00", "09:00", "朝闻天下"),
+		program("CCTV1", "09:00", "10:00", "生活圈"),
+		program("CCTV1", "10:00", "11:00", "电视剧"),
+	}
+
+	tests := []struct {
+		name string
+		old  []*model.Program
+		new  []*model.Program
+		want []string
+	}{
+		{
+			name: "unchanged",
+			old:  schedule,
+			new:  schedule,
+			want: []string{},
+		},
+		{
+			name: "added",
+			old:  schedule,
+			new:  append(slices.Clone(schedule), program("CCTV1", "11:00", "12:00", "新闻30分")),
+			want: []string{"added @ -> 新闻30分@11:00"},
+		},
+		{
+			name: "removed",
+			old:  schedule,
+			new:  schedule[:2],
+			want: []string{"removed 电视剧@10:00 -> @"},
+		},
+		{
+			name: "title changed",
+			old:  schedule,
+			new: []*model.Program{
+				schedule[0],
+				program("CCTV1", "09:00", "10:00", "今日说法"),
+				schedule[2],
+			},
+			want: []string{"title_changed 生活圈@09:00 -> 今日说法@09:00"},
+		},
+		{
+			name: "time shifted",
+			old:  schedule,
+			new: []*model.Program{
+				schedule[0],
+				program("CCTV1", "09:15", "10:00", "生活圈"),
+				schedule[2],
+			},
+			want: []string{"time_shifted 生活圈@09:00 -> 生活圈@09:15"},
+		},
+		{
+			name: "repeated title shifts to the nearest airing",
+			old: []*model.Program{
+				program("CCTV1", "08:00", "09:00", "新闻"),
+				program("CCTV1", "12:00", "13:00", "新闻"),
+			},
+			new: []*model.Program{
+				program("CCTV1", "08:00", "09:00", "新闻"),
+				program("CCTV1", "12:30", "13:00", "新闻"),
+			},
+			want: []string{"time_shifted 新闻@12:00 -> 新闻@12:30"},
+		},
+		{
+			name: "changes are ordered by start",
+			old:  schedule,
+			new: []*model.Program{
+				program("CCTV1", "07:00", "08:00", "早间新闻"),
+				schedule[0],
+				program("CCTV1", "09:00", "10:00", "今日说法"),
+			},
+			want: []string{
+				"added @ -> 早间新闻@07:00",
+				"title_changed 生活圈@09:00 -> 今日说法@09:00",
+				"removed 电视剧@10:00 -> @",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := describeChanges(diffPrograms(tt.old, tt.new))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("diffPrograms() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+type recordingChangeRepo struct {
+	repository.ProgramChangeRepository
+	created []*model.ProgramChange
+}
+
+func (r *recordingChangeRepo) CreateBatch(_ context.Context, changes []*model.ProgramChange) error {
+	r.created = append(r.created, changes...)
+	return nil
+}
+
+func TestRecordChangesSkipsInitialPublication(t *testing.T) {
+	repo := &recordingChangeRepo{}
+	feed := NewChangeFeedService(repo, nil)
+
+	oldPrograms := []*model.Program{
+		program("CCTV1", "08:00", "09:00", "朝闻天下"),
+		program("CCTV2", "08:00", "09:00", "第一时间"),
+	}
+	newPrograms := []*model.Program{
+		program("CCTV1", "08:00", "09:00", "朝闻天下"),
+		program("CCTV1", "09:00", "10:00", "生活圈"),
+		// first data for the date, not a change
+		program("CCTV3", "08:00", "09:00", "综艺喜乐汇"),
+	}
+
+	changes, err := feed.RecordChanges(context.Background(), "ysp", diffDay, oldPrograms, newPrograms)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// CCTV2 got no data, which is a failed fetch and skipped as well
+	want := []string{"added @ -> 生活圈@09:00"}
+	if got := describeChanges(changes); !slices.Equal(got, want) {
+		t.Errorf("RecordChanges() = %q, want %q", got, want)
+	}
+	if len(repo.created) != len(changes) {
+		t.Errorf("stored %d changes, want %d", len(repo.created), len(changes))
+	}
+	for _, c := range changes {
+		if c.ChannelID != "CCTV1" || c.ProviderID != "ysp" || c.Date != "2026-10-18" {
+			t.Errorf("change %+v is not attributed to CCTV1, ysp and 2026-10-18", c)
+		}
+	}
+
+	changes, err = feed.RecordChanges(context.Background(), "ysp", diffDay, nil, newPrograms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("initial publication recorded %d changes, want none", len(changes))
+	}
+}
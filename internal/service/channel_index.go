@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+)
+
+// ChannelIndex resolves incoming channel names, as sent by DIYP clients or
+// listed by providers, to canonical channels. It is built from the channels
+// and their aliases on first use and rebuilt after either changes.
+type ChannelIndex struct {
+	channelRepo repository.ChannelRepository
+	aliasRepo   repository.ChannelAliasRepository
+
+	mu     sync.RWMutex
+	lookup *channelLookup
+	// generation counts invalidations, so a lookup built from data read
+	// before one is not kept
+	generation uint64
+}
+
+type aliasPattern struct {
+	channelID string
+	re        *regexp.Regexp
+}
+
+// channelLookup is an immutable snapshot of the index.
+type channelLookup struct {
+	channels   map[string]*model.Channel
+	exact      map[string]string
+	normalized map[string]string
+	patterns   []*aliasPattern
+	// names holds the exact and normalized aliases of each channel, used as
+	// extra names when fuzzy matching provider channels
+	names map[string][]string
+}
+
+func NewChannelIndex(channelRepo repository.ChannelRepository, aliasRepo repository.ChannelAliasRepository) *ChannelIndex {
+	return &ChannelIndex{
+		channelRepo: channelRepo,
+		aliasRepo:   aliasRepo,
+	}
+}
+
+// Lookup returns the channel for a name. Exact aliases, channel ids and
+// display names are tried first, then their normalized forms, then regex
+// aliases in the order they were added.
+func (i *ChannelIndex) Lookup(ctx context.Context, name string) (*model.Channel, error) {
+	lookup, err := i.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel := lookup.channels[lookup.match(name)]; channel != nil {
+		return channel, nil
+	}
+	return nil, errors.ChannelNotFound(name)
+}
+
+// Channel returns the channel with the given id, or nil when there is none,
+// without reading the channel table again.
+func (i *ChannelIndex) Channel(ctx context.Context, channelID string) (*model.Channel, error) {
+	lookup, err := i.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lookup.channels[channelID], nil
+}
+
+// Invalidate drops the index so the next lookup rebuilds it.
+func (i *ChannelIndex) Invalidate() {
+	i.mu.Lock()
+	i.lookup = nil
+	i.generation++
+	i.mu.Unlock()
+}
+
+func (i *ChannelIndex) get(ctx context.Context) (*channelLookup, error) {
+	i.mu.RLock()
+	lookup, generation := i.lookup, i.generation
+	i.mu.RUnlock()
+	if lookup != nil {
+		return lookup, nil
+	}
+
+	channels, err := i.channelRepo.GetAllChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := i.aliasRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup = buildChannelLookup(channels, aliases)
+
+	// a write during the build may be missing from lookup; it still serves
+	// this call but the next one builds again
+	i.mu.Lock()
+	if i.generation == generation {
+		i.lookup = lookup
+	}
+	i.mu.Unlock()
+
+	logger.Debug("Channel index built",
+		logger.Int("channel_count", len(channels)),
+		logger.Int("alias_count", len(aliases)),
+	)
+
+	return lookup, nil
+}
+
+func buildChannelLookup(channels []*model.Channel, aliases []*model.ChannelAlias) *channelLookup {
+	lookup := &channelLookup{
+		channels:   make(map[string]*model.Channel, len(channels)),
+		exact:      make(map[string]string),
+		normalized: make(map[string]string),
+		names:      make(map[string][]string),
+	}
+
+	for _, channel := range channels {
+		lookup.channels[channel.ChannelID] = channel
+	}
+
+	// explicit aliases go first so they win over a clashing channel name
+	for _, alias := range aliases {
+		if lookup.channels[alias.ChannelID] == nil {
+			continue
+		}
+
+		switch alias.MatchType {
+		case model.AliasMatchExact:
+			setIfAbsent(lookup.exact, strings.ToLower(strings.TrimSpace(alias.Alias)), alias.ChannelID)
+			lookup.names[alias.ChannelID] = append(lookup.names[alias.ChannelID], alias.Alias)
+		case model.AliasMatchNormalized:
+			setIfAbsent(lookup.normalized, normalizeChannelName(alias.Alias), alias.ChannelID)
+			lookup.names[alias.ChannelID] = append(lookup.names[alias.ChannelID], alias.Alias)
+		case model.AliasMatchRegex:
+			re, err := compileAliasPattern(alias.Alias)
+			if err != nil {
+				logger.Warn("Skipping invalid channel alias",
+					logger.Int64("id", alias.ID),
+					logger.String("alias", alias.Alias),
+					logger.Err(err),
+				)
+				continue
+			}
+			lookup.patterns = append(lookup.patterns, &aliasPattern{channelID: alias.ChannelID, re: re})
+		}
+	}
+
+	for _, channel := range channels {
+		for _, name := range []string{channel.ChannelID, channel.DisplayName} {
+			if name == "" {
+				continue
+			}
+			setIfAbsent(lookup.exact, strings.ToLower(name), channel.ChannelID)
+			setIfAbsent(lookup.normalized, normalizeChannelName(name), channel.ChannelID)
+		}
+	}
+
+	return lookup
+}
+
+// match returns the channel id for a name, or an empty string.
+func (l *channelLookup) match(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ""
+	}
+
+	if id, ok := l.exact[strings.ToLower(name)]; ok {
+		return id
+	}
+	if normalized := normalizeChannelName(name); normalized != "" {
+		if id, ok := l.normalized[normalized]; ok {
+			return id
+		}
+	}
+	for _, p := range l.patterns {
+		if p.re.MatchString(name) {
+			return p.channelID
+		}
+	}
+	return ""
+}
+
+// compileAliasPattern anchors the pattern so it has to cover the whole name;
+// otherwise ^cctv-?1 would also claim CCTV10. The patterns moved over from the
+// old channel.regexp column were searches and carry a .* so they keep
+// matching the names they matched before.
+func compileAliasPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`(?i)^(?:` + pattern + `)$`)
+}
+
+func setIfAbsent(m map[string]string, key, value string) {
+	if key == "" {
+		return
+	}
+	if _, ok := m[key]; !ok {
+		m[key] = value
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+)
+
+type stubChannelRepo struct {
+	repository.ChannelRepository
+	channels []*model.Channel
+}
+
+func (r *stubChannelRepo) GetAllChannels(context.Context) ([]*model.Channel, error) {
+	return r.channels, nil
+}
+
+// stubAliasRepo runs onList after reading the aliases, as a concurrent
+// write would between the index reading the tables and storing the result.
+type stubAliasRepo struct {
+	repository.ChannelAliasRepository
+	aliases []*model.ChannelAlias
+	onList  func()
+}
+
+func (r *stubAliasRepo) List(context.Context) ([]*model.ChannelAlias, error) {
+	aliases := r.aliases
+	if r.onList != nil {
+		onList := r.onList
+		r.onList = nil
+		onList()
+	}
+	return aliases, nil
+}
+
+func TestChannelIndexDropsLookupBuiltBeforeInvalidate(t *testing.T) {
+	ctx := context.Background()
+	channels := &stubChannelRepo{channels: []*model.Channel{{ChannelID: "CCTV1", DisplayName: "CCTV-1 综合"}}}
+	aliases := &stubAliasRepo{}
+	index := NewChannelIndex(channels, aliases)
+
+	// an alias is added while the first lookup is being built
+	aliases.onList = func() {
+		aliases.aliases = []*model.ChannelAlias{{ChannelID: "CCTV1", Alias: "央视一套", MatchType: model.AliasMatchExact}}
+		index.Invalidate()
+	}
+
+	if _, err := index.Lookup(ctx, "央视一套"); err == nil {
+		t.Fatal("the first lookup was built before the alias was added and should not find it")
+	}
+
+	channel, err := index.Lookup(ctx, "央视一套")
+	if err != nil {
+		t.Fatalf("the alias added during the first build is not found: %v", err)
+	}
+	if channel.ChannelID != "CCTV1" {
+		t.Errorf("Lookup() = %s, want CCTV1", channel.ChannelID)
+	}
+}
@@ -2,48 +2,88 @@ package service
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/epg-sync/epgsync/internal/config"
 	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/provider"
 	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
 	"github.com/epg-sync/epgsync/pkg/logger"
 )
 
 type ChannelMappingService struct {
 	channelRepo        repository.ChannelRepository
 	channelMappingRepo repository.ChannelMappingsRepository
+	chain              *provider.Chain
+	index              *ChannelIndex
+	cfg                config.MappingConfig
+	audit              *AuditService
 }
 
 func NewChannelMappingService(
 	channelMappingRepo repository.ChannelMappingsRepository,
 	channelRepo repository.ChannelRepository,
+	chain *provider.Chain,
+	index *ChannelIndex,
+	cfg config.MappingConfig,
+	audit *AuditService,
 ) *ChannelMappingService {
 	return &ChannelMappingService{
 		channelMappingRepo: channelMappingRepo,
 		channelRepo:        channelRepo,
+		chain:              chain,
+		index:              index,
+		cfg:                cfg,
+		audit:              audit,
 	}
 }
 
+// AutoMapChannels suggests a mapping for every provider channel that has none.
+// Suggestions scoring at least min_score are stored as pending, everything
+// else only shows up in the review queue. Mappings that already exist, in any
+// status, are left alone so review decisions survive restarts.
 func (s *ChannelMappingService) AutoMapChannels(ctx context.Context, providerID string, providerChannels []*model.ProviderChannel) error {
 	standardChannels, err := s.channelRepo.GetAllChannels(ctx)
 	if err != nil {
 		return err
 	}
+	lookup, err := s.index.get(ctx)
+	if err != nil {
+		return err
+	}
 
 	for _, pc := range providerChannels {
 
 		existing, _ := s.channelMappingRepo.GetByProviderChannelID(ctx, pc.ID, providerID)
-		if existing != nil && existing.Confidence >= 0.8 {
+		if existing != nil {
 			continue
 		}
 
-		bestMatch, score := s.findBestMatch(pc, standardChannels)
-		if bestMatch != nil && score >= 0.8 {
+		bestMatch, score, ambiguous := s.findBestMatch(pc, standardChannels, lookup)
+		if bestMatch != nil && ambiguous {
+			logger.Info("Ambiguous channel mapping left for review",
+				logger.String("provider_id", providerID),
+				logger.String("provider_channel_id", pc.ID),
+				logger.String("provider_channel_name", pc.Name),
+				logger.String("best_match", bestMatch.ChannelID),
+				logger.Float64("score", score),
+			)
+		} else if bestMatch != nil && score >= s.cfg.MinScore {
 			mapping := &model.ChannelMapping{
-				ProviderID:        providerID,
-				ProviderChannelID: pc.ID,
-				CanonicalID:       bestMatch.ChannelID,
-				Confidence:        score,
+				ProviderID:          providerID,
+				ProviderChannelID:   pc.ID,
+				ProviderChannelName: pc.Name,
+				CanonicalID:         bestMatch.ChannelID,
+				Confidence:          score,
+				Status:              model.MappingStatusPending,
 			}
 
 			if err := s.channelMappingRepo.Create(ctx, mapping); err != nil {
@@ -62,67 +102,464 @@ func (s *ChannelMappingService) AutoMapChannels(ctx context.Context, providerID
 	return nil
 }
 
-func (s *ChannelMappingService) ListChannels(ctx context.Context, providerID string) ([]*model.ChannelMapping, error) {
-	channels, err := s.channelMappingRepo.ListByProviderID(ctx, providerID)
+// ReviewQueue lists provider channels without a mapping and pending automatic
+// mappings, each with the best scoring canonical channels.
+func (s *ChannelMappingService) ReviewQueue(ctx context.Context, providerID string) ([]*model.MappingReviewItem, error) {
+	standardChannels, err := s.channelRepo.GetAllChannels(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return channels, nil
+	lookup, err := s.index.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := s.channelMappingRepo.ListByStatus(ctx, model.MappingStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	pendingByKey := make(map[string]*model.ChannelMapping, len(pending))
+	for _, m := range pending {
+		pendingByKey[m.ProviderID+"/"+m.ProviderChannelID] = m
+	}
+
+	var items []*model.MappingReviewItem
+	for _, p := range s.chain.GetProviders() {
+		if providerID != "" && p.GetID() != providerID {
+			continue
+		}
+
+		mapped, err := s.channelMappingRepo.ListByProviderID(ctx, p.GetID())
+		if err != nil {
+			return nil, err
+		}
+		mappedIDs := make(map[string]bool, len(mapped))
+		for _, m := range mapped {
+			mappedIDs[m.ProviderChannelID] = true
+		}
+
+		for _, pc := range p.ListChannels() {
+			mapping := pendingByKey[p.GetID()+"/"+pc.ID]
+			if mapping == nil && mappedIDs[pc.ID] {
+				continue
+			}
+
+			items = append(items, &model.MappingReviewItem{
+				ProviderID:          p.GetID(),
+				ProviderChannelID:   pc.ID,
+				ProviderChannelName: pc.Name,
+				Mapping:             mapping,
+				Candidates:          s.rankCandidates(pc, standardChannels, lookup, s.cfg.Candidates),
+			})
+		}
+	}
+
+	return items, nil
+}
+
+func (s *ChannelMappingService) ApproveMapping(ctx context.Context, id int64) (*model.ChannelMapping, error) {
+	return s.review(ctx, id, model.MappingStatusVerified)
+}
+
+func (s *ChannelMappingService) RejectMapping(ctx context.Context, id int64) (*model.ChannelMapping, error) {
+	return s.review(ctx, id, model.MappingStatusRejected)
+}
+
+// CreateMapping stores a manual mapping as verified, replacing whatever was
+// suggested for the provider channel before.
+func (s *ChannelMappingService) CreateMapping(ctx context.Context, providerID, providerChannelID, canonicalID string, priority int) (*model.ChannelMapping, error) {
+	before, _ := s.channelMappingRepo.GetByProviderChannelID(ctx, providerChannelID, providerID)
+
+	mapping, created, err := s.saveMapping(ctx, &model.ChannelMapping{
+		ProviderID:        providerID,
+		ProviderChannelID: providerChannelID,
+		CanonicalID:       canonicalID,
+		Priority:          priority,
+		Status:            model.MappingStatusVerified,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	action := model.AuditActionUpdate
+	if created {
+		action = model.AuditActionCreate
+	}
+	s.audit.Record(ctx, action, model.AuditResourceChannelMapping, strconv.FormatInt(mapping.ID, 10), before, mapping)
+
+	return mapping, nil
+}
+
+// UpdateMapping points an existing mapping at another canonical or provider
+// channel, or changes its priority. Empty ids and a nil priority keep the
+// current value. The result counts as reviewed.
+func (s *ChannelMappingService) UpdateMapping(ctx context.Context, id int64, canonicalID, providerChannelID string, priority *int) (*model.ChannelMapping, error) {
+	mapping, err := s.channelMappingRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *mapping
+
+	if providerChannelID != "" && providerChannelID != mapping.ProviderChannelID {
+		existing, _ := s.channelMappingRepo.GetByProviderChannelID(ctx, providerChannelID, mapping.ProviderID)
+		if existing != nil {
+			return nil, errors.AlreadyExists("channel mapping", mapping.ProviderID+"/"+providerChannelID)
+		}
+		pc, err := s.lookupProviderChannel(mapping.ProviderID, providerChannelID)
+		if err != nil {
+			return nil, err
+		}
+		mapping.ProviderChannelID = pc.ID
+		mapping.ProviderChannelName = pc.Name
+	}
+
+	if canonicalID != "" && canonicalID != mapping.CanonicalID {
+		if _, err := s.channelRepo.GetByID(ctx, canonicalID); err != nil {
+			return nil, err
+		}
+		mapping.CanonicalID = canonicalID
+	}
+
+	if priority != nil {
+		mapping.Priority = *priority
+	}
+
+	now := time.Now()
+	mapping.Confidence = 1
+	mapping.IsVerified = 1
+	mapping.Status = model.MappingStatusVerified
+	mapping.ReviewedAt = &now
+
+	if err := s.channelMappingRepo.Update(ctx, mapping); err != nil {
+		return nil, err
+	}
+	s.audit.Record(ctx, model.AuditActionUpdate, model.AuditResourceChannelMapping, strconv.FormatInt(id, 10), &before, mapping)
+
+	return mapping, nil
+}
+
+func (s *ChannelMappingService) DeleteMapping(ctx context.Context, id int64) error {
+	before, _ := s.channelMappingRepo.GetByID(ctx, id)
+	if err := s.channelMappingRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.audit.Record(ctx, model.AuditActionDelete, model.AuditResourceChannelMapping, strconv.FormatInt(id, 10), before, nil)
+
+	return nil
+}
+
+const (
+	MappingFormatJSON = "json"
+	MappingFormatCSV  = "csv"
+)
+
+var mappingCSVHeader = []string{"provider_id", "provider_channel_id", "canonical_id", "priority", "status"}
+
+// ExportMappings writes every mapping as JSON or CSV. The output can be fed
+// back to ImportMappings unchanged.
+func (s *ChannelMappingService) ExportMappings(ctx context.Context, w io.Writer, format string) error {
+	mappings, err := s.channelMappingRepo.ListAllChannelMappings(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case MappingFormatJSON, "":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(mappings)
+	case MappingFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(mappingCSVHeader); err != nil {
+			return err
+		}
+		for _, m := range mappings {
+			record := []string{m.ProviderID, m.ProviderChannelID, m.CanonicalID, strconv.Itoa(m.Priority), m.Status}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return errors.InvalidParam("format", "unsupported format "+format)
+	}
+}
+
+// ImportMappings creates or updates mappings keyed by provider and provider
+// channel. Rows without a status are imported as verified.
+func (s *ChannelMappingService) ImportMappings(ctx context.Context, r io.Reader, format string) (*model.MappingImportResult, error) {
+	var rows []*model.ChannelMapping
+
+	switch format {
+	case MappingFormatJSON, "":
+		if err := json.NewDecoder(r).Decode(&rows); err != nil {
+			return nil, errors.InvalidParam("body", "invalid JSON: "+err.Error())
+		}
+	case MappingFormatCSV:
+		parsed, err := parseMappingCSV(r)
+		if err != nil {
+			return nil, err
+		}
+		rows = parsed
+	default:
+		return nil, errors.InvalidParam("format", "unsupported format "+format)
+	}
+
+	result := &model.MappingImportResult{}
+	for i, row := range rows {
+		if row == nil || row.ProviderID == "" || row.ProviderChannelID == "" || row.CanonicalID == "" {
+			result.Errors = append(result.Errors, fmt.Sprintf("row %d: provider_id, provider_channel_id and canonical_id are required", i+1))
+			continue
+		}
+
+		_, created, err := s.saveMapping(ctx, &model.ChannelMapping{
+			ProviderID:        row.ProviderID,
+			ProviderChannelID: row.ProviderChannelID,
+			CanonicalID:       row.CanonicalID,
+			Priority:          row.Priority,
+			Status:            row.Status,
+		})
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("row %d: %v", i+1, err))
+			continue
+		}
+		if created {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+	}
+
+	logger.Info("Channel mappings imported",
+		logger.Int("created", result.Created),
+		logger.Int("updated", result.Updated),
+		logger.Int("errors", len(result.Errors)),
+	)
+	s.audit.Record(ctx, model.AuditActionImport, model.AuditResourceChannelMapping, "", nil, result)
+
+	return result, nil
 }
 
-func (s *ChannelMappingService) findBestMatch(pc *model.ProviderChannel, standardChannels []*model.Channel) (*model.Channel, float64) {
-	var bestMatch *model.Channel
-	var bestScore float64
+func parseMappingCSV(r io.Reader) ([]*model.ChannelMapping, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, errors.InvalidParam("body", "invalid CSV: "+err.Error())
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range mappingCSVHeader[:3] {
+		if _, ok := columns[name]; !ok {
+			return nil, errors.InvalidParam("body", "missing CSV column "+name)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	rows := make([]*model.ChannelMapping, 0, len(records)-1)
+	for _, record := range records[1:] {
+		priority, _ := strconv.Atoi(field(record, "priority"))
+		rows = append(rows, &model.ChannelMapping{
+			ProviderID:        field(record, "provider_id"),
+			ProviderChannelID: field(record, "provider_channel_id"),
+			CanonicalID:       field(record, "canonical_id"),
+			Priority:          priority,
+			Status:            field(record, "status"),
+		})
+	}
+	return rows, nil
+}
+
+// saveMapping validates a reviewed mapping and creates it, or updates the
+// mapping that already exists for the provider channel. It reports whether a
+// new row was created.
+func (s *ChannelMappingService) saveMapping(ctx context.Context, m *model.ChannelMapping) (*model.ChannelMapping, bool, error) {
+	switch m.Status {
+	case "":
+		m.Status = model.MappingStatusVerified
+	case model.MappingStatusPending, model.MappingStatusVerified, model.MappingStatusRejected:
+	default:
+		return nil, false, errors.InvalidParam("status", "unsupported mapping status "+m.Status)
+	}
+
+	pc, err := s.lookupProviderChannel(m.ProviderID, m.ProviderChannelID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if _, err := s.channelRepo.GetByID(ctx, m.CanonicalID); err != nil {
+		return nil, false, err
+	}
+
+	now := time.Now()
+	isVerified := 0
+	if m.Status == model.MappingStatusVerified {
+		isVerified = 1
+	}
 
-	for _, sc := range standardChannels {
-		score := s.calculateMatchScore(pc, sc)
-		if score > bestScore {
-			bestScore = score
-			bestMatch = sc
+	existing, _ := s.channelMappingRepo.GetByProviderChannelID(ctx, m.ProviderChannelID, m.ProviderID)
+	if existing != nil {
+		existing.CanonicalID = m.CanonicalID
+		existing.ProviderChannelName = pc.Name
+		existing.Priority = m.Priority
+		existing.Confidence = 1
+		existing.IsVerified = isVerified
+		existing.Status = m.Status
+		existing.ReviewedAt = &now
+		if err := s.channelMappingRepo.Update(ctx, existing); err != nil {
+			return nil, false, err
 		}
+		return existing, false, nil
+	}
+
+	mapping := &model.ChannelMapping{
+		ProviderID:          m.ProviderID,
+		ProviderChannelID:   pc.ID,
+		ProviderChannelName: pc.Name,
+		CanonicalID:         m.CanonicalID,
+		Priority:            m.Priority,
+		Confidence:          1,
+		IsVerified:          isVerified,
+		Status:              m.Status,
+		ReviewedAt:          &now,
+	}
+	if err := s.channelMappingRepo.Create(ctx, mapping); err != nil {
+		return nil, false, errors.Wrap(err, errors.ErrCodeDatabaseQuery, "failed to create channel mapping")
+	}
+
+	return mapping, true, nil
+}
+
+func (s *ChannelMappingService) lookupProviderChannel(providerID, providerChannelID string) (*model.ProviderChannel, error) {
+	p, err := s.findProvider(providerID)
+	if err != nil {
+		return nil, err
 	}
 
-	return bestMatch, bestScore
+	for _, pc := range p.ListChannels() {
+		if pc.ID == providerChannelID {
+			return pc, nil
+		}
+	}
+	return nil, errors.NotFound("provider channel", providerChannelID)
 }
 
-func (s *ChannelMappingService) calculateMatchScore(pc *model.ProviderChannel, sc *model.Channel) float64 {
-	var score float64
+func (s *ChannelMappingService) review(ctx context.Context, id int64, status string) (*model.ChannelMapping, error) {
+	mapping, err := s.channelMappingRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *mapping
+	now := time.Now()
+	mapping.Status = status
+	mapping.IsVerified = 0
+	if status == model.MappingStatusVerified {
+		mapping.IsVerified = 1
+	}
+	mapping.ReviewedAt = &now
+
+	if err := s.channelMappingRepo.Update(ctx, mapping); err != nil {
+		return nil, err
+	}
 
-	if strings.EqualFold(pc.ID, sc.ChannelID) || strings.EqualFold(pc.Name, sc.DisplayName) {
-		score += 1.0
+	action := model.AuditActionReject
+	if status == model.MappingStatusVerified {
+		action = model.AuditActionApprove
 	}
+	s.audit.Record(ctx, action, model.AuditResourceChannelMapping, strconv.FormatInt(id, 10), &before, mapping)
+
+	return mapping, nil
+}
+
+// RankMappings orders mappings by effective priority, which is the mapping's
+// own priority when set and its provider's priority otherwise. Lower values
+// win, ties keep the provider chain order.
+func (s *ChannelMappingService) RankMappings(mappings []*model.ChannelMapping) []*model.ChannelMapping {
+	return rankMappings(mappings, s.chain.GetProviders())
+}
 
-	if strings.Contains(strings.ToLower(sc.ChannelID), strings.ToLower(pc.Name)) ||
-		strings.Contains(strings.ToLower(pc.Name), strings.ToLower(sc.ChannelID)) {
-		score += 0.7
+func rankMappings(mappings []*model.ChannelMapping, providers []provider.Provider) []*model.ChannelMapping {
+	providerPriority := make(map[string]int, len(providers))
+	providerOrder := make(map[string]int, len(providers))
+	for i, p := range providers {
+		providerPriority[p.GetID()] = p.GetPriority()
+		providerOrder[p.GetID()] = i
 	}
 
-	for _, alias := range pc.Aliases {
-		if strings.EqualFold(alias, sc.ChannelID) || strings.EqualFold(alias, sc.DisplayName) {
-			score += 0.9
-			break
+	effective := func(m *model.ChannelMapping) int {
+		if m.Priority > 0 {
+			return m.Priority
 		}
+		return providerPriority[m.ProviderID]
 	}
 
-	cleanPC := cleanChannelName(pc.Name)
-	cleanSC := cleanChannelName(sc.ChannelID)
-	cleanSCDisplayName := cleanChannelName(sc.DisplayName)
+	ranked := make([]*model.ChannelMapping, len(mappings))
+	copy(ranked, mappings)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		pi, pj := effective(ranked[i]), effective(ranked[j])
+		if pi != pj {
+			return pi < pj
+		}
+		return providerOrder[ranked[i].ProviderID] < providerOrder[ranked[j].ProviderID]
+	})
+
+	return ranked
+}
 
-	if strings.Contains(cleanSCDisplayName, cleanPC) {
-		score += 1.0
+func (s *ChannelMappingService) findProvider(providerID string) (provider.Provider, error) {
+	for _, p := range s.chain.GetProviders() {
+		if p.GetID() == providerID {
+			return p, nil
+		}
 	}
+	return nil, errors.ProviderNotFound(providerID)
+}
 
-	if cleanPC == cleanSC {
-		score += 0.8
+// ListChannels returns the mappings of a provider that may be synced.
+func (s *ChannelMappingService) ListChannels(ctx context.Context, providerID string) ([]*model.ChannelMapping, error) {
+	channels, err := s.channelMappingRepo.ListSyncableByProviderID(ctx, providerID, s.cfg.VerifiedOnly)
+	if err != nil {
+		return nil, err
 	}
+	return channels, nil
+}
 
-	return score
+func (s *ChannelMappingService) findBestMatch(pc *model.ProviderChannel, standardChannels []*model.Channel, lookup *channelLookup) (*model.Channel, float64, bool) {
+	matches := matchChannels(pc, standardChannels, lookup)
+	if len(matches) == 0 {
+		return nil, 0, false
+	}
+	return matches[0].channel, matches[0].score, isAmbiguous(matches)
 }
 
-func cleanChannelName(name string) string {
-	name = strings.ToLower(name)
-	name = strings.ReplaceAll(name, " ", "")
-	name = strings.ReplaceAll(name, "-", "")
-	name = strings.ReplaceAll(name, "_", "")
-	return name
+func (s *ChannelMappingService) rankCandidates(pc *model.ProviderChannel, standardChannels []*model.Channel, lookup *channelLookup, n int) []*model.MappingCandidate {
+	matches := matchChannels(pc, standardChannels, lookup)
+	ambiguous := isAmbiguous(matches)
+	if len(matches) > n {
+		matches = matches[:n]
+	}
+
+	candidates := make([]*model.MappingCandidate, 0, len(matches))
+	for _, m := range matches {
+		candidates = append(candidates, &model.MappingCandidate{
+			ChannelID:   m.channel.ChannelID,
+			DisplayName: m.channel.DisplayName,
+			Score:       m.score,
+			Ambiguous:   ambiguous && matches[0].score-m.score < matchAmbiguityMargin,
+		})
+	}
+
+	return candidates
 }
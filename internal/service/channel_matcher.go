@@ -0,0 +1,229 @@
+package service
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/pkg/utils"
+)
+
+const (
+	// two candidates closer than this are too close to call automatically
+	matchAmbiguityMargin = 0.05
+	// a differing channel number (CCTV1 vs CCTV10) halves the score
+	matchNumberPenalty = 0.5
+)
+
+var (
+	// suffixes that say nothing about which channel it is, longest first so
+	// 超高清 goes before 高清
+	channelNameSuffixes = []string{"超高清", "高清", "超清", "标清", "uhd", "hd", "频道", "电视台", "卫视"}
+
+	channelNamePunct  = strings.NewReplacer(" ", "", "-", "", "_", "", "·", "", ".", "", "(", "", ")", "")
+	chineseNumeralRun = regexp.MustCompile(`[零一二三四五六七八九十百两]+`)
+)
+
+type channelMatch struct {
+	channel  *model.Channel
+	score    float64
+	tieBreak float64
+}
+
+// matchChannels scores every canonical channel against the provider channel
+// and returns the matches best first. Scores are normalized to 0..1, ties are
+// broken by how close the raw names are before normalization, then by id.
+// Channel aliases from the lookup count as extra names, and a name the lookup
+// resolves outright scores 1.
+func matchChannels(pc *model.ProviderChannel, channels []*model.Channel, lookup *channelLookup) []*channelMatch {
+	names := append([]string{pc.Name}, pc.Aliases...)
+
+	resolved := make(map[string]bool)
+	if lookup != nil {
+		for _, name := range names {
+			if id := lookup.match(name); id != "" {
+				resolved[id] = true
+			}
+		}
+	}
+
+	matches := make([]*channelMatch, 0, len(channels))
+	for _, sc := range channels {
+		score := 0.0
+		if resolved[sc.ChannelID] || pc.ID != "" && strings.EqualFold(pc.ID, sc.ChannelID) {
+			score = 1
+		}
+
+		candidates := []string{sc.DisplayName, sc.ChannelID}
+		if lookup != nil {
+			candidates = append(candidates, lookup.names[sc.ChannelID]...)
+		}
+		for _, name := range names {
+			for _, candidate := range candidates {
+				score = max(score, channelNameSimilarity(name, candidate))
+			}
+		}
+		if score <= 0 {
+			continue
+		}
+
+		matches = append(matches, &channelMatch{
+			channel:  sc,
+			score:    score,
+			tieBreak: editSimilarity(strings.ToLower(pc.Name), strings.ToLower(sc.DisplayName)),
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		if matches[i].tieBreak != matches[j].tieBreak {
+			return matches[i].tieBreak > matches[j].tieBreak
+		}
+		return matches[i].channel.ChannelID < matches[j].channel.ChannelID
+	})
+
+	return matches
+}
+
+// isAmbiguous reports whether the best match cannot be told apart from the
+// runner-up.
+func isAmbiguous(matches []*channelMatch) bool {
+	return len(matches) > 1 && matches[0].score-matches[1].score < matchAmbiguityMargin
+}
+
+// channelNameSimilarity combines token overlap and edit distance over the
+// normalized names. Identical normalized names score 1.
+func channelNameSimilarity(a, b string) float64 {
+	na, nb := normalizeChannelName(a), normalizeChannelName(b)
+	if na == "" || nb == "" {
+		return 0
+	}
+	if na == nb {
+		return 1
+	}
+
+	ta, tb := channelNameTokens(na), channelNameTokens(nb)
+	score := (tokenSimilarity(ta, tb) + editSimilarity(na, nb)) / 2
+	if !slices.Equal(numberTokens(ta), numberTokens(tb)) {
+		score *= matchNumberPenalty
+	}
+	return score
+}
+
+// normalizeChannelName folds width, script and case, drops punctuation and
+// quality or type suffixes, and writes Chinese numerals as digits, so
+// "CCTV-1 高清" and "cctv一" both become "cctv1".
+func normalizeChannelName(name string) string {
+	name = strings.ToLower(utils.ToSimplified(utils.ToHalfWidth(name)))
+	name = channelNamePunct.Replace(name)
+
+	name = chineseNumeralRun.ReplaceAllStringFunc(name, func(s string) string {
+		if n, ok := utils.ParseChineseNumber(s); ok {
+			return strconv.Itoa(n)
+		}
+		return s
+	})
+
+	for stripped := true; stripped; {
+		stripped = false
+		for _, suffix := range channelNameSuffixes {
+			if trimmed := strings.TrimSuffix(name, suffix); trimmed != name && trimmed != "" {
+				name = trimmed
+				stripped = true
+			}
+		}
+	}
+
+	return name
+}
+
+// channelNameTokens splits a normalized name into latin words, numbers and
+// single CJK characters. Anything else, such as "+", is its own token.
+func channelNameTokens(name string) []string {
+	var tokens []string
+	runes := []rune(name)
+	for i := 0; i < len(runes); {
+		j := i + 1
+		switch {
+		case isLatin(runes[i]):
+			for j < len(runes) && isLatin(runes[j]) {
+				j++
+			}
+		case unicode.IsDigit(runes[i]):
+			for j < len(runes) && unicode.IsDigit(runes[j]) {
+				j++
+			}
+		}
+		tokens = append(tokens, string(runes[i:j]))
+		i = j
+	}
+	return tokens
+}
+
+func isLatin(r rune) bool {
+	return r < unicode.MaxASCII && unicode.IsLetter(r)
+}
+
+func numberTokens(tokens []string) []string {
+	var numbers []string
+	for _, t := range tokens {
+		if t[0] >= '0' && t[0] <= '9' {
+			numbers = append(numbers, t)
+		}
+	}
+	return numbers
+}
+
+// tokenSimilarity is the Jaccard index of the two token sets.
+func tokenSimilarity(a, b []string) float64 {
+	set := make(map[string]int)
+	for _, t := range a {
+		set[t] |= 1
+	}
+	for _, t := range b {
+		set[t] |= 2
+	}
+
+	shared := 0
+	for _, v := range set {
+		if v == 3 {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(set))
+}
+
+// editSimilarity is 1 minus the rune Levenshtein distance over the longer
+// length.
+func editSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return 1 - float64(prev[len(rb)])/float64(longest)
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/epg-sync/epgsync/internal/model"
+)
+
+// canonicalChannels are the national, CGTN and satellite channels of the
+// shipped database, where similar names make matching hardest.
+var canonicalChannels = []*model.Channel{
+	{ChannelID: "CCTV1", DisplayName: "CCTV-1 综合"},
+	{ChannelID: "CCTV2", DisplayName: "CCTV-2 财经"},
+	{ChannelID: "CCTV3", DisplayName: "CCTV-3 综艺"},
+	{ChannelID: "CCTV4", DisplayName: "CCTV-4 中文国际"},
+	{ChannelID: "CCTV5", DisplayName: "CCTV-5 体育"},
+	{ChannelID: "CCTV5+", DisplayName: "CCTV-5 体育赛事"},
+	{ChannelID: "CCTV6", DisplayName: "CCTV-6 电影"},
+	{ChannelID: "CCTV7", DisplayName: "CCTV-7 国防军事"},
+	{ChannelID: "CCTV8", DisplayName: "CCTV-8 电视剧"},
+	{ChannelID: "CCTV9", DisplayName: "CCTV-9 纪录"},
+	{ChannelID: "CCTV10", DisplayName: "CCTV-10 科教"},
+	{ChannelID: "CCTV11", DisplayName: "CCTV-11 戏曲"},
+	{ChannelID: "CCTV12", DisplayName: "CCTV-12 社会与法"},
+	{ChannelID: "CCTV13", DisplayName: "CCTV-13 新闻"},
+	{ChannelID: "CCTV14", DisplayName: "CCTV-14 少儿"},
+	{ChannelID: "CCTV15", DisplayName: "CCTV-15 音乐"},
+	{ChannelID: "CCTV16", DisplayName: "CCTV-16 奥林匹克"},
+	{ChannelID: "CCTV17", DisplayName: "CCTV-17 农业农村"},
+	{ChannelID: "CCTV4K", DisplayName: "CCTV-4K 超高清"},
+	{ChannelID: "CCTV8K", DisplayName: "CCTV-8K 超高清"},
+	{ChannelID: "CCTV4欧洲", DisplayName: "CCTV-4 欧洲"},
+	{ChannelID: "CCTV4美洲", DisplayName: "CCTV-4 美洲"},
+	{ChannelID: "CGTN", DisplayName: "CGTN"},
+	{ChannelID: "CGTN俄语", DisplayName: "CGTN俄语"},
+	{ChannelID: "CGTN西语", DisplayName: "CGTN西语"},
+	{ChannelID: "CGTN阿语", DisplayName: "CGTN阿语"},
+	{ChannelID: "CGTN法语", DisplayName: "CGTN法语"},
+	{ChannelID: "CGTN记录", DisplayName: "CGTN记录"},
+	{ChannelID: "CCTV风云剧场", DisplayName: "CCTV-风云剧场"},
+	{ChannelID: "CCTV第一剧场", DisplayName: "CCTV-第一剧场"},
+	{ChannelID: "CCTV怀旧剧场", DisplayName: "CCTV-怀旧剧场"},
+	{ChannelID: "CCTV世界地理", DisplayName: "CCTV-世界地理"},
+	{ChannelID: "CCTV风云音乐", DisplayName: "CCTV-风云音乐"},
+	{ChannelID: "CCTV兵器科技", DisplayName: "CCTV-兵器科技"},
+	{ChannelID: "CCTV风云足球", DisplayName: "CCTV-风云足球"},
+	{ChannelID: "CCTV高尔夫网球", DisplayName: "CCTV-高尔夫网球"},
+	{ChannelID: "CCTV女性时尚", DisplayName: "CCTV-女性时尚"},
+	{ChannelID: "CCTV央视文化精品", DisplayName: "CCTV-央视文化精品"},
+	{ChannelID: "CCTV央视台球", DisplayName: "CCTV-央视台球"},
+	{ChannelID: "CCTV电视指南", DisplayName: "CCTV-电视指南"},
+	{ChannelID: "CCTV卫生健康", DisplayName: "CCTV-卫生健康"},
+	{ChannelID: "北京卫视", DisplayName: "北京卫视"},
+	{ChannelID: "江苏卫视", DisplayName: "江苏卫视"},
+	{ChannelID: "东方卫视", DisplayName: "东方卫视"},
+	{ChannelID: "浙江卫视", DisplayName: "浙江卫视"},
+	{ChannelID: "湖南卫视", DisplayName: "湖南卫视"},
+	{ChannelID: "湖北卫视", DisplayName: "湖北卫视"},
+	{ChannelID: "广东卫视", DisplayName: "广东卫视"},
+	{ChannelID: "广西卫视", DisplayName: "广西卫视"},
+	{ChannelID: "黑龙江卫视", DisplayName: "黑龙江卫视"},
+	{ChannelID: "海南卫视", DisplayName: "海南卫视"},
+	{ChannelID: "重庆卫视", DisplayName: "重庆卫视"},
+	{ChannelID: "深圳卫视", DisplayName: "深圳卫视"},
+	{ChannelID: "四川卫视", DisplayName: "四川卫视"},
+	{ChannelID: "河南卫视", DisplayName: "河南卫视"},
+	{ChannelID: "东南卫视", DisplayName: "东南卫视"},
+	{ChannelID: "贵州卫视", DisplayName: "贵州卫视"},
+	{ChannelID: "江西卫视", DisplayName: "江西卫视"},
+	{ChannelID: "辽宁卫视", DisplayName: "辽宁卫视"},
+	{ChannelID: "安徽卫视", DisplayName: "安徽卫视"},
+	{ChannelID: "河北卫视", DisplayName: "河北卫视"},
+	{ChannelID: "山东卫视", DisplayName: "山东卫视"},
+	{ChannelID: "天津卫视", DisplayName: "天津卫视"},
+	{ChannelID: "吉林卫视", DisplayName: "吉林卫视"},
+	{ChannelID: "陕西卫视", DisplayName: "陕西卫视"},
+	{ChannelID: "宁夏卫视", DisplayName: "宁夏卫视"},
+	{ChannelID: "内蒙古卫视", DisplayName: "内蒙古卫视"},
+	{ChannelID: "云南卫视", DisplayName: "云南卫视"},
+	{ChannelID: "山西卫视", DisplayName: "山西卫视"},
+	{ChannelID: "青海卫视", DisplayName: "青海卫视"},
+	{ChannelID: "西藏卫视", DisplayName: "西藏卫视"},
+	{ChannelID: "新疆卫视", DisplayName: "新疆卫视"},
+	{ChannelID: "三沙卫视", DisplayName: "三沙卫视"},
+	{ChannelID: "延边卫视", DisplayName: "延边卫视"},
+	{ChannelID: "厦门卫视", DisplayName: "厦门卫视"},
+	{ChannelID: "兵团卫视", DisplayName: "兵团卫视"},
+	{ChannelID: "大湾区卫视", DisplayName: "大湾区卫视"},
+	{ChannelID: "海峡卫视", DisplayName: "海峡卫视"},
+	{ChannelID: "农林卫视", DisplayName: "中国农林卫视"},
+	{ChannelID: "CETV1", DisplayName: "CETV-1"},
+	{ChannelID: "凤凰中文", DisplayName: "凤凰卫视中文台"},
+	{ChannelID: "凤凰资讯", DisplayName: "凤凰卫视资讯台"},
+	{ChannelID: "凤凰香港", DisplayName: "凤凰卫视香港台"},
+	{ChannelID: "甘肃卫视", DisplayName: "甘肃卫视"},
+}
+
+func TestMatchChannels(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider *model.ProviderChannel
+		want     string
+	}{
+		// CCTV-5+ must not fall back to CCTV-5 and the other way round
+		{"cctv 5+ by id", &model.ProviderChannel{ID: "cctv5plus", Name: "CCTV-5体育赛事", Aliases: []string{"CCTV5+", "CCTV-5+", "CCTV5plus"}}, "CCTV5+"},
+		{"ysp 5+", &model.ProviderChannel{ID: "600001817", Name: "CCTV-5体育赛事", Aliases: []string{"CCTV5+", "CCTV-5+", "CCTV5plus"}}, "CCTV5+"},
+		{"5+ with quality suffix", &model.ProviderChannel{Name: "CCTV5+ 高清"}, "CCTV5+"},
+		{"5+ with name and HD", &model.ProviderChannel{Name: "CCTV-5+体育赛事HD"}, "CCTV5+"},
+		{"5 with quality suffix", &model.ProviderChannel{Name: "CCTV5 高清"}, "CCTV5"},
+
+		{"1 with HD", &model.ProviderChannel{Name: "CCTV-1 HD"}, "CCTV1"},
+		{"1 with name and 高清", &model.ProviderChannel{Name: "CCTV1综合高清"}, "CCTV1"},
+		{"full width", &model.ProviderChannel{Name: "ＣＣＴＶ－１３"}, "CCTV13"},
+		{"13 with name", &model.ProviderChannel{Name: "CCTV-13新闻"}, "CCTV13"},
+		{"10 not 1", &model.ProviderChannel{Name: "CCTV10 科教"}, "CCTV10"},
+		{"17 with name", &model.ProviderChannel{Name: "CCTV-17农业农村"}, "CCTV17"},
+		{"16 with name and HD", &model.ProviderChannel{Name: "CCTV-16奥林匹克HD"}, "CCTV16"},
+		{"8K not 8", &model.ProviderChannel{Name: "CCTV8K 超高清", Aliases: []string{"CCTV8K"}}, "CCTV8K"},
+		{"4K not 4", &model.ProviderChannel{Name: "CCTV4K超高清"}, "CCTV4K"},
+		{"4 asia", &model.ProviderChannel{ID: "600001814", Name: "CCTV-4中文国际", Aliases: []string{"CCTV4亚洲", "CCTV-4中文国际", "CCTV-4中文国际(亚)"}}, "CCTV4"},
+		{"4 europe", &model.ProviderChannel{ID: "cctveurope", Name: "CCTV-4 欧洲", Aliases: []string{"CCTV4欧洲", "CCTV-4中文国际欧洲", "CCTV-4中文国际(欧)"}}, "CCTV4欧洲"},
+		{"4 america", &model.ProviderChannel{ID: "cctvamerica", Name: "CCTV-4 美洲", Aliases: []string{"CCTV4美洲", "CCTV-4中文国际美洲", "CCTV-4中文国际(美)"}}, "CCTV4美洲"},
+		{"theme channel", &model.ProviderChannel{Name: "CCTV-风云剧场"}, "CCTV风云剧场"},
+		{"theme channel without prefix", &model.ProviderChannel{Name: "风云足球"}, "CCTV风云足球"},
+		{"cetv", &model.ProviderChannel{Name: "CETV-1"}, "CETV1"},
+
+		{"cgtn", &model.ProviderChannel{ID: "600014550", Name: "CGTN"}, "CGTN"},
+		{"cgtn french", &model.ProviderChannel{ID: "600084704", Name: "CGTN法语"}, "CGTN法语"},
+		{"cgtn russian", &model.ProviderChannel{ID: "600084758", Name: "CGTN俄语"}, "CGTN俄语"},
+		{"cgtn arabic with alias", &model.ProviderChannel{ID: "600084782", Name: "CGTN阿拉伯语", Aliases: []string{"CGTN阿语"}}, "CGTN阿语"},
+		{"cgtn arabic", &model.ProviderChannel{Name: "CGTN阿拉伯语"}, "CGTN阿语"},
+		{"cgtn spanish with alias", &model.ProviderChannel{ID: "600084744", Name: "CGTN西班牙语", Aliases: []string{"CGTN西语"}}, "CGTN西语"},
+		{"cgtn spanish", &model.ProviderChannel{Name: "CGTN西班牙语"}, "CGTN西语"},
+		{"cgtn documentary", &model.ProviderChannel{ID: "600084781", Name: "CGTN外语纪录", Aliases: []string{"CGTN记录"}}, "CGTN记录"},
+
+		{"satellite", &model.ProviderChannel{Name: "湖南卫视"}, "湖南卫视"},
+		{"satellite with 高清", &model.ProviderChannel{Name: "湖南卫视高清"}, "湖南卫视"},
+		{"satellite with HD", &model.ProviderChannel{Name: "湖南卫视 HD"}, "湖南卫视"},
+		{"satellite HD not neighbour", &model.ProviderChannel{Name: "湖北卫视HD"}, "湖北卫视"},
+		{"satellite with 超高清", &model.ProviderChannel{Name: "东方卫视超高清"}, "东方卫视"},
+		{"shanxi", &model.ProviderChannel{Name: "山西卫视"}, "山西卫视"},
+		{"shaanxi", &model.ProviderChannel{Name: "陕西卫视"}, "陕西卫视"},
+		{"jiangxi", &model.ProviderChannel{Name: "江西卫视"}, "江西卫视"},
+		{"guangxi", &model.ProviderChannel{Name: "广西卫视"}, "广西卫视"},
+		{"three characters", &model.ProviderChannel{Name: "黑龙江卫视"}, "黑龙江卫视"},
+		{"longer display name", &model.ProviderChannel{Name: "中国农林卫视"}, "农林卫视"},
+		{"phoenix chinese", &model.ProviderChannel{Name: "凤凰卫视中文台"}, "凤凰中文"},
+		{"phoenix info", &model.ProviderChannel{Name: "凤凰卫视资讯台"}, "凤凰资讯"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := matchChannels(tt.provider, canonicalChannels, nil)
+			if len(matches) == 0 {
+				t.Fatalf("%s: no match, want %s", tt.provider.Name, tt.want)
+			}
+			if got := matches[0].channel.ChannelID; got != tt.want {
+				t.Errorf("%s: matched %s (%.3f), want %s", tt.provider.Name, got, matches[0].score, tt.want)
+			}
+			if isAmbiguous(matches) {
+				t.Errorf("%s: %s (%.3f) and %s (%.3f) are too close to call", tt.provider.Name,
+					matches[0].channel.ChannelID, matches[0].score, matches[1].channel.ChannelID, matches[1].score)
+			}
+		})
+	}
+}
+
+func TestNormalizeChannelName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"CCTV-1 高清", "cctv1"},
+		{"cctv一", "cctv1"},
+		{"CCTV-5+ HD", "cctv5+"},
+		{"CCTV8K 超高清", "cctv8k"},
+		{"ＣＣＴＶ－１３", "cctv13"},
+		{"湖南卫视高清", "湖南"},
+		{"江苏卫视 HD", "江苏"},
+		{"南京新闻综合频道", "南京新闻综合"},
+		// a suffix is kept when nothing else is left
+		{"高清", "高清"},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeChannelName(tt.name); got != tt.want {
+			t.Errorf("normalizeChannelName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/epg-sync/epgsync/internal/cache"
 	"github.com/epg-sync/epgsync/internal/model"
@@ -13,21 +16,30 @@ import (
 type ChannelService struct {
 	channelRepo        repository.ChannelRepository
 	channelMappingRepo repository.ChannelMappingsRepository
+	aliasRepo          repository.ChannelAliasRepository
+	index              *ChannelIndex
 	cache              cache.Cache
 	chain              *provider.Chain
+	audit              *AuditService
 }
 
 func NewChannelService(
 	channelRepo repository.ChannelRepository,
 	channelMappingRepo repository.ChannelMappingsRepository,
+	aliasRepo repository.ChannelAliasRepository,
+	index *ChannelIndex,
 	cache cache.Cache,
 	chain *provider.Chain,
+	audit *AuditService,
 ) *ChannelService {
 	return &ChannelService{
 		channelRepo:        channelRepo,
 		channelMappingRepo: channelMappingRepo,
+		aliasRepo:          aliasRepo,
+		index:              index,
 		cache:              cache,
 		chain:              chain,
+		audit:              audit,
 	}
 }
 
@@ -56,6 +68,8 @@ func (s *ChannelService) CreateChannel(ctx context.Context, channel *model.Chann
 	if err := s.channelRepo.Create(ctx, channel); err != nil {
 		return nil, err
 	}
+	s.index.Invalidate()
+	s.audit.Record(ctx, model.AuditActionCreate, model.AuditResourceChannel, channel.ChannelID, nil, channel)
 
 	return channel, nil
 }
@@ -64,6 +78,10 @@ func (s *ChannelService) BatchCreateChannels(ctx context.Context, channels []*mo
 	if err := s.channelRepo.CreateBatch(ctx, channels); err != nil {
 		return nil, err
 	}
+	s.index.Invalidate()
+	for _, channel := range channels {
+		s.audit.Record(ctx, model.AuditActionCreate, model.AuditResourceChannel, channel.ChannelID, nil, channel)
+	}
 	return channels, nil
 }
 
@@ -76,17 +94,26 @@ func (s *ChannelService) ListAllChannels(ctx context.Context) ([]*model.Channel,
 }
 
 func (s *ChannelService) UpdateChannel(ctx context.Context, channel *model.Channel) error {
+	before, _ := s.channelRepo.GetByID(ctx, channel.ChannelID)
 	if err := s.channelRepo.Update(ctx, channel); err != nil {
 		return err
 	}
+	s.index.Invalidate()
+	s.audit.Record(ctx, model.AuditActionUpdate, model.AuditResourceChannel, channel.ChannelID, before, channel)
 
 	return nil
 }
 
 func (s *ChannelService) DeleteChannel(ctx context.Context, channelID string) error {
+	before, _ := s.channelRepo.GetByID(ctx, channelID)
 	if err := s.channelRepo.Delete(ctx, channelID); err != nil {
 		return err
 	}
+	if err := s.aliasRepo.DeleteByChannelID(ctx, channelID); err != nil {
+		return err
+	}
+	s.index.Invalidate()
+	s.audit.Record(ctx, model.AuditActionDelete, model.AuditResourceChannel, channelID, before, nil)
 
 	return nil
 }
@@ -105,3 +132,90 @@ func (s *ChannelService) ListAllChannelMappings(ctx context.Context) ([]*model.C
 func (s *ChannelService) GetChannelMappings(ctx context.Context, channelID string) ([]*model.ChannelMapping, error) {
 	return s.channelMappingRepo.GetByCanonicalID(ctx, channelID)
 }
+
+// LookupChannel resolves a channel id, display name or alias to a channel.
+func (s *ChannelService) LookupChannel(ctx context.Context, name string) (*model.Channel, error) {
+	return s.index.Lookup(ctx, name)
+}
+
+func (s *ChannelService) ListAliases(ctx context.Context, channelID string) ([]*model.ChannelAlias, error) {
+	if _, err := s.channelRepo.GetByID(ctx, channelID); err != nil {
+		return nil, err
+	}
+	return s.aliasRepo.ListByChannelID(ctx, channelID)
+}
+
+func (s *ChannelService) CreateAlias(ctx context.Context, alias *model.ChannelAlias) (*model.ChannelAlias, error) {
+	if err := validateChannelAlias(alias); err != nil {
+		return nil, err
+	}
+	if _, err := s.channelRepo.GetByID(ctx, alias.ChannelID); err != nil {
+		return nil, err
+	}
+	if err := s.aliasRepo.Create(ctx, alias); err != nil {
+		return nil, err
+	}
+	s.index.Invalidate()
+	s.audit.Record(ctx, model.AuditActionCreate, model.AuditResourceChannelAlias, strconv.FormatInt(alias.ID, 10), nil, alias)
+	return alias, nil
+}
+
+func (s *ChannelService) UpdateAlias(ctx context.Context, alias *model.ChannelAlias) (*model.ChannelAlias, error) {
+	existing, err := s.aliasRepo.GetByID(ctx, alias.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.ChannelID != alias.ChannelID {
+		return nil, errors.NotFound("channel alias", fmt.Sprintf("%d", alias.ID))
+	}
+	if err := validateChannelAlias(alias); err != nil {
+		return nil, err
+	}
+
+	before := *existing
+	existing.Alias = alias.Alias
+	existing.MatchType = alias.MatchType
+	if err := s.aliasRepo.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+	s.index.Invalidate()
+	s.audit.Record(ctx, model.AuditActionUpdate, model.AuditResourceChannelAlias, strconv.FormatInt(existing.ID, 10), &before, existing)
+	return existing, nil
+}
+
+func (s *ChannelService) DeleteAlias(ctx context.Context, channelID string, id int64) error {
+	existing, err := s.aliasRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing.ChannelID != channelID {
+		return errors.NotFound("channel alias", fmt.Sprintf("%d", id))
+	}
+	if err := s.aliasRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.index.Invalidate()
+	s.audit.Record(ctx, model.AuditActionDelete, model.AuditResourceChannelAlias, strconv.FormatInt(id, 10), existing, nil)
+	return nil
+}
+
+func validateChannelAlias(alias *model.ChannelAlias) error {
+	alias.Alias = strings.TrimSpace(alias.Alias)
+	if alias.Alias == "" {
+		return errors.InvalidParam("alias", "alias is required")
+	}
+
+	switch alias.MatchType {
+	case "":
+		alias.MatchType = model.AliasMatchExact
+	case model.AliasMatchExact, model.AliasMatchNormalized:
+	case model.AliasMatchRegex:
+		if _, err := compileAliasPattern(alias.Alias); err != nil {
+			return errors.InvalidParam("alias", err.Error())
+		}
+	default:
+		return errors.InvalidParam("match_type", fmt.Sprintf("unsupported match type %s", alias.MatchType))
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/epg-sync/epgsync/internal/model"
+)
+
+// ConfigService keeps the config the server currently runs with. Reloads
+// replace it; keys that need a restart keep their value from the start.
+type ConfigService struct {
+	mu              sync.RWMutex
+	cfg             *config.AppConfig
+	loadedAt        time.Time
+	restartRequired []string
+}
+
+func NewConfigService(cfg *config.AppConfig) *ConfigService {
+	return &ConfigService{
+		cfg:      cfg,
+		loadedAt: time.Now(),
+	}
+}
+
+// Current returns the effective config. It must not be modified.
+func (s *ConfigService) Current() *config.AppConfig {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cfg
+}
+
+// Update records the config applied by a reload and the keys of the file
+// that wait for a restart.
+func (s *ConfigService) Update(cfg *config.AppConfig, restartRequired []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cfg = cfg
+	s.loadedAt = time.Now()
+	s.restartRequired = restartRequired
+}
+
+// Effective returns the effective config with passwords, secrets and tokens
+// redacted.
+func (s *ConfigService) Effective() (*model.EffectiveConfig, error) {
+	s.mu.RLock()
+	cfg, loadedAt, restartRequired := s.cfg, s.loadedAt, s.restartRequired
+	s.mu.RUnlock()
+
+	tree, err := cfg.RedactedMap()
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := make(map[string]string)
+	for _, path := range cfg.EnvOverrides() {
+		overrides[path] = cfg.Source(path)
+	}
+
+	if restartRequired == nil {
+		restartRequired = []string{}
+	}
+	return &model.EffectiveConfig{
+		Path:            cfg.Path(),
+		LoadedAt:        loadedAt,
+		Config:          tree,
+		Overrides:       overrides,
+		RestartRequired: restartRequired,
+	}, nil
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/cache"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/provider"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/logger"
+)
+
+const (
+	programDetailCacheTTL = 7 * 24 * time.Hour
+	// a failed fetch is not tried again for this long, so a provider that is
+	// down is not asked again on every read
+	programDetailRetryAfter = time.Hour
+	programDetailWorkers    = 4
+)
+
+// DetailService fills description, image, cast and rating for programs whose
+// provider implements provider.ProgramDetailFetcher. Each program is fetched
+// once; results are cached by provider program so reruns of the same episode
+// on other days do not hit the provider again.
+type DetailService struct {
+	programRepo repository.ProgramRepository
+	cache       cache.Cache
+	chain       *provider.Chain
+
+	mu       sync.Mutex
+	inflight map[int64]bool
+}
+
+func NewDetailService(programRepo repository.ProgramRepository, cache cache.Cache, chain *provider.Chain) *DetailService {
+	return &DetailService{
+		programRepo: programRepo,
+		cache:       cache,
+		chain:       chain,
+		inflight:    make(map[int64]bool),
+	}
+}
+
+// fetcher looks the provider up on every call since the chain changes when
+// the config is reloaded.
+func (s *DetailService) fetcher(providerID string) provider.ProgramDetailFetcher {
+	p, ok := s.chain.GetProvider(providerID)
+	if !ok {
+		return nil
+	}
+	fetcher, _ := p.(provider.ProgramDetailFetcher)
+	return fetcher
+}
+
+// EnrichAsync fetches missing details in the background and stores them, so
+// the next read or XMLTV build carries them. It never waits; the programs
+// passed in are left untouched, and ones already queued are skipped.
+func (s *DetailService) EnrichAsync(programs []*model.Program) {
+	pending := s.pending(programs)
+	if len(pending) == 0 {
+		return
+	}
+
+	go func() {
+		s.enrich(context.Background(), pending)
+
+		s.mu.Lock()
+		for _, p := range pending {
+			delete(s.inflight, p.ID)
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// pending returns copies of the programs that still need details and marks
+// them in flight.
+func (s *DetailService) pending(programs []*model.Program) []*model.Program {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var pending []*model.Program
+	for _, p := range programs {
+		if p == nil || p.ID == 0 || p.DetailFetchedAt != nil || s.inflight[p.ID] {
+			continue
+		}
+		if s.fetcher(p.ProviderID) != nil {
+			s.inflight[p.ID] = true
+			copied := *p
+			pending = append(pending, &copied)
+		}
+	}
+	return pending
+}
+
+func (s *DetailService) enrich(ctx context.Context, programs []*model.Program) {
+	tasks := make(chan *model.Program)
+	var wg sync.WaitGroup
+
+	for range min(programDetailWorkers, len(programs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range tasks {
+				s.enrichOne(ctx, p)
+			}
+		}()
+	}
+
+	for _, p := range programs {
+		select {
+		case tasks <- p:
+		case <-ctx.Done():
+		}
+	}
+	close(tasks)
+	wg.Wait()
+}
+
+func (s *DetailService) enrichOne(ctx context.Context, p *model.Program) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	cacheKey := programDetailCacheKey(p)
+	var detail model.ProgramDetail
+	if err := s.cache.Get(ctx, cacheKey, &detail); err != nil {
+		if failed, _ := s.cache.Exists(ctx, cacheKey+":failed"); failed {
+			return
+		}
+
+		fetcher := s.fetcher(p.ProviderID)
+		if fetcher == nil {
+			// the provider was disabled since the program was queued
+			return
+		}
+		fetched, err := fetcher.FetchProgramDetail(ctx, p)
+		if err != nil {
+			logger.Debug("Failed to fetch program detail",
+				logger.String("provider_id", p.ProviderID),
+				logger.String("channel_id", p.ChannelID),
+				logger.String("title", p.Title),
+				logger.Err(err),
+			)
+			s.cache.Set(ctx, cacheKey+":failed", time.Now(), programDetailRetryAfter)
+			return
+		}
+		if fetched != nil {
+			detail = *fetched
+		}
+		s.cache.Set(ctx, cacheKey, &detail, programDetailCacheTTL)
+	}
+
+	now := time.Now()
+	if detail.Description != "" {
+		p.Description = detail.Description
+	}
+	if detail.ImageURL != "" {
+		p.ImageURL = detail.ImageURL
+	}
+	if len(detail.Cast) > 0 {
+		p.Cast = strings.Join(detail.Cast, ",")
+	}
+	if detail.Rating != "" {
+		p.Rating = detail.Rating
+	}
+	p.DetailFetchedAt = &now
+
+	if err := s.programRepo.UpdateDetail(ctx, p); err != nil {
+		logger.Warn("Failed to save program detail",
+			logger.Int64("program_id", p.ID),
+			logger.Err(err),
+		)
+	}
+}
+
+func programDetailCacheKey(p *model.Program) string {
+	if p.ProviderProgramID != "" {
+		return fmt.Sprintf("program_detail:%s:%s", p.ProviderID, p.ProviderProgramID)
+	}
+	return fmt.Sprintf("program_detail:%s:%s:%d", p.ProviderID, p.ChannelID, p.StartTime.Unix())
+}
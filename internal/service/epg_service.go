@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/epg-sync/epgsync/internal/cache"
+	"github.com/epg-sync/epgsync/internal/config"
 	"github.com/epg-sync/epgsync/internal/model"
 	"github.com/epg-sync/epgsync/internal/provider"
 	"github.com/epg-sync/epgsync/internal/repository"
@@ -20,6 +21,13 @@ type EPGService struct {
 	cache           cache.Cache
 	channelMappings repository.ChannelMappingsRepository
 	chain           *provider.Chain
+	changeFeed      *ChangeFeedService
+	quality         *QualityService
+	titles          *TitleService
+	categories      *CategoryService
+	details         *DetailService
+	channelIndex    *ChannelIndex
+	mappingCfg      config.MappingConfig
 }
 
 func NewEPGService(
@@ -28,6 +36,13 @@ func NewEPGService(
 	channelMappings repository.ChannelMappingsRepository,
 	cache cache.Cache,
 	chain *provider.Chain,
+	changeFeed *ChangeFeedService,
+	quality *QualityService,
+	titles *TitleService,
+	categories *CategoryService,
+	details *DetailService,
+	channelIndex *ChannelIndex,
+	mappingCfg config.MappingConfig,
 ) *EPGService {
 	return &EPGService{
 		programRepo:     programRepo,
@@ -35,6 +50,13 @@ func NewEPGService(
 		channelMappings: channelMappings,
 		cache:           cache,
 		chain:           chain,
+		changeFeed:      changeFeed,
+		quality:         quality,
+		titles:          titles,
+		categories:      categories,
+		details:         details,
+		channelIndex:    channelIndex,
+		mappingCfg:      mappingCfg,
 	}
 }
 
@@ -46,6 +68,9 @@ func (s *EPGService) GetEPG(ctx context.Context, channelID string, date time.Tim
 
 	programs, total, err := s.programRepo.ListByChannelIDAndDate(ctx, channelID, date, page, pageSize)
 	if err == nil && len(programs) > 0 {
+		if s.details != nil {
+			s.details.EnrichAsync(programs)
+		}
 		return programs, total, nil
 	}
 
@@ -73,6 +98,10 @@ func (s *EPGService) GetCurrentProgram(ctx context.Context, channelID string) (*
 		return nil, err
 	}
 
+	if s.details != nil {
+		s.details.EnrichAsync([]*model.Program{program})
+	}
+
 	return program, nil
 }
 
@@ -105,7 +134,7 @@ func (s *EPGService) SyncEPG(ctx context.Context, channelID string, startDate, e
 			continue
 		}
 
-		channelMaps, err := s.channelMappings.GetByCanonicalID(ctx, channelID)
+		channelMaps, err := s.channelMappings.ListSyncableByCanonicalID(ctx, channelID, s.mappingCfg.VerifiedOnly)
 
 		logger.Debug("Channel mappings fetched",
 			logger.String("channel_id", channelID),
@@ -127,16 +156,19 @@ func (s *EPGService) SyncEPG(ctx context.Context, channelID string, startDate, e
 			return errors.ChannelMappingNotFound(channelID)
 		}
 
-		var channelMappingInfos []*model.ChannelMappingInfo
-
-		for _, channelMap := range channelMaps {
-			channelMappingInfos = append(channelMappingInfos, &model.ChannelMappingInfo{
+		// try mappings one by one in priority order, the first provider that
+		// returns data wins
+		var programs []*model.Program
+		for _, channelMap := range rankMappings(channelMaps, s.chain.GetProviders()) {
+			programs, err = s.chain.FetchEPGParallel(ctx, []*model.ChannelMappingInfo{{
 				ProviderChannelID: channelMap.ProviderChannelID,
 				CanonicalID:       channelID,
 				ProviderID:        channelMap.ProviderID,
-			})
+			}}, date)
+			if err == nil {
+				break
+			}
 		}
-		programs, err := s.chain.FetchEPGParallel(ctx, channelMappingInfos, date)
 		if err != nil {
 			logger.Warn("Failed to fetch EPG",
 				logger.Err(err),
@@ -154,6 +186,19 @@ func (s *EPGService) SyncEPG(ctx context.Context, channelID string, startDate, e
 			continue
 		}
 
+		if s.titles != nil {
+			programs = s.titles.Normalize(programs)
+		}
+		programs = ExtractEpisode(programs)
+
+		if s.categories != nil {
+			programs = s.categories.Categorize(ctx, programs)
+		}
+
+		if s.quality != nil {
+			programs = s.quality.Inspect(ctx, date, programs)
+		}
+
 		if err := s.programRepo.CreateBatch(ctx, programs); err != nil {
 			logger.Warn("Failed to save EPG",
 				logger.Err(err),
@@ -163,6 +208,10 @@ func (s *EPGService) SyncEPG(ctx context.Context, channelID string, startDate, e
 			continue
 		}
 
+		if s.details != nil {
+			s.details.EnrichAsync(programs)
+		}
+
 		cacheKey := s.buildCacheKey(channelID, date)
 		s.cache.Delete(ctx, cacheKey)
 
@@ -194,13 +243,32 @@ func (s *EPGService) SyncEPGBatch(ctx context.Context, channelMappingInfos []*mo
 		s.cache.Delete(ctx, "xmltv_epg:"+date.Format("2006-01-02"))
 		programsToSave := make([]*model.Program, 0)
 		cmInfosToSync := make([]*model.ChannelMappingInfo, 0)
+		providerID := channelMappingInfos[0].ProviderID
+		var previousPrograms []*model.Program
 		if forceUpdate {
-			providerID := channelMappingInfos[0].ProviderID
+			// replace by channel, the stored programs may come from another
+			// provider than the one syncing now and would otherwise make
+			// the channel look synced below
+			channelIDs := make([]string, 0, len(channelMappingInfos))
+			for _, cmInfo := range channelMappingInfos {
+				channelIDs = append(channelIDs, cmInfo.CanonicalID)
+			}
+
+			previous, err := s.programRepo.ListByDateAndChannelIDs(ctx, date, channelIDs)
+			if err != nil {
+				logger.Warn("Failed to load existing EPG before update",
+					logger.Err(err),
+					logger.Time("date", date),
+					logger.String("providerID", providerID),
+				)
+			}
+			previousPrograms = previous
+
 			logger.Debug("Force update enabled, deleting existing EPG for date",
 				logger.Time("date", date),
-				logger.String("providerID", providerID),
+				logger.Int("channel_count", len(channelIDs)),
 			)
-			if err := s.programRepo.DeleteByDateAndProviderID(ctx, date, providerID); err != nil {
+			if err := s.programRepo.DeleteByDateAndChannelIDs(ctx, date, channelIDs); err != nil {
 				logger.Warn("Failed to delete existing EPG before update",
 					logger.Err(err),
 					logger.Time("date", date),
@@ -256,6 +324,19 @@ func (s *EPGService) SyncEPGBatch(ctx context.Context, channelMappingInfos []*mo
 			continue
 		}
 
+		if s.titles != nil {
+			programs = s.titles.Normalize(programs)
+		}
+		programs = ExtractEpisode(programs)
+
+		if s.categories != nil {
+			programs = s.categories.Categorize(ctx, programs)
+		}
+
+		if s.quality != nil {
+			programs = s.quality.Inspect(ctx, date, programs)
+		}
+
 		programsToSave = append(programsToSave, programs...)
 
 		if err := s.programRepo.CreateBatch(ctx, programsToSave); err != nil {
@@ -267,6 +348,20 @@ func (s *EPGService) SyncEPGBatch(ctx context.Context, channelMappingInfos []*mo
 			)
 			continue
 		}
+
+		if s.details != nil {
+			s.details.EnrichAsync(programsToSave)
+		}
+
+		if s.changeFeed != nil {
+			if _, err := s.changeFeed.RecordChanges(ctx, providerID, date, previousPrograms, programsToSave); err != nil {
+				logger.Warn("Failed to record EPG changes",
+					logger.Err(err),
+					logger.Time("date", date),
+					logger.String("providerID", providerID),
+				)
+			}
+		}
 	}
 
 	logger.Info("Synced EPG batch",
@@ -294,6 +389,21 @@ func (s *EPGService) CleanupOldEPG(ctx context.Context, keepDays int) (int64, er
 
 	logger.Info("Cleaned up old EPG", logger.Int64("count", count))
 
+	if s.quality != nil {
+		if _, err := s.quality.Cleanup(ctx, keepDays); err != nil {
+			logger.Warn("Failed to clean up old schedule reports", logger.Err(err))
+		}
+	}
+
+	if s.changeFeed != nil {
+		changes, err := s.changeFeed.Cleanup(ctx, keepDays)
+		if err != nil {
+			logger.Warn("Failed to clean up old EPG changes", logger.Err(err))
+		} else {
+			logger.Info("Cleaned up old EPG changes", logger.Int64("count", changes))
+		}
+	}
+
 	return count, nil
 }
 
@@ -362,7 +472,21 @@ func (s *EPGService) GenerateXMLTVFormatPrograms(ctx context.Context) (*model.XM
 			Start:   program.StartTime.In(location).Format("20060102150405 -0700"),
 			Stop:    program.EndTime.In(location).Format("20060102150405 -0700"),
 			Title:   program.Title,
+			Desc:    program.Description,
+		}
+		if program.ImageURL != "" {
+			xmltvProgram.Icon = &model.XMLTVIcon{Src: program.ImageURL}
+		}
+		if program.Cast != "" {
+			xmltvProgram.Credits = &model.XMLTVCredits{Actor: strings.Split(program.Cast, ",")}
+		}
+		if program.Rating != "" {
+			xmltvProgram.Rating = &model.XMLTVRating{Value: program.Rating}
+		}
+		if genre, ok := model.CategoryGenres[program.Category]; ok {
+			xmltvProgram.Category = []string{genre}
 		}
+		xmltvProgram.EpisodeNum = XMLTVEpisodeNums(program)
 		xmltvEPG.Programmes = append(xmltvEPG.Programmes, xmltvProgram)
 	}
 
@@ -382,7 +506,7 @@ func (s *EPGService) GenerateDIYPFormatPrograms(ctx context.Context, channelName
 
 	channelName = strings.TrimSpace(channelName)
 
-	channel, err := s.channelRepo.GetByChannelName(ctx, channelName)
+	channel, err := s.channelIndex.Lookup(ctx, channelName)
 
 	if err != nil {
 		logger.Error("Failed to get channel by name",
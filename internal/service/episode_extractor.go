@@ -0,0 +1,127 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/pkg/utils"
+)
+
+var (
+	// 亮剑 第2季 第15集, 亮剑第二季15
+	seasonEpisodePattern = regexp.MustCompile(`^(.*?)\s*第\s*([0-9一二三四五六七八九十两]+)\s*[季部]\s*[:：\-]?\s*第?\s*([0-9一二三四五六七八九十百零两]+)\s*[集期回话]?\s*$`)
+	// 亮剑 S02E15
+	sxxexxPattern = regexp.MustCompile(`(?i)^(.*?)\s*S(\d{1,2})\s*E(\d{1,4})\s*$`)
+	// 亮剑 第15集, 开讲啦 第120期
+	episodePattern = regexp.MustCompile(`^(.*?)\s*第\s*([0-9一二三四五六七八九十百零两]+)\s*[集期回话]\s*$`)
+	// 亮剑(23), 亮剑（23）, but not the year in 长津湖(2021)
+	bracketEpisodePattern = regexp.MustCompile(`^(.+?)\s*[(（]\s*(\d{1,4})\s*[)）]\s*$`)
+	// 新闻联播 2026-10-16, 新闻联播20261016
+	dateEpisodePattern = regexp.MustCompile(`^(.+?)\s*(\d{4})[-./年]?(\d{2})[-./月]?(\d{2})日?\s*$`)
+
+	seriesTrim = " \t-_:：·"
+)
+
+// ExtractEpisode parses series, season and episode from program titles and
+// stores them on the program. Titles are left untouched. An episode already
+// set by the title rules is kept and the remaining title becomes the series.
+func ExtractEpisode(programs []*model.Program) []*model.Program {
+	for _, p := range programs {
+		extractEpisode(p)
+	}
+	return programs
+}
+
+func extractEpisode(p *model.Program) {
+	title := strings.TrimSpace(p.Title)
+
+	if m := seasonEpisodePattern.FindStringSubmatch(title); m != nil {
+		season, ok1 := utils.ParseChineseNumber(m[2])
+		episode, ok2 := utils.ParseChineseNumber(m[3])
+		if ok1 && ok2 && episode > 0 {
+			setEpisode(p, m[1], season, episode)
+			return
+		}
+	}
+
+	if m := sxxexxPattern.FindStringSubmatch(title); m != nil {
+		season, _ := strconv.Atoi(m[2])
+		episode, _ := strconv.Atoi(m[3])
+		if episode > 0 {
+			setEpisode(p, m[1], season, episode)
+			return
+		}
+	}
+
+	if m := episodePattern.FindStringSubmatch(title); m != nil {
+		if episode, ok := utils.ParseChineseNumber(m[2]); ok && episode > 0 {
+			setEpisode(p, m[1], 0, episode)
+			return
+		}
+	}
+
+	if m := bracketEpisodePattern.FindStringSubmatch(title); m != nil {
+		if episode, _ := strconv.Atoi(m[2]); episode > 0 && !isYear(m[2]) {
+			setEpisode(p, m[1], 0, episode)
+			return
+		}
+	}
+
+	if m := dateEpisodePattern.FindStringSubmatch(title); m != nil {
+		series := strings.Trim(m[1], seriesTrim)
+		if _, err := time.Parse("20060102", m[2]+m[3]+m[4]); series != "" && err == nil {
+			p.Series = series
+			p.EpisodeLabel = fmt.Sprintf("%s-%s-%s", m[2], m[3], m[4])
+			return
+		}
+	}
+
+	if p.Episode > 0 {
+		setEpisode(p, title, p.Season, p.Episode)
+	}
+}
+
+// isYear reports whether a bracketed number is more likely the year of a
+// film or recording than an episode.
+func isYear(number string) bool {
+	year, err := strconv.Atoi(number)
+	return err == nil && len(number) == 4 && year >= 1900 && year <= 2100
+}
+
+func setEpisode(p *model.Program, series string, season, episode int) {
+	p.Series = strings.Trim(series, seriesTrim)
+	p.Season = season
+	p.Episode = episode
+	if season > 0 {
+		p.EpisodeLabel = fmt.Sprintf("S%02dE%02d", season, episode)
+	} else {
+		p.EpisodeLabel = fmt.Sprintf("E%02d", episode)
+	}
+}
+
+// XMLTVEpisodeNums renders the episode in the xmltv_ns form, which is zero
+// based as season.episode.part, and in the onscreen form.
+func XMLTVEpisodeNums(p *model.Program) []*model.XMLTVEpisodeNum {
+	var nums []*model.XMLTVEpisodeNum
+	if p.Episode > 0 {
+		season := ""
+		if p.Season > 0 {
+			season = strconv.Itoa(p.Season - 1)
+		}
+		nums = append(nums, &model.XMLTVEpisodeNum{
+			System: "xmltv_ns",
+			Value:  fmt.Sprintf("%s.%d.", season, p.Episode-1),
+		})
+	}
+	if p.EpisodeLabel != "" {
+		nums = append(nums, &model.XMLTVEpisodeNum{
+			System: "onscreen",
+			Value:  p.EpisodeLabel,
+		})
+	}
+	return nums
+}
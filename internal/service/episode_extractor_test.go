@@ -0,0 +1,104 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/epg-sync/epgsync/internal/model"
+)
+
+func TestExtractEpisode(t *testing.T) {
+	tests := []struct {
+		title   string
+		series  string
+		season  int
+		episode int
+		label   string
+	}{
+		// season and episode
+		{"亮剑 第2季 第15集", "亮剑", 2, 15, "S02E15"},
+		{"亮剑第二季15", "亮剑", 2, 15, "S02E15"},
+		{"庆余年 第二季：第一集", "庆余年", 2, 1, "S02E01"},
+		{"奔跑吧 第十部 第3期", "奔跑吧", 10, 3, "S10E03"},
+		{"Friends S01E02", "Friends", 1, 2, "S01E02"},
+		{"老友记 s10e17", "老友记", 10, 17, "S10E17"},
+
+		// episode only
+		{"亮剑 第15集", "亮剑", 0, 15, "E15"},
+		{"开讲啦 第120期", "开讲啦", 0, 120, "E120"},
+		{"红楼梦 第一百二十回", "红楼梦", 0, 120, "E120"},
+		{"星光大道 第十二期", "星光大道", 0, 12, "E12"},
+		{"人世间(23)", "人世间", 0, 23, "E23"},
+		{"人世间（23）", "人世间", 0, 23, "E23"},
+		{"电视剧：人世间 (5)", "电视剧：人世间", 0, 5, "E05"},
+
+		// dated episodes
+		{"新闻联播 2026-10-16", "新闻联播", 0, 0, "2026-10-16"},
+		{"新闻联播20261016", "新闻联播", 0, 0, "2026-10-16"},
+		{"焦点访谈 2026年10月16日", "焦点访谈", 0, 0, "2026-10-16"},
+		{"今日说法 2026.10.16", "今日说法", 0, 0, "2026-10-16"},
+
+		// not episodes
+		{"CCTV-1", "", 0, 0, ""},
+		{"CCTV-5+ 体育赛事", "", 0, 0, ""},
+		{"第一时间", "", 0, 0, ""},
+		{"第一动画乐园", "", 0, 0, ""},
+		{"复仇者联盟4", "", 0, 0, ""},
+		{"1949年的冬天", "", 0, 0, ""},
+		{"2026年世界杯预选赛", "", 0, 0, ""},
+		{"长津湖(2021)", "", 0, 0, ""},
+		{"新闻联播（1978）", "", 0, 0, ""},
+		{"体育新闻(重播)", "", 0, 0, ""},
+		{"第0集", "", 0, 0, ""},
+		{"亮剑 S01E00", "", 0, 0, ""},
+		{"今日说法 20261345", "", 0, 0, ""},
+		{"2026-10-16", "", 0, 0, ""},
+		{"中国新闻 2026", "", 0, 0, ""},
+	}
+
+	for _, tt := range tests {
+		p := &model.Program{Title: tt.title}
+		ExtractEpisode([]*model.Program{p})
+
+		if p.Series != tt.series || p.Season != tt.season || p.Episode != tt.episode || p.EpisodeLabel != tt.label {
+			t.Errorf("ExtractEpisode(%q) = %q S%d E%d %q, want %q S%d E%d %q",
+				tt.title, p.Series, p.Season, p.Episode, p.EpisodeLabel,
+				tt.series, tt.season, tt.episode, tt.label)
+		}
+		if p.Title != tt.title {
+			t.Errorf("ExtractEpisode(%q) changed the title to %q", tt.title, p.Title)
+		}
+	}
+}
+
+func TestExtractEpisodeKeepsRuleEpisode(t *testing.T) {
+	// a title rule already cut the episode off the title
+	p := &model.Program{Title: "亮剑", Episode: 5}
+	ExtractEpisode([]*model.Program{p})
+
+	if p.Series != "亮剑" || p.Episode != 5 || p.EpisodeLabel != "E05" {
+		t.Errorf("ExtractEpisode() = %q E%d %q, want 亮剑 E5 E05", p.Series, p.Episode, p.EpisodeLabel)
+	}
+}
+
+func TestXMLTVEpisodeNums(t *testing.T) {
+	tests := []struct {
+		program *model.Program
+		want    []string
+	}{
+		{&model.Program{Season: 2, Episode: 15, EpisodeLabel: "S02E15"}, []string{"xmltv_ns:1.14.", "onscreen:S02E15"}},
+		{&model.Program{Episode: 1, EpisodeLabel: "E01"}, []string{"xmltv_ns:.0.", "onscreen:E01"}},
+		{&model.Program{EpisodeLabel: "2026-10-16"}, []string{"onscreen:2026-10-16"}},
+		{&model.Program{}, nil},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, num := range XMLTVEpisodeNums(tt.program) {
+			got = append(got, num.System+":"+num.Value)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("XMLTVEpisodeNums(%+v) = %q, want %q", tt.program, got, tt.want)
+		}
+	}
+}
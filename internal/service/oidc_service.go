@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/epg-sync/epgsync/internal/cache"
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"github.com/epg-sync/epgsync/pkg/utils"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// how long the user has to finish the login at the provider
+	oidcStateTTL = 10 * time.Minute
+	// how long the login page has to trade its ticket for the tokens
+	oidcTicketTTL = time.Minute
+
+	oidcStateLength    = 32
+	oidcTicketLength   = 32
+	oidcRequestTimeout = 10 * time.Second
+)
+
+// oidcLogin is kept in the cache between the redirect to the provider and
+// the callback, keyed by the state parameter.
+type oidcLogin struct {
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+}
+
+// oidcTicket is kept in the cache between the callback and the login page
+// picking up its tokens.
+type oidcTicket struct {
+	Tokens *TokenPair `json:"tokens"`
+	UserID int64      `json:"user_id"`
+}
+
+// OIDCResult is the outcome of a callback. Ticket is set when a frontend
+// URL is configured and the tokens wait in the cache for the login page;
+// otherwise Tokens is set.
+type OIDCResult struct {
+	Tokens *TokenPair
+	Ticket string
+	User   *model.User
+}
+
+// OIDCService runs the authorization code flow with PKCE against an OpenID
+// Connect provider and signs the user in with the usual app tokens. The
+// provider is discovered on the first login rather than at startup, so an
+// unreachable provider does not keep the server from starting and the
+// discovery is retried on the next login.
+type OIDCService struct {
+	cfg          config.OIDCConfig
+	userRepo     repository.UserRepository
+	identityRepo repository.UserIdentityRepository
+	tokens       *TokenService
+	cache        cache.Cache
+	audit        *AuditService
+	client       *http.Client
+
+	mu       sync.Mutex
+	oauth    *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func NewOIDCService(cfg config.OIDCConfig, userRepo repository.UserRepository, identityRepo repository.UserIdentityRepository, tokens *TokenService, cache cache.Cache, audit *AuditService) *OIDCService {
+	return &OIDCService{
+		cfg:          cfg,
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		tokens:       tokens,
+		cache:        cache,
+		audit:        audit,
+		client:       &http.Client{Timeout: oidcRequestTimeout},
+	}
+}
+
+func oidcStateKey(state string) string {
+	return "auth:oidc_state:" + state
+}
+
+func oidcTicketKey(ticket string) string {
+	return "auth:oidc_ticket:" + ticket
+}
+
+func (s *OIDCService) Enabled() bool {
+	return s.cfg.Enabled
+}
+
+// discover fetches the provider metadata once and keeps it.
+func (s *OIDCService) discover(ctx context.Context) (*oauth2.Config, *oidc.IDTokenVerifier, error) {
+	if !s.cfg.Enabled {
+		return nil, nil, errors.New(errors.ErrCodeNotFound, "OIDC login is not enabled")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.oauth != nil {
+		return s.oauth, s.verifier, nil
+	}
+
+	provider, err := oidc.NewProvider(oidc.ClientContext(ctx, s.client), s.cfg.Issuer)
+	if err != nil {
+		logger.Error("OIDC discovery failed", logger.Err(err), logger.String("issuer", s.cfg.Issuer))
+		return nil, nil, errors.Wrap(err, errors.ErrCodeNetworkUnavailable, "OIDC provider is unavailable")
+	}
+
+	scopes := []string{oidc.ScopeOpenID}
+	for _, scope := range s.cfg.Scopes {
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	s.oauth = &oauth2.Config{
+		ClientID:     s.cfg.ClientID,
+		ClientSecret: s.cfg.ClientSecret,
+		RedirectURL:  s.cfg.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       scopes,
+	}
+	s.verifier = provider.Verifier(&oidc.Config{ClientID: s.cfg.ClientID})
+
+	logger.Info("OIDC provider discovered", logger.String("issuer", s.cfg.Issuer))
+	return s.oauth, s.verifier, nil
+}
+
+// AuthCodeURL 生成跳转到身份提供方的登录地址，state、nonce 和 PKCE verifier 暂存在缓存中
+func (s *OIDCService) AuthCodeURL(ctx context.Context) (string, error) {
+	oauth, _, err := s.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := utils.GenerateRandomString(oidcStateLength)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := utils.GenerateRandomString(oidcStateLength)
+	if err != nil {
+		return "", err
+	}
+	login := &oidcLogin{
+		Verifier: oauth2.GenerateVerifier(),
+		Nonce:    nonce,
+	}
+	if err := s.cache.Set(ctx, oidcStateKey(state), login, oidcStateTTL); err != nil {
+		return "", errors.Wrap(err, errors.ErrCodeCacheWriteFailed, "failed to store OIDC login state")
+	}
+
+	return oauth.AuthCodeURL(state, oauth2.S256ChallengeOption(login.Verifier), oidc.Nonce(nonce)), nil
+}
+
+// Callback 用授权码换取并校验 ID token，找到或创建对应的用户，然后签发应用自己的 token
+func (s *OIDCService) Callback(ctx context.Context, state, code, ip string) (*OIDCResult, error) {
+	oauth, verifier, err := s.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// a state is good for one callback only
+	var login oidcLogin
+	if state == "" || s.cache.Get(ctx, oidcStateKey(state), &login) != nil {
+		return nil, errors.New(errors.ErrCodeUnauthorized, "unknown or expired OIDC login, please start again")
+	}
+	if err := s.cache.Delete(ctx, oidcStateKey(state)); err != nil {
+		logger.Warn("Failed to delete OIDC login state", logger.Err(err))
+	}
+
+	ctx = oidc.ClientContext(ctx, s.client)
+	token, err := oauth.Exchange(ctx, code, oauth2.VerifierOption(login.Verifier))
+	if err != nil {
+		logger.Warn("OIDC code exchange failed", logger.Err(err), logger.String("ip", ip))
+		return nil, errors.New(errors.ErrCodeUnauthorized, "OIDC code exchange failed")
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New(errors.ErrCodeUnauthorized, "OIDC provider returned no id_token")
+	}
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		logger.Warn("OIDC id_token rejected", logger.Err(err), logger.String("ip", ip))
+		return nil, errors.New(errors.ErrCodeUnauthorized, "invalid OIDC id_token")
+	}
+	if idToken.Nonce != login.Nonce {
+		return nil, errors.New(errors.ErrCodeUnauthorized, "invalid OIDC id_token nonce")
+	}
+
+	var claims map[string]any
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeUnauthorized, "invalid OIDC id_token claims")
+	}
+
+	user, err := s.resolveUser(ctx, idToken.Issuer, idToken.Subject, claims, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.tokens.Issue(ctx, user, ip)
+	if err != nil {
+		return nil, err
+	}
+	ctx = WithAuditActor(ctx, AuditActor{UserID: user.ID, Username: user.Username, IP: ip})
+	s.audit.Record(ctx, model.AuditActionLogin, model.AuditResourceUser, strconv.FormatInt(user.ID, 10), nil, map[string]string{"method": "oidc"})
+
+	result := &OIDCResult{User: user}
+	if s.cfg.FrontendURL == "" {
+		result.Tokens = tokens
+		return result, nil
+	}
+
+	ticket, err := utils.GenerateRandomString(oidcTicketLength)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cache.Set(ctx, oidcTicketKey(ticket), &oidcTicket{Tokens: tokens, UserID: user.ID}, oidcTicketTTL); err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeCacheWriteFailed, "failed to store OIDC login ticket")
+	}
+	result.Ticket = ticket
+	return result, nil
+}
+
+// RedeemTicket 登录页用回调时拿到的一次性 ticket 换取 token
+func (s *OIDCService) RedeemTicket(ctx context.Context, ticket string) (*TokenPair, *model.User, error) {
+	var stored oidcTicket
+	if err := s.cache.Get(ctx, oidcTicketKey(ticket), &stored); err != nil || stored.Tokens == nil {
+		return nil, nil, errors.New(errors.ErrCodeUnauthorized, "unknown or expired login ticket")
+	}
+	if err := s.cache.Delete(ctx, oidcTicketKey(ticket)); err != nil {
+		logger.Warn("Failed to delete OIDC login ticket", logger.Err(err))
+	}
+
+	user, err := s.userRepo.GetByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stored.Tokens, user, nil
+}
+
+// FrontendURL is where the callback sends the browser, empty when the
+// callback answers with JSON.
+func (s *OIDCService) FrontendURL() string {
+	return s.cfg.FrontendURL
+}
+
+// resolveUser finds the user linked to the provider account, or creates one
+// on the first login. The role follows the role claim on every login, except
+// that the last active admin is not demoted.
+func (s *OIDCService) resolveUser(ctx context.Context, issuer, subject string, claims map[string]any, ip string) (*model.User, error) {
+	role := s.mapRole(claims)
+	if role == "" {
+		logger.Warn("OIDC login without a mapped role",
+			logger.String("subject", subject),
+			logger.String("ip", ip),
+		)
+		return nil, errors.New(errors.ErrCodeForbidden, "your account has no role in this application")
+	}
+
+	identity, err := s.identityRepo.GetBySubject(ctx, issuer, subject)
+	if err != nil && !errors.Is(err, errors.ErrCodeNotFound) {
+		return nil, err
+	}
+	if identity == nil {
+		return s.provision(ctx, issuer, subject, claims, role, ip)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, identity.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsActive != 1 {
+		return nil, errors.New(errors.ErrCodeForbidden, "user account is disabled")
+	}
+	if err := s.identityRepo.TouchLastLogin(ctx, identity.ID, time.Now()); err != nil {
+		logger.Warn("Failed to update OIDC last login", logger.Err(err), logger.Int64("user_id", user.ID))
+	}
+
+	if user.Role != role && isActiveAdmin(user) {
+		// the provider cannot demote the last active admin either; the
+		// account keeps its role until another admin exists
+		if err := ensureAnotherAdmin(ctx, s.userRepo); errors.Is(err, errors.ErrCodeForbidden) {
+			logger.Warn("OIDC role change would demote the last active admin, keeping the admin role",
+				logger.Int64("user_id", user.ID),
+				logger.String("role", role),
+			)
+			role = user.Role
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	if user.Role != role {
+		before := *user
+		user.Role = role
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+		ctx = WithAuditActor(ctx, AuditActor{UserID: user.ID, Username: user.Username, IP: ip})
+		s.audit.Record(ctx, model.AuditActionUpdate, model.AuditResourceUser, strconv.FormatInt(user.ID, 10), &before, user)
+		// tokens of other sessions still carry the old role
+		if err := s.tokens.RevokeUser(ctx, user.ID); err != nil {
+			logger.Warn("Failed to revoke sessions after OIDC role change", logger.Err(err), logger.Int64("user_id", user.ID))
+		}
+	}
+
+	return user, nil
+}
+
+func (s *OIDCService) provision(ctx context.Context, issuer, subject string, claims map[string]any, role, ip string) (*model.User, error) {
+	username, _ := claims[s.cfg.UsernameClaim].(string)
+	if username == "" {
+		return nil, errors.New(errors.ErrCodeForbidden, "OIDC id_token has no "+s.cfg.UsernameClaim+" claim")
+	}
+	// an existing local account is not taken over just because the name
+	// matches, an admin has to rename one of them
+	if existing, _ := s.userRepo.GetByUsername(ctx, username); existing != nil {
+		logger.Warn("OIDC login collides with an existing username",
+			logger.String("username", username),
+			logger.String("subject", subject),
+		)
+		return nil, errors.New(errors.ErrCodeAlreadyExists, "username already exists")
+	}
+
+	// the password is never handed out, so the account can only sign in
+	// through the provider until an admin resets it
+	password, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	email, _ := claims["email"].(string)
+	user := &model.User{
+		Username: username,
+		Password: hashedPassword,
+		Email:    email,
+		Role:     role,
+		IsActive: 1,
+	}
+	identity := &model.UserIdentity{
+		Issuer:  issuer,
+		Subject: subject,
+	}
+	if err := s.identityRepo.CreateWithUser(ctx, user, identity); err != nil {
+		return nil, err
+	}
+
+	logger.Info("User provisioned through OIDC",
+		logger.String("username", username),
+		logger.String("role", role),
+	)
+	ctx = WithAuditActor(ctx, AuditActor{UserID: user.ID, Username: user.Username, IP: ip})
+	s.audit.Record(ctx, model.AuditActionCreate, model.AuditResourceUser, strconv.FormatInt(user.ID, 10), nil, user)
+
+	return user, nil
+}
+
+// mapRole returns the most privileged role any value of the role claim maps
+// to, the default role when none does, or "" when the user gets no role.
+func (s *OIDCService) mapRole(claims map[string]any) string {
+	roles := model.Roles()
+	best := -1
+	for _, value := range claimValues(claims, s.cfg.RoleClaim) {
+		if i := slices.Index(roles, s.cfg.RoleMapping[value]); i > best {
+			best = i
+		}
+	}
+	if best >= 0 {
+		return roles[best]
+	}
+	return s.cfg.DefaultRole
+}
+
+// claimValues reads a string or list claim; a dotted name walks into
+// nested objects.
+func claimValues(claims map[string]any, name string) []string {
+	if name == "" {
+		return nil
+	}
+
+	var value any = claims
+	for _, part := range strings.Split(name, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[part]
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,439 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/cache"
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	mysql "github.com/epg-sync/epgsync/internal/repository/db"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+const (
+	testOIDCClientID = "epg-sync"
+	testOIDCKeyID    = "test-key"
+)
+
+// mockOIDCProvider serves discovery, JWKS and the token endpoint of an
+// OpenID Connect provider. The test decides what the id_token of each
+// authorization code says, as the login page of a real provider would.
+type mockOIDCProvider struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]*mockOIDCCode
+}
+
+// mockOIDCCode is an authorization code, bound to the PKCE challenge of the
+// login it was issued for.
+type mockOIDCCode struct {
+	challenge string
+	claims    map[string]any
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &mockOIDCProvider{t: t, key: key, codes: make(map[string]*mockOIDCCode)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/jwks", p.jwks)
+	mux.HandleFunc("/token", p.token)
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+func (p *mockOIDCProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.server.URL,
+		"authorization_endpoint":                p.server.URL + "/authorize",
+		"token_endpoint":                        p.server.URL + "/token",
+		"jwks_uri":                              p.server.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (p *mockOIDCProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	pub := p.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"kid": testOIDCKeyID,
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+// token exchanges a code for an id_token after checking the PKCE verifier
+// against the challenge the code was issued for.
+func (p *mockOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	p.mu.Lock()
+	code, ok := p.codes[r.Form.Get("code")]
+	delete(p.codes, r.Form.Get("code"))
+	p.mu.Unlock()
+	if !ok || pkceChallenge(r.Form.Get("code_verifier")) != code.challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": "provider-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     p.sign(code.claims),
+	})
+}
+
+// sign returns an RS256 id_token for the claims, with the registered claims
+// a provider always sets filled in.
+func (p *mockOIDCProvider) sign(claims map[string]any) string {
+	now := time.Now()
+	payload := map[string]any{
+		"iss": p.server.URL,
+		"aud": testOIDCClientID,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		payload[k] = v
+	}
+
+	segment := func(v any) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			p.t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	signingInput := segment(map[string]string{"alg": "RS256", "typ": "JWT", "kid": testOIDCKeyID}) + "." + segment(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// authorize stands in for the login page: it takes the authorization URL
+// the service redirected to and returns the state and a code whose id_token
+// carries the claims. The nonce of the request is added unless the claims
+// set one.
+func (p *mockOIDCProvider) authorize(authURL string, claims map[string]any) (state, code string) {
+	p.t.Helper()
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	query := u.Query()
+	if u.Path != "/authorize" || query.Get("client_id") != testOIDCClientID || query.Get("response_type") != "code" {
+		p.t.Fatalf("unexpected authorization URL %s", authURL)
+	}
+	if query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+		p.t.Fatalf("authorization URL without an S256 PKCE challenge: %s", authURL)
+	}
+
+	withNonce := map[string]any{"nonce": query.Get("nonce")}
+	for k, v := range claims {
+		withNonce[k] = v
+	}
+
+	code = "code-" + query.Get("state")
+	p.mu.Lock()
+	p.codes[code] = &mockOIDCCode{challenge: query.Get("code_challenge"), claims: withNonce}
+	p.mu.Unlock()
+	return query.Get("state"), code
+}
+
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+type oidcTestEnv struct {
+	provider *mockOIDCProvider
+	service  *OIDCService
+	users    repository.UserRepository
+}
+
+func newOIDCTestEnv(t *testing.T) *oidcTestEnv {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{SingularTable: true},
+		Logger:         logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&model.User{}, &model.UserIdentity{}, &model.RefreshToken{}, &model.AuditLog{}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	provider := newMockOIDCProvider(t)
+	users := mysql.NewUserRepository(db)
+	memoryCache := cache.NewMemoryCache()
+	tokens := NewTokenService(mysql.NewRefreshTokenRepository(db), users, memoryCache, config.ServerConfig{
+		JWTSecret:          "oidc-test-secret-oidc-test-secret",
+		JWTExpireHours:     1,
+		RefreshExpireHours: 24,
+	})
+	cfg := config.OIDCConfig{
+		Enabled:       true,
+		Issuer:        provider.server.URL,
+		ClientID:      testOIDCClientID,
+		ClientSecret:  "client-secret",
+		RedirectURL:   "http://epg.example.com/auth/oidc/callback",
+		UsernameClaim: "preferred_username",
+		RoleClaim:     "realm_access.roles",
+		RoleMapping: map[string]string{
+			"epg-admins":    model.RoleAdmin,
+			"epg-operators": model.RoleOperator,
+		},
+		DefaultRole: model.RoleViewer,
+	}
+	service := NewOIDCService(cfg, users, mysql.NewUserIdentityRepository(db), tokens, memoryCache, NewAuditService(mysql.NewAuditLogRepository(db)))
+
+	return &oidcTestEnv{provider: provider, service: service, users: users}
+}
+
+// login runs the whole flow for an account of the provider with the given
+// roles.
+func (env *oidcTestEnv) login(t *testing.T, subject string, roles ...string) (*OIDCResult, error) {
+	t.Helper()
+	return env.loginWithClaims(t, map[string]any{
+		"sub":                subject,
+		"preferred_username": subject,
+		"email":              subject + "@example.com",
+		"realm_access":       map[string]any{"roles": roles},
+	})
+}
+
+func (env *oidcTestEnv) loginWithClaims(t *testing.T, claims map[string]any) (*OIDCResult, error) {
+	t.Helper()
+
+	ctx := context.Background()
+	authURL, err := env.service.AuthCodeURL(ctx)
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	state, code := env.provider.authorize(authURL, claims)
+	return env.service.Callback(ctx, state, code, "127.0.0.1")
+}
+
+func TestOIDCLogin(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("provisions the user on the first login", func(t *testing.T) {
+		env := newOIDCTestEnv(t)
+
+		result, err := env.login(t, "alice", "staff", "epg-operators")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Tokens == nil || result.Tokens.AccessToken == "" {
+			t.Fatal("no app tokens without a frontend URL")
+		}
+		user, err := env.users.GetByUsername(ctx, "alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if user.ID != result.User.ID || user.Role != model.RoleOperator || user.Email != "alice@example.com" {
+			t.Errorf("provisioned user = %+v, want operator alice@example.com", user)
+		}
+
+		again, err := env.login(t, "alice", "epg-operators")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again.User.ID != user.ID {
+			t.Errorf("second login got user %d, want the linked user %d", again.User.ID, user.ID)
+		}
+	})
+
+	t.Run("maps roles", func(t *testing.T) {
+		env := newOIDCTestEnv(t)
+
+		tests := []struct {
+			subject string
+			roles   []string
+			want    string
+		}{
+			{"admin", []string{"epg-admins"}, model.RoleAdmin},
+			{"both", []string{"epg-operators", "epg-admins"}, model.RoleAdmin},
+			{"operator", []string{"epg-operators", "staff"}, model.RoleOperator},
+			{"unmapped", []string{"staff"}, model.RoleViewer},
+			{"none", nil, model.RoleViewer},
+		}
+		for _, tt := range tests {
+			result, err := env.login(t, tt.subject, tt.roles...)
+			if err != nil {
+				t.Errorf("%s: %v", tt.subject, err)
+				continue
+			}
+			if result.User.Role != tt.want {
+				t.Errorf("%s with %v got role %s, want %s", tt.subject, tt.roles, result.User.Role, tt.want)
+			}
+		}
+
+		env.service.cfg.DefaultRole = ""
+		if _, err := env.login(t, "nobody", "staff"); !errors.Is(err, errors.ErrCodeForbidden) {
+			t.Errorf("login without a mapped role or default role: %v, want forbidden", err)
+		}
+		if _, err := env.users.GetByUsername(ctx, "nobody"); err == nil {
+			t.Error("user without a role was provisioned")
+		}
+	})
+
+	t.Run("follows role changes at the provider", func(t *testing.T) {
+		env := newOIDCTestEnv(t)
+
+		if _, err := env.login(t, "root", "epg-admins"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := env.login(t, "alice", "epg-admins"); err != nil {
+			t.Fatal(err)
+		}
+		result, err := env.login(t, "alice", "epg-operators")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.User.Role != model.RoleOperator {
+			t.Errorf("role after the provider demoted alice = %s, want operator", result.User.Role)
+		}
+	})
+
+	t.Run("keeps the last active admin", func(t *testing.T) {
+		env := newOIDCTestEnv(t)
+
+		if _, err := env.login(t, "root", "epg-admins"); err != nil {
+			t.Fatal(err)
+		}
+		result, err := env.login(t, "root", "staff")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.User.Role != model.RoleAdmin {
+			t.Errorf("last active admin was demoted to %s", result.User.Role)
+		}
+
+		// once another admin exists the demotion goes through
+		if _, err := env.login(t, "alice", "epg-admins"); err != nil {
+			t.Fatal(err)
+		}
+		result, err = env.login(t, "root", "staff")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.User.Role != model.RoleViewer {
+			t.Errorf("role with another admin present = %s, want viewer", result.User.Role)
+		}
+	})
+
+	t.Run("rejects a nonce mismatch", func(t *testing.T) {
+		env := newOIDCTestEnv(t)
+
+		_, err := env.loginWithClaims(t, map[string]any{
+			"sub":                "mallory",
+			"preferred_username": "mallory",
+			"nonce":              "replayed-nonce",
+		})
+		if !errors.Is(err, errors.ErrCodeUnauthorized) || !strings.Contains(errors.GetMessage(err), "nonce") {
+			t.Errorf("callback with another nonce: %v, want the nonce rejected", err)
+		}
+		if _, err := env.users.GetByUsername(ctx, "mallory"); err == nil {
+			t.Error("user was provisioned from an id_token with the wrong nonce")
+		}
+	})
+
+	t.Run("binds the code to the PKCE verifier of its login", func(t *testing.T) {
+		env := newOIDCTestEnv(t)
+		claims := map[string]any{"sub": "alice", "preferred_username": "alice"}
+
+		victimURL, err := env.service.AuthCodeURL(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		attackerURL, err := env.service.AuthCodeURL(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, victimCode := env.provider.authorize(victimURL, claims)
+		attackerState, _ := env.provider.authorize(attackerURL, claims)
+
+		// a code injected into another login fails the PKCE check
+		if _, err := env.service.Callback(ctx, attackerState, victimCode, "127.0.0.1"); !errors.Is(err, errors.ErrCodeUnauthorized) {
+			t.Errorf("callback with the code of another login: %v, want unauthorized", err)
+		}
+		if _, err := env.users.GetByUsername(ctx, "alice"); err == nil {
+			t.Error("user was provisioned from a code of another login")
+		}
+	})
+
+	t.Run("accepts a state only once", func(t *testing.T) {
+		env := newOIDCTestEnv(t)
+
+		authURL, err := env.service.AuthCodeURL(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		state, code := env.provider.authorize(authURL, map[string]any{"sub": "alice", "preferred_username": "alice"})
+		if _, err := env.service.Callback(ctx, state, code, "127.0.0.1"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := env.service.Callback(ctx, state, code, "127.0.0.1"); !errors.Is(err, errors.ErrCodeUnauthorized) {
+			t.Errorf("second callback with the same state: %v, want unauthorized", err)
+		}
+	})
+}
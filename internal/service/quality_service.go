@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/provider"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/logger"
+)
+
+type QualityService struct {
+	reportRepo repository.ScheduleReportRepository
+	cfg        config.QualityConfig
+}
+
+func NewQualityService(reportRepo repository.ScheduleReportRepository, cfg config.QualityConfig) *QualityService {
+	return &QualityService{
+		reportRepo: reportRepo,
+		cfg:        cfg,
+	}
+}
+
+// Inspect validates freshly fetched programs per channel, stores one report
+// per channel and date, and returns the programs to persist. When auto repair
+// is enabled the returned programs have duplicates removed and overlaps and
+// gaps closed by moving end times to the next start.
+func (s *QualityService) Inspect(ctx context.Context, date time.Time, programs []*model.Program) []*model.Program {
+	if !s.cfg.Enabled || len(programs) == 0 {
+		return programs
+	}
+
+	var channelOrder []string
+	byChannel := make(map[string][]*model.Program)
+	for _, p := range programs {
+		if _, ok := byChannel[p.ChannelID]; !ok {
+			channelOrder = append(channelOrder, p.ChannelID)
+		}
+		byChannel[p.ChannelID] = append(byChannel[p.ChannelID], p)
+	}
+
+	dateStr := date.Format("2006-01-02")
+	result := make([]*model.Program, 0, len(programs))
+	reports := make([]*model.ScheduleReport, 0, len(channelOrder))
+
+	for _, channelID := range channelOrder {
+		channelPrograms := byChannel[channelID]
+		loc := provider.ProgramLocation(channelPrograms[0])
+		dayStart, err := time.ParseInLocation("2006-01-02", dateStr, loc)
+		if err != nil {
+			result = append(result, channelPrograms...)
+			continue
+		}
+
+		checked, report := s.validate(channelPrograms, dayStart)
+		report.ChannelID = channelID
+		report.ProviderID = channelPrograms[0].ProviderID
+		report.Date = dateStr
+		reports = append(reports, report)
+		result = append(result, checked...)
+
+		if report.IssueCount > 0 {
+			logger.Debug("Schedule quality issues found",
+				logger.String("channel_id", channelID),
+				logger.String("date", dateStr),
+				logger.Int("issues", report.IssueCount),
+				logger.Int("repaired", report.RepairCount),
+			)
+		}
+	}
+
+	if err := s.reportRepo.Save(ctx, reports); err != nil {
+		logger.Warn("Failed to save schedule reports", logger.Err(err), logger.String("date", dateStr))
+	}
+
+	return result
+}
+
+func (s *QualityService) ListReports(ctx context.Context, filter *repository.ScheduleReportFilter, page, pageSize int) ([]*model.ScheduleReport, int64, error) {
+	return s.reportRepo.List(ctx, filter, page, pageSize)
+}
+
+func (s *QualityService) Cleanup(ctx context.Context, keepDays int) (int64, error) {
+	return s.reportRepo.DeleteBefore(ctx, time.Now().AddDate(0, 0, -keepDays))
+}
+
+func (s *QualityService) validate(programs []*model.Program, dayStart time.Time) ([]*model.Program, *model.ScheduleReport) {
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	repair := s.cfg.AutoRepair
+	report := &model.ScheduleReport{Issues: []*model.ScheduleIssue{}}
+
+	sorted := make([]*model.Program, len(programs))
+	copy(sorted, programs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StartTime.Before(sorted[j].StartTime)
+	})
+
+	var kept []*model.Program
+	for _, p := range sorted {
+		if len(kept) > 0 && kept[len(kept)-1].StartTime.Equal(p.StartTime) {
+			prev := kept[len(kept)-1]
+			report.Issues = append(report.Issues, newScheduleIssue(model.IssueTypeDuplicate, model.IssueSeverityWarning, p,
+				fmt.Sprintf("starts at the same time as %q", prev.Title), repair))
+			continue
+		}
+		kept = append(kept, p)
+	}
+
+	if len(kept) > 0 && kept[0].StartTime.Sub(dayStart) > s.cfg.GapTolerance && kept[0].StartTime.Before(dayEnd) {
+		report.Issues = append(report.Issues, &model.ScheduleIssue{
+			Type:      model.IssueTypeGap,
+			Severity:  model.IssueSeverityWarning,
+			Message:   fmt.Sprintf("no program for %s at the start of the day", kept[0].StartTime.Sub(dayStart)),
+			StartTime: timePtr(dayStart),
+			EndTime:   timePtr(kept[0].StartTime),
+		})
+	}
+
+	var result []*model.Program
+	for i, p := range kept {
+		var next *model.Program
+		if i+1 < len(kept) {
+			next = kept[i+1]
+		}
+
+		if next != nil {
+			switch {
+			case next.StartTime.Before(p.EndTime):
+				report.Issues = append(report.Issues, newScheduleIssue(model.IssueTypeOverlap, model.IssueSeverityWarning, p,
+					fmt.Sprintf("overlaps %q by %s", next.Title, p.EndTime.Sub(next.StartTime)), repair))
+				if repair {
+					p.EndTime = next.StartTime
+				}
+			case next.StartTime.Sub(p.EndTime) > s.cfg.GapTolerance:
+				report.Issues = append(report.Issues, newScheduleIssue(model.IssueTypeGap, model.IssueSeverityWarning, p,
+					fmt.Sprintf("%s gap before %q", next.StartTime.Sub(p.EndTime), next.Title), repair))
+				if repair {
+					p.EndTime = next.StartTime
+				}
+			}
+		}
+
+		duration := p.EndTime.Sub(p.StartTime)
+		switch {
+		case duration <= 0:
+			repaired := repair && next == nil && p.StartTime.Before(dayEnd)
+			report.Issues = append(report.Issues, newScheduleIssue(model.IssueTypeTooShort, model.IssueSeverityWarning, p,
+				"program has no duration", repaired))
+			if repaired {
+				p.EndTime = dayEnd
+			} else if repair {
+				continue
+			}
+		case duration < s.cfg.MinDuration:
+			report.Issues = append(report.Issues, newScheduleIssue(model.IssueTypeTooShort, model.IssueSeverityWarning, p,
+				fmt.Sprintf("program lasts only %s", duration), false))
+		case duration > s.cfg.MaxDuration:
+			report.Issues = append(report.Issues, newScheduleIssue(model.IssueTypeTooLong, model.IssueSeverityWarning, p,
+				fmt.Sprintf("program lasts %s", duration), false))
+		}
+
+		if p.EndTime.After(dayEnd) && p.StartTime.Before(dayEnd) {
+			report.Issues = append(report.Issues, newScheduleIssue(model.IssueTypeCrossesMidnight, model.IssueSeverityInfo, p,
+				"program runs past midnight", false))
+		}
+
+		result = append(result, p)
+	}
+
+	if len(result) > 0 {
+		last := result[len(result)-1]
+		if dayEnd.Sub(last.EndTime) > s.cfg.GapTolerance {
+			report.Issues = append(report.Issues, &model.ScheduleIssue{
+				Type:      model.IssueTypeGap,
+				Severity:  model.IssueSeverityWarning,
+				Message:   fmt.Sprintf("no program for %s at the end of the day", dayEnd.Sub(last.EndTime)),
+				StartTime: timePtr(last.EndTime),
+				EndTime:   timePtr(dayEnd),
+			})
+		}
+	}
+
+	report.Coverage = scheduleCoverage(result, dayStart, dayEnd)
+	if report.Coverage < s.cfg.MinCoverage {
+		report.Issues = append(report.Issues, &model.ScheduleIssue{
+			Type:     model.IssueTypeLowCoverage,
+			Severity: model.IssueSeverityWarning,
+			Message:  fmt.Sprintf("programs cover %.0f%% of the day", report.Coverage*100),
+		})
+	}
+
+	report.IssueCount = len(report.Issues)
+	for _, issue := range report.Issues {
+		if issue.Repaired {
+			report.RepairCount++
+		}
+	}
+
+	if !repair {
+		result = programs
+	}
+	report.ProgramCount = len(result)
+	return result, report
+}
+
+func newScheduleIssue(issueType, severity string, p *model.Program, message string, repaired bool) *model.ScheduleIssue {
+	return &model.ScheduleIssue{
+		Type:      issueType,
+		Severity:  severity,
+		Message:   message,
+		Title:     p.Title,
+		StartTime: timePtr(p.StartTime),
+		EndTime:   timePtr(p.EndTime),
+		Repaired:  repaired,
+	}
+}
+
+// scheduleCoverage returns the fraction of [dayStart, dayEnd) covered by at
+// least one program. Programs must be sorted by start time.
+func scheduleCoverage(programs []*model.Program, dayStart, dayEnd time.Time) float64 {
+	var covered time.Duration
+	cursor := dayStart
+	for _, p := range programs {
+		start, end := p.StartTime, p.EndTime
+		if start.Before(cursor) {
+			start = cursor
+		}
+		if end.After(dayEnd) {
+			end = dayEnd
+		}
+		if end.After(start) {
+			covered += end.Sub(start)
+			cursor = end
+		}
+	}
+	return float64(covered) / float64(dayEnd.Sub(dayStart))
+}
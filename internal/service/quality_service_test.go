@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+)
+
+type recordingReportRepo struct {
+	repository.ScheduleReportRepository
+	saved []*model.ScheduleReport
+}
+
+func (r *recordingReportRepo) Save(_ context.Context, reports []*model.ScheduleReport) error {
+	r.saved = append(r.saved, reports...)
+	return nil
+}
+
+// qualityDay is the inspected date. Programs are given as "HH:MM-HH:MM" from
+// its midnight in Asia/Shanghai, where 24:00 and later are the next day.
+var qualityDay = time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+func slot(t *testing.T, span, title string) *model.Program {
+	t.Helper()
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip("time zone data is not available")
+	}
+	dayStart := time.Date(qualityDay.Year(), qualityDay.Month(), qualityDay.Day(), 0, 0, 0, 0, loc)
+
+	var startH, startM, endH, endM int
+	if _, err := fmt.Sscanf(span, "%d:%d-%d:%d", &startH, &startM, &endH, &endM); err != nil {
+		t.Fatalf("bad span %q: %v", span, err)
+	}
+	return &model.Program{
+		ChannelID:        "CCTV1",
+		ProviderID:       "ysp",
+		Title:            title,
+		StartTime:        dayStart.Add(time.Duration(startH)*time.Hour + time.Duration(startM)*time.Minute),
+		EndTime:          dayStart.Add(time.Duration(endH)*time.Hour + time.Duration(endM)*time.Minute),
+		OriginalTimezone: "Asia/Shanghai",
+	}
+}
+
+func describeSlots(programs []*model.Program) []string {
+	out := make([]string, len(programs))
+	for i, p := range programs {
+		out[i] = fmt.Sprintf("%s-%s %s", p.StartTime.Format("15:04"), p.EndTime.Format("15:04"), p.Title)
+	}
+	return out
+}
+
+func TestQualityServiceInspect(t *testing.T) {
+	cfg := config.QualityConfig{
+		Enabled:      true,
+		MinDuration:  5 * time.Minute,
+		MaxDuration:  8 * time.Hour,
+		GapTolerance: time.Minute,
+		MinCoverage:  0.8,
+	}
+
+	tests := []struct {
+		name         string
+		repair       bool
+		spans        [][2]string
+		wantIssues   []string
+		wantRepaired int
+		wantCoverage float64
+		wantPrograms []string
+	}{
+		{
+			name:         "complete day",
+			spans:        [][2]string{{"00:00-06:00", "A"}, {"06:00-12:00", "B"}, {"12:00-18:00", "C"}, {"18:00-24:00", "D"}},
+			wantIssues:   []string{},
+			wantCoverage: 1,
+			wantPrograms: []string{"00:00-06:00 A", "06:00-12:00 B", "12:00-18:00 C", "18:00-00:00 D"},
+		},
+		{
+			name:         "overlap is reported",
+			spans:        [][2]string{{"00:00-06:00", "A"}, {"06:00-12:30", "B"}, {"12:00-18:00", "C"}, {"18:00-24:00", "D"}},
+			wantIssues:   []string{model.IssueTypeOverlap},
+			wantCoverage: 1,
+			wantPrograms: []string{"00:00-06:00 A", "06:00-12:30 B", "12:00-18:00 C", "18:00-00:00 D"},
+		},
+		{
+			name:         "overlap is repaired",
+			repair:       true,
+			spans:        [][2]string{{"00:00-06:00", "A"}, {"06:00-12:30", "B"}, {"12:00-18:00", "C"}, {"18:00-24:00", "D"}},
+			wantIssues:   []string{model.IssueTypeOverlap},
+			wantRepaired: 1,
+			wantCoverage: 1,
+			wantPrograms: []string{"00:00-06:00 A", "06:00-12:00 B", "12:00-18:00 C", "18:00-00:00 D"},
+		},
+		{
+			name:         "gap is reported",
+			spans:        [][2]string{{"00:00-06:00", "A"}, {"06:00-10:00", "B"}, {"12:00-18:00", "C"}, {"18:00-24:00", "D"}},
+			wantIssues:   []string{model.IssueTypeGap},
+			wantCoverage: 22.0 / 24,
+			wantPrograms: []string{"00:00-06:00 A", "06:00-10:00 B", "12:00-18:00 C", "18:00-00:00 D"},
+		},
+		{
+			name:         "gap is closed",
+			repair:       true,
+			spans:        [][2]string{{"00:00-06:00", "A"}, {"06:00-10:00", "B"}, {"12:00-18:00", "C"}, {"18:00-24:00", "D"}},
+			wantIssues:   []string{model.IssueTypeGap},
+			wantRepaired: 1,
+			wantCoverage: 1,
+			wantPrograms: []string{"00:00-06:00 A", "06:00-12:00 B", "12:00-18:00 C", "18:00-00:00 D"},
+		},
+		{
+			name:         "gap within tolerance",
+			spans:        [][2]string{{"00:00-06:00", "A"}, {"06:00-12:00", "B"}, {"12:01-20:00", "C"}, {"20:00-24:00", "D"}},
+			wantIssues:   []string{},
+			wantCoverage: 1 - 1.0/(24*60),
+			wantPrograms: []string{"00:00-06:00 A", "06:00-12:00 B", "12:01-20:00 C", "20:00-00:00 D"},
+		},
+		{
+			name:   "short schedule",
+			spans:  [][2]string{{"20:00-21:00", "A"}, {"21:00-22:00", "B"}},
+			repair: true,
+			wantIssues: []string{
+				model.IssueTypeGap, model.IssueTypeGap, model.IssueTypeLowCoverage,
+			},
+			wantCoverage: 2.0 / 24,
+			wantPrograms: []string{"20:00-21:00 A", "21:00-22:00 B"},
+		},
+		{
+			name:         "duplicate start is dropped",
+			repair:       true,
+			spans:        [][2]string{{"00:00-08:00", "A"}, {"00:00-08:00", "A again"}, {"08:00-16:00", "B"}, {"16:00-24:00", "C"}},
+			wantIssues:   []string{model.IssueTypeDuplicate},
+			wantRepaired: 1,
+			wantCoverage: 1,
+			wantPrograms: []string{"00:00-08:00 A", "08:00-16:00 B", "16:00-00:00 C"},
+		},
+		{
+			name:         "too short and too long",
+			spans:        [][2]string{{"00:00-12:00", "A"}, {"12:00-12:02", "B"}, {"12:02-24:00", "C"}},
+			wantIssues:   []string{model.IssueTypeTooLong, model.IssueTypeTooShort, model.IssueTypeTooLong},
+			wantCoverage: 1,
+			wantPrograms: []string{"00:00-12:00 A", "12:00-12:02 B", "12:02-00:00 C"},
+		},
+		{
+			name:         "last program without end runs to midnight",
+			repair:       true,
+			spans:        [][2]string{{"00:00-08:00", "A"}, {"08:00-16:00", "B"}, {"16:00-16:00", "C"}},
+			wantIssues:   []string{model.IssueTypeTooShort},
+			wantRepaired: 1,
+			wantCoverage: 1,
+			wantPrograms: []string{"00:00-08:00 A", "08:00-16:00 B", "16:00-00:00 C"},
+		},
+		{
+			name:         "unsorted programs crossing midnight",
+			spans:        [][2]string{{"16:00-25:00", "C"}, {"00:00-08:00", "A"}, {"08:00-16:00", "B"}},
+			wantIssues:   []string{model.IssueTypeTooLong, model.IssueTypeCrossesMidnight},
+			wantCoverage: 1,
+			wantPrograms: []string{"16:00-01:00 C", "00:00-08:00 A", "08:00-16:00 B"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			programs := make([]*model.Program, len(tt.spans))
+			for i, s := range tt.spans {
+				programs[i] = slot(t, s[0], s[1])
+			}
+
+			repo := &recordingReportRepo{}
+			cfg := cfg
+			cfg.AutoRepair = tt.repair
+			got := NewQualityService(repo, cfg).Inspect(context.Background(), qualityDay, programs)
+
+			if slots := describeSlots(got); !slices.Equal(slots, tt.wantPrograms) {
+				t.Errorf("programs = %q, want %q", slots, tt.wantPrograms)
+			}
+			if len(repo.saved) != 1 {
+				t.Fatalf("saved %d reports, want 1", len(repo.saved))
+			}
+
+			report := repo.saved[0]
+			issues := make([]string, len(report.Issues))
+			for i, issue := range report.Issues {
+				issues[i] = issue.Type
+			}
+			if !slices.Equal(issues, tt.wantIssues) {
+				t.Errorf("issues = %q, want %q", issues, tt.wantIssues)
+			}
+			if report.IssueCount != len(tt.wantIssues) || report.RepairCount != tt.wantRepaired {
+				t.Errorf("issue count %d, repair count %d, want %d and %d",
+					report.IssueCount, report.RepairCount, len(tt.wantIssues), tt.wantRepaired)
+			}
+			if math.Abs(report.Coverage-tt.wantCoverage) > 1e-9 {
+				t.Errorf("coverage = %v, want %v", report.Coverage, tt.wantCoverage)
+			}
+			if report.ChannelID != "CCTV1" || report.ProviderID != "ysp" || report.Date != "2026-10-18" || report.ProgramCount != len(tt.wantPrograms) {
+				t.Errorf("report %+v is not for CCTV1, ysp and 2026-10-18 with %d programs", report, len(tt.wantPrograms))
+			}
+		})
+	}
+}
+
+func TestQualityServiceInspectDisabled(t *testing.T) {
+	repo := &recordingReportRepo{}
+	programs := []*model.Program{slot(t, "00:00-12:30", "A"), slot(t, "12:00-13:00", "B")}
+
+	got := NewQualityService(repo, config.QualityConfig{AutoRepair: true}).Inspect(context.Background(), qualityDay, programs)
+
+	if !slices.Equal(got, programs) || !got[0].EndTime.Equal(slot(t, "00:00-12:30", "A").EndTime) {
+		t.Error("disabled inspection changed the programs")
+	}
+	if len(repo.saved) != 0 {
+		t.Errorf("disabled inspection saved %d reports", len(repo.saved))
+	}
+}
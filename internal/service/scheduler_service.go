@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/epg-sync/epgsync/internal/cache"
+	"github.com/epg-sync/epgsync/internal/config"
 	"github.com/epg-sync/epgsync/internal/model"
 	"github.com/epg-sync/epgsync/internal/provider"
 	"github.com/epg-sync/epgsync/pkg/errors"
@@ -20,6 +22,7 @@ type SchedulerService struct {
 	channelService        *ChannelService
 	channelMappingService *ChannelMappingService
 	chain                 *provider.Chain
+	cfg                   config.SchedulerConfig
 	mu                    sync.RWMutex
 	jobs                  map[string]cron.EntryID
 }
@@ -30,6 +33,7 @@ func NewSchedulerService(
 	channelMappingService *ChannelMappingService,
 	chain *provider.Chain,
 	cache cache.Cache,
+	cfg config.SchedulerConfig,
 ) *SchedulerService {
 	return &SchedulerService{
 		cron:                  cron.New(),
@@ -37,6 +41,7 @@ func NewSchedulerService(
 		channelService:        channelService,
 		channelMappingService: channelMappingService,
 		chain:                 chain,
+		cfg:                   cfg,
 		jobs:                  make(map[string]cron.EntryID),
 		cache:                 cache,
 	}
@@ -45,17 +50,11 @@ func NewSchedulerService(
 func (s *SchedulerService) Start() error {
 	logger.Info("Starting scheduler service")
 
-	if _, err := s.AddJob("sync_epg_midnight", "1 0 * * *", s.syncEPGMidnight); err != nil {
-		return err
-	}
-
-	if _, err := s.AddJob("sync_epg_morning", "0 8 * * *", s.syncEPGMorning); err != nil {
-		return err
-	}
-
-	if _, err := s.AddJob("cleanup_old_epg", "0 4 * * *", s.cleanupOldEPG); err != nil {
+	schedule, err := ParseSchedule(s.cfg)
+	if err != nil {
 		return err
 	}
+	s.Reschedule(schedule)
 
 	s.cron.Start()
 	logger.Debug("Scheduler service started")
@@ -70,6 +69,72 @@ func (s *SchedulerService) Stop() {
 	logger.Info("Scheduler service stopped")
 }
 
+// Schedule is a parsed scheduler config, ready to be passed to Reschedule.
+// Jobs whose spec is off have no entry.
+type Schedule struct {
+	jobs map[string]cron.Schedule
+	spec map[string]string
+}
+
+// ParseSchedule parses the spec of every built-in job, so a config reload
+// can fail before any job is replaced.
+func ParseSchedule(cfg config.SchedulerConfig) (*Schedule, error) {
+	schedule := &Schedule{
+		jobs: make(map[string]cron.Schedule),
+		spec: make(map[string]string),
+	}
+	for _, name := range []string{config.JobSyncMidnight, config.JobSyncMorning, config.JobCleanup} {
+		spec := cfg.Jobs[name]
+		if spec == config.JobOff {
+			continue
+		}
+		parsed, err := cron.ParseStandard(spec)
+		if err != nil {
+			return nil, errors.InvalidParam("scheduler.jobs."+name, fmt.Sprintf("invalid cron spec %q: %v", spec, err))
+		}
+		schedule.jobs[name] = parsed
+		schedule.spec[name] = spec
+	}
+	return schedule, nil
+}
+
+// Reschedule sets the schedule of the built-in jobs, as on start or after a
+// config reload. Jobs whose spec is off are removed.
+func (s *SchedulerService) Reschedule(schedule *Schedule) {
+	jobs := []struct {
+		name string
+		run  func()
+	}{
+		{config.JobSyncMidnight, s.syncEPGMidnight},
+		{config.JobSyncMorning, s.syncEPGMorning},
+		{config.JobCleanup, s.cleanupOldEPG},
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, job := range jobs {
+		entryID, exists := s.jobs[job.name]
+		if exists {
+			s.cron.Remove(entryID)
+			delete(s.jobs, job.name)
+		}
+
+		parsed, ok := schedule.jobs[job.name]
+		if !ok {
+			if exists {
+				logger.Info("Removed job", logger.String("name", job.name))
+			}
+			continue
+		}
+		s.jobs[job.name] = s.cron.Schedule(parsed, cron.FuncJob(job.run))
+		logger.Info("Added job",
+			logger.String("name", job.name),
+			logger.String("spec", schedule.spec[job.name]),
+		)
+	}
+}
+
 func (s *SchedulerService) AddJob(name, spec string, cmd func()) (cron.EntryID, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -137,6 +202,11 @@ func (s *SchedulerService) SyncAllEPG(forceUpdate bool) {
 
 	providers := s.chain.GetProviders()
 
+	// rank every channel's mappings by priority. The first pass syncs each
+	// channel from its preferred mapping, later passes only fill channels
+	// that are still empty, so they never force an update.
+	byRank := make(map[int]map[string][]*model.ChannelMappingInfo)
+	byChannel := make(map[string][]*model.ChannelMapping)
 	for _, p := range providers {
 		channelMappings, err := s.channelMappingService.ListChannels(ctx, p.GetID())
 		if err != nil {
@@ -146,28 +216,46 @@ func (s *SchedulerService) SyncAllEPG(forceUpdate bool) {
 			)
 			continue
 		}
-		var channelMappingInfos []*model.ChannelMappingInfo
-
+		if len(channelMappings) == 0 {
+			logger.Info("No channel mappings found for provider, skipping EPG sync",
+				logger.String("provider_id", p.GetID()),
+			)
+			continue
+		}
 		for _, cm := range channelMappings {
-			channelMappingInfos = append(channelMappingInfos, &model.ChannelMappingInfo{
+			byChannel[cm.CanonicalID] = append(byChannel[cm.CanonicalID], cm)
+		}
+	}
+
+	maxRank := 0
+	for _, mappings := range byChannel {
+		for rank, cm := range s.channelMappingService.RankMappings(mappings) {
+			if byRank[rank] == nil {
+				byRank[rank] = make(map[string][]*model.ChannelMappingInfo)
+			}
+			byRank[rank][cm.ProviderID] = append(byRank[rank][cm.ProviderID], &model.ChannelMappingInfo{
 				ProviderChannelID: cm.ProviderChannelID,
 				CanonicalID:       cm.CanonicalID,
 				ProviderID:        cm.ProviderID,
 			})
+			maxRank = max(maxRank, rank)
 		}
+	}
 
-		if len(channelMappingInfos) == 0 {
-			logger.Info("No channel mappings found for provider, skipping EPG sync",
-				logger.String("provider_id", p.GetID()),
-			)
-			continue
-		}
-
-		if err := s.epgService.SyncEPGBatch(ctx, channelMappingInfos, startDate, endDate, forceUpdate); err != nil {
-			logger.Error("Failed to sync EPG batch",
-				logger.Err(err),
-				logger.String("provider_id", p.GetID()),
-			)
+	for rank := 0; rank <= maxRank; rank++ {
+		for _, p := range providers {
+			channelMappingInfos := byRank[rank][p.GetID()]
+			if len(channelMappingInfos) == 0 {
+				continue
+			}
+
+			if err := s.epgService.SyncEPGBatch(ctx, channelMappingInfos, startDate, endDate, forceUpdate && rank == 0); err != nil {
+				logger.Error("Failed to sync EPG batch",
+					logger.Err(err),
+					logger.String("provider_id", p.GetID()),
+					logger.Int("rank", rank),
+				)
+			}
 		}
 	}
 
@@ -197,11 +285,11 @@ func (s *SchedulerService) RunNow(name string) error {
 	}
 
 	switch name {
-	case "sync_epg_midnight":
+	case config.JobSyncMidnight:
 		go s.syncEPGMidnight()
-	case "sync_epg_morning":
+	case config.JobSyncMorning:
 		go s.syncEPGMorning()
-	case "cleanup_old_epg":
+	case config.JobCleanup:
 		go s.cleanupOldEPG()
 	default:
 		return errors.InvalidParam("name", "unknown job name")
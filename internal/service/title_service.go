@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/utils"
+)
+
+var spaceRun = regexp.MustCompile(`\s+`)
+
+type titleRule struct {
+	model.TitleRule
+	re *regexp.Regexp
+}
+
+type TitleService struct {
+	programRepo repository.ProgramRepository
+	enabled     bool
+	global      []*titleRule
+
+	mu         sync.RWMutex
+	byProvider map[string][]*titleRule
+}
+
+func NewTitleService(programRepo repository.ProgramRepository, cfg config.TitleConfig, providers []model.ProviderConfig) (*TitleService, error) {
+	global, err := compileTitleRules(cfg.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	byProvider, err := compileProviderTitleRules(providers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TitleService{
+		programRepo: programRepo,
+		enabled:     cfg.Enabled,
+		global:      global,
+		byProvider:  byProvider,
+	}, nil
+}
+
+// Normalize rewrites program titles in place with the rules of the program's
+// provider followed by the global rules. Provider rules clean up what a
+// source is known to add and run even when the global chain is disabled.
+func (s *TitleService) Normalize(programs []*model.Program) []*model.Program {
+	for _, p := range programs {
+		rules := s.rulesFor(p.ProviderID)
+		if !s.enabled {
+			rules = s.providerRules(p.ProviderID)
+		}
+		if len(rules) == 0 {
+			continue
+		}
+		title, episode, _ := applyTitleRules(p.Title, rules, false)
+		p.Title = title
+		if episode > 0 {
+			p.Episode = episode
+		}
+	}
+
+	return programs
+}
+
+// DryRun shows how each rule changes the given titles, or the most recent
+// stored titles when none are given. Rules, when given, replace the
+// configured chain so edits can be tried before they go into the config.
+func (s *TitleService) DryRun(ctx context.Context, providerID string, titles []string, rules []model.TitleRule, limit int) ([]*model.TitleDryRunResult, error) {
+	var candidates []*titleRule
+	if len(rules) > 0 {
+		compiled, err := compileTitleRules(rules)
+		if err != nil {
+			return nil, err
+		}
+		candidates = compiled
+	}
+
+	type sample struct {
+		providerID string
+		title      string
+	}
+	var samples []sample
+	if len(titles) > 0 {
+		for _, title := range titles {
+			samples = append(samples, sample{providerID: providerID, title: title})
+		}
+	} else {
+		programs, err := s.programRepo.ListRecent(ctx, providerID, limit)
+		if err != nil {
+			return nil, err
+		}
+		seen := make(map[string]bool)
+		for _, p := range programs {
+			key := p.ProviderID + "\x00" + p.Title
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			samples = append(samples, sample{providerID: p.ProviderID, title: p.Title})
+		}
+	}
+
+	results := make([]*model.TitleDryRunResult, 0, len(samples))
+	for _, smp := range samples {
+		chain := candidates
+		if chain == nil {
+			chain = s.rulesFor(smp.providerID)
+		}
+		title, episode, steps := applyTitleRules(smp.title, chain, true)
+		results = append(results, &model.TitleDryRunResult{
+			ProviderID: smp.providerID,
+			Original:   smp.title,
+			Result:     title,
+			Episode:    episode,
+			Steps:      steps,
+		})
+	}
+
+	return results, nil
+}
+
+// ProviderTitleRules are the compiled title rules of each provider, ready to
+// be passed to SetProviderRules.
+type ProviderTitleRules struct {
+	byProvider map[string][]*titleRule
+}
+
+// CompileProviderTitleRules compiles the title rules of providers, so a
+// config reload can fail before anything is replaced.
+func CompileProviderTitleRules(providers []model.ProviderConfig) (*ProviderTitleRules, error) {
+	byProvider, err := compileProviderTitleRules(providers)
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderTitleRules{byProvider: byProvider}, nil
+}
+
+// SetProviderRules replaces the per provider rules after a config reload.
+func (s *TitleService) SetProviderRules(rules *ProviderTitleRules) {
+	s.mu.Lock()
+	s.byProvider = rules.byProvider
+	s.mu.Unlock()
+}
+
+func compileProviderTitleRules(providers []model.ProviderConfig) (map[string][]*titleRule, error) {
+	byProvider := make(map[string][]*titleRule)
+	for _, p := range providers {
+		rules, err := compileTitleRules(p.TitleRules)
+		if err != nil {
+			return nil, err
+		}
+		if len(rules) > 0 {
+			byProvider[p.ID] = rules
+		}
+	}
+	return byProvider, nil
+}
+
+func (s *TitleService) providerRules(providerID string) []*titleRule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.byProvider[providerID]
+}
+
+func (s *TitleService) rulesFor(providerID string) []*titleRule {
+	providerRules := s.providerRules(providerID)
+	if len(providerRules) == 0 {
+		return s.global
+	}
+	rules := make([]*titleRule, 0, len(providerRules)+len(s.global))
+	rules = append(rules, providerRules...)
+	return append(rules, s.global...)
+}
+
+func applyTitleRules(title string, rules []*titleRule, trace bool) (string, int, []*model.TitleRuleStep) {
+	var steps []*model.TitleRuleStep
+	episode := 0
+
+	for i, rule := range rules {
+		before := title
+		ruleEpisode := 0
+
+		switch rule.Type {
+		case model.TitleRuleRegexReplace:
+			title = rule.re.ReplaceAllString(title, rule.Replace)
+		case model.TitleRuleTrim:
+			title = strings.TrimSpace(spaceRun.ReplaceAllString(title, " "))
+		case model.TitleRuleHalfWidth:
+			title = utils.ToHalfWidth(title)
+		case model.TitleRuleSimplified:
+			title = utils.ToSimplified(title)
+		case model.TitleRuleEpisode:
+			if m := rule.re.FindStringSubmatchIndex(title); m != nil && m[2] >= 0 {
+				if n, err := strconv.Atoi(title[m[2]:m[3]]); err == nil && n > 0 {
+					ruleEpisode = n
+					episode = n
+					title = strings.TrimSpace(title[:m[0]] + title[m[1]:])
+				}
+			}
+		}
+
+		if trace {
+			name := rule.Name
+			if name == "" {
+				name = fmt.Sprintf("%d:%s", i+1, rule.Type)
+			}
+			steps = append(steps, &model.TitleRuleStep{
+				Rule:    name,
+				Title:   title,
+				Episode: ruleEpisode,
+				Changed: title != before,
+			})
+		}
+	}
+
+	return title, episode, steps
+}
+
+func compileTitleRules(rules []model.TitleRule) ([]*titleRule, error) {
+	compiled := make([]*titleRule, 0, len(rules))
+	for i, rule := range rules {
+		re, err := rule.Compile()
+		if err != nil {
+			return nil, errors.InvalidParam("rules", fmt.Sprintf("rule %d: %v", i+1, err))
+		}
+		compiled = append(compiled, &titleRule{TitleRule: rule, re: re})
+	}
+	return compiled, nil
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/cache"
+	"github.com/epg-sync/epgsync/internal/config"
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"github.com/epg-sync/epgsync/pkg/utils"
+)
+
+const (
+	refreshTokenLength = 48
+	sessionIDLength    = 24
+)
+
+// TokenPair is what a login or refresh hands to the client.
+type TokenPair struct {
+	AccessToken      string    `json:"token"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	RefreshToken     string    `json:"refresh_token"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+}
+
+// TokenService issues short-lived access tokens and the rotating refresh
+// tokens behind them. Refresh tokens live in the database; revoked access
+// tokens and sessions are blacklisted in the cache until the access tokens
+// they cover have expired.
+type TokenService struct {
+	refreshRepo repository.RefreshTokenRepository
+	userRepo    repository.UserRepository
+	cache       cache.Cache
+	jwtSecret   string
+	accessTTL   time.Duration
+	refreshTTL  time.Duration
+}
+
+func NewTokenService(refreshRepo repository.RefreshTokenRepository, userRepo repository.UserRepository, cache cache.Cache, cfg config.ServerConfig) *TokenService {
+	return &TokenService{
+		refreshRepo: refreshRepo,
+		userRepo:    userRepo,
+		cache:       cache,
+		jwtSecret:   cfg.JWTSecret,
+		accessTTL:   time.Duration(cfg.JWTExpireHours) * time.Hour,
+		refreshTTL:  time.Duration(cfg.RefreshExpireHours) * time.Hour,
+	}
+}
+
+func revokedTokenKey(tokenID string) string {
+	return "auth:revoked_token:" + tokenID
+}
+
+func revokedSessionKey(sessionID string) string {
+	return "auth:revoked_session:" + sessionID
+}
+
+// Issue 为新登录创建会话，返回 access token 和 refresh token
+func (s *TokenService) Issue(ctx context.Context, user *model.User, ip string) (*TokenPair, error) {
+	if err := s.refreshRepo.DeleteExpired(ctx, user.ID, time.Now()); err != nil {
+		logger.Warn("Failed to delete expired refresh tokens", logger.Err(err), logger.Int64("user_id", user.ID))
+	}
+
+	sessionID, err := utils.GenerateRandomString(sessionIDLength)
+	if err != nil {
+		return nil, err
+	}
+	return s.issue(ctx, user, sessionID, ip)
+}
+
+func (s *TokenService) issue(ctx context.Context, user *model.User, sessionID, ip string) (*TokenPair, error) {
+	now := time.Now()
+
+	accessToken, err := utils.GenerateToken(user.ID, user.Username, user.Role, sessionID, s.jwtSecret, s.accessTTL)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeUnknown, "failed to generate token")
+	}
+
+	refreshToken, err := utils.GenerateRandomString(refreshTokenLength)
+	if err != nil {
+		return nil, err
+	}
+	row := &model.RefreshToken{
+		UserID:    user.ID,
+		SessionID: sessionID,
+		TokenHash: utils.HashToken(refreshToken),
+		ExpiresAt: now.Add(s.refreshTTL),
+		CreatedIP: ip,
+	}
+	if err := s.refreshRepo.Create(ctx, row); err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:      accessToken,
+		ExpiresAt:        now.Add(s.accessTTL),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: row.ExpiresAt,
+	}, nil
+}
+
+// Refresh 用 refresh token 换取新的一对 token，旧的 refresh token 随即失效。
+// 已失效的 refresh token 再次出现说明它可能已泄露，整个会话都会被撤销
+func (s *TokenService) Refresh(ctx context.Context, refreshToken, ip string) (*TokenPair, *model.User, error) {
+	invalid := errors.New(errors.ErrCodeUnauthorized, "invalid refresh token")
+
+	row, err := s.refreshRepo.GetByHash(ctx, utils.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, errors.ErrCodeNotFound) {
+			return nil, nil, invalid
+		}
+		return nil, nil, err
+	}
+
+	if row.RevokedAt != nil {
+		s.revokeReusedSession(ctx, row, ip)
+		return nil, nil, invalid
+	}
+	if !time.Now().Before(row.ExpiresAt) {
+		return nil, nil, errors.New(errors.ErrCodeUnauthorized, "refresh token expired")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, row.UserID)
+	if err != nil {
+		if errors.Is(err, errors.ErrCodeNotFound) {
+			return nil, nil, invalid
+		}
+		return nil, nil, err
+	}
+	if user.IsActive != 1 {
+		return nil, nil, errors.New(errors.ErrCodeForbidden, "user account is disabled")
+	}
+
+	if err := s.refreshRepo.Revoke(ctx, row.ID, time.Now()); err != nil {
+		if errors.Is(err, errors.ErrCodeNotFound) {
+			// another request rotated it first
+			s.revokeReusedSession(ctx, row, ip)
+			return nil, nil, invalid
+		}
+		return nil, nil, err
+	}
+
+	pair, err := s.issue(ctx, user, row.SessionID, ip)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pair, user, nil
+}
+
+func (s *TokenService) revokeReusedSession(ctx context.Context, row *model.RefreshToken, ip string) {
+	logger.Warn("Revoked refresh token reused, revoking its session",
+		logger.Int64("user_id", row.UserID),
+		logger.String("ip", ip),
+	)
+	if err := s.revokeSessions(ctx, []string{row.SessionID}); err != nil {
+		logger.Error("Failed to revoke session", logger.Err(err), logger.Int64("user_id", row.UserID))
+	}
+}
+
+// Validate 解析 access token，并拒绝已登出或已撤销会话的 token
+func (s *TokenService) Validate(ctx context.Context, accessToken string) (*utils.Claims, error) {
+	claims, err := utils.ParseToken(accessToken, s.jwtSecret)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrCodeUnauthorized, "invalid authorization token")
+	}
+	// tokens from before refresh tokens existed carry no session and
+	// cannot be revoked, so they are not accepted either
+	if claims.ID == "" || claims.SessionID == "" {
+		return nil, errors.New(errors.ErrCodeUnauthorized, "invalid authorization token")
+	}
+
+	for _, key := range []string{revokedTokenKey(claims.ID), revokedSessionKey(claims.SessionID)} {
+		revoked, err := s.cache.Exists(ctx, key)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrCodeUnauthorized, "failed to check token revocation")
+		}
+		if revoked {
+			return nil, errors.New(errors.ErrCodeUnauthorized, "token has been revoked")
+		}
+	}
+
+	return claims, nil
+}
+
+// Logout 使当前 access token 立即失效并撤销其会话
+func (s *TokenService) Logout(ctx context.Context, claims *utils.Claims) error {
+	if claims.ExpiresAt != nil {
+		if ttl := time.Until(claims.ExpiresAt.Time); ttl > 0 {
+			if err := s.cache.Set(ctx, revokedTokenKey(claims.ID), true, ttl); err != nil {
+				return err
+			}
+		}
+	}
+	return s.revokeSessions(ctx, []string{claims.SessionID})
+}
+
+// RevokeUser 撤销用户的所有会话，用于修改密码、角色或停用账号之后
+func (s *TokenService) RevokeUser(ctx context.Context, userID int64) error {
+	sessionIDs, err := s.refreshRepo.ActiveSessionIDs(ctx, userID, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := s.revokeSessions(ctx, sessionIDs); err != nil {
+		return err
+	}
+	return s.refreshRepo.RevokeByUserID(ctx, userID, time.Now())
+}
+
+// revokeSessions blacklists the access tokens of the sessions, which live
+// at most accessTTL, and revokes their refresh tokens.
+func (s *TokenService) revokeSessions(ctx context.Context, sessionIDs []string) error {
+	for _, sessionID := range sessionIDs {
+		if err := s.cache.Set(ctx, revokedSessionKey(sessionID), true, s.accessTTL); err != nil {
+			return err
+		}
+		if err := s.refreshRepo.RevokeSession(ctx, sessionID, time.Now()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
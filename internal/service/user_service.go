@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/epg-sync/epgsync/internal/model"
 	"github.com/epg-sync/epgsync/internal/repository"
@@ -11,19 +12,32 @@ import (
 )
 
 type UserService struct {
-	userRepo  repository.UserRepository
-	jwtSecret string
+	userRepo repository.UserRepository
+	tokens   *TokenService
+	guard    *LoginGuard
+	audit    *AuditService
 }
 
-func NewUserService(userRepo repository.UserRepository, jwtSecret string) *UserService {
+func NewUserService(userRepo repository.UserRepository, tokens *TokenService, guard *LoginGuard, audit *AuditService) *UserService {
 	return &UserService{
-		userRepo:  userRepo,
-		jwtSecret: jwtSecret,
+		userRepo: userRepo,
+		tokens:   tokens,
+		guard:    guard,
+		audit:    audit,
 	}
 }
 
 // Register 注册新用户
 func (s *UserService) Register(ctx context.Context, username, password, email string) (*model.User, error) {
+	return s.CreateUser(ctx, username, password, email, model.RoleAdmin)
+}
+
+// CreateUser 创建指定角色的用户
+func (s *UserService) CreateUser(ctx context.Context, username, password, email, role string) (*model.User, error) {
+	if !model.IsValidRole(role) {
+		return nil, errors.InvalidParam("role", "must be one of viewer, operator, admin")
+	}
+
 	// 检查用户名是否已存在
 	existing, _ := s.userRepo.GetByUsername(ctx, username)
 	if existing != nil {
@@ -40,39 +54,63 @@ func (s *UserService) Register(ctx context.Context, username, password, email st
 		Username: username,
 		Password: hashedPassword,
 		Email:    email,
-		Role:     "admin",
+		Role:     role,
 		IsActive: 1,
 	}
 
 	if err := s.userRepo.Create(ctx, user); err != nil {
 		return nil, err
 	}
+	s.audit.Record(ctx, model.AuditActionCreate, model.AuditResourceUser, strconv.FormatInt(user.ID, 10), nil, user)
 
 	return user, nil
 }
 
-// Login 用户登录
-func (s *UserService) Login(ctx context.Context, username, password string) (string, *model.User, error) {
+// Login 用户登录，返回新会话的 access token 和 refresh token
+// 连续失败会被 LoginGuard 延迟或锁定，成功和失败都会写入审计日志
+func (s *UserService) Login(ctx context.Context, username, password, ip string) (*TokenPair, *model.User, error) {
+	if err := s.guard.Check(ctx, username, ip); err != nil {
+		return nil, nil, err
+	}
+
 	user, err := s.userRepo.GetByUsername(ctx, username)
 	if err != nil {
-		return "", nil, errors.New(errors.ErrCodeUnauthorized, "invalid username or password")
+		s.loginFailed(ctx, nil, username, ip, "unknown user")
+		return nil, nil, errors.New(errors.ErrCodeUnauthorized, "invalid username or password")
 	}
 
 	if user.IsActive != 1 {
-		return "", nil, errors.New(errors.ErrCodeForbidden, "user account is disabled")
+		s.loginFailed(ctx, user, username, ip, "account disabled")
+		return nil, nil, errors.New(errors.ErrCodeForbidden, "user account is disabled")
 	}
 
 	if !utils.CheckPassword(user.Password, password) {
-		return "", nil, errors.New(errors.ErrCodeUnauthorized, "invalid username or password")
+		s.loginFailed(ctx, user, username, ip, "wrong password")
+		return nil, nil, errors.New(errors.ErrCodeUnauthorized, "invalid username or password")
 	}
 
-	// 生成token (24小时有效)
-	token, err := utils.GenerateToken(user.ID, user.Username, user.Role, s.jwtSecret, 24)
+	tokens, err := s.tokens.Issue(ctx, user, ip)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to generate token: %w", err)
+		return nil, nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
-	return token, user, nil
+	s.guard.Succeed(ctx, username)
+	ctx = WithAuditActor(ctx, AuditActor{UserID: user.ID, Username: user.Username, IP: ip})
+	s.audit.Record(ctx, model.AuditActionLogin, model.AuditResourceUser, strconv.FormatInt(user.ID, 10), nil, nil)
+
+	return tokens, user, nil
+}
+
+func (s *UserService) loginFailed(ctx context.Context, user *model.User, username, ip, reason string) {
+	s.guard.Fail(ctx, username, ip)
+
+	actor := AuditActor{Username: username, IP: ip}
+	resourceID := ""
+	if user != nil {
+		actor.UserID = user.ID
+		resourceID = strconv.FormatInt(user.ID, 10)
+	}
+	s.audit.Record(WithAuditActor(ctx, actor), model.AuditActionLoginFailed, model.AuditResourceUser, resourceID, nil, map[string]string{"reason": reason})
 }
 
 // GetUserByID 根据ID获取用户
@@ -91,27 +129,165 @@ func (s *UserService) UpdateUser(ctx context.Context, user *model.User) error {
 	return s.userRepo.Update(ctx, user)
 }
 
-// DeleteUser 删除用户
-func (s *UserService) DeleteUser(ctx context.Context, id int64) error {
-	return s.userRepo.Delete(ctx, id)
+// UserUpdate holds the fields an admin may change on another user; nil
+// fields are left alone.
+type UserUpdate struct {
+	Email    *string
+	Role     *string
+	IsActive *int
 }
 
-// ChangePassword 修改密码
-func (s *UserService) ChangePassword(ctx context.Context, userID int64, oldPassword, newPassword string) error {
-	user, err := s.userRepo.GetByID(ctx, userID)
+// UpdateUserFields 修改用户邮箱、角色和启用状态。不能修改自己的角色或停用自己，
+// 也不能让系统失去最后一个启用的管理员
+func (s *UserService) UpdateUserFields(ctx context.Context, actorID, id int64, update UserUpdate) (*model.User, error) {
+	if update.Role != nil && !model.IsValidRole(*update.Role) {
+		return nil, errors.InvalidParam("role", "must be one of viewer, operator, admin")
+	}
+	if update.IsActive != nil && *update.IsActive != 0 && *update.IsActive != 1 {
+		return nil, errors.InvalidParam("is_active", "must be 0 or 1")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if actorID == id {
+		if update.Role != nil && *update.Role != user.Role {
+			return nil, errors.New(errors.ErrCodeForbidden, "cannot change your own role")
+		}
+		if update.IsActive != nil && *update.IsActive != user.IsActive {
+			return nil, errors.New(errors.ErrCodeForbidden, "cannot deactivate your own account")
+		}
+	}
+
+	before := *user
+	wasActiveAdmin := isActiveAdmin(user)
+	oldRole, wasActive := user.Role, user.IsActive == 1
+	if update.Email != nil {
+		user.Email = *update.Email
+	}
+	if update.Role != nil {
+		user.Role = *update.Role
+	}
+	if update.IsActive != nil {
+		user.IsActive = *update.IsActive
+	}
+
+	if wasActiveAdmin && !isActiveAdmin(user) {
+		if err := ensureAnotherAdmin(ctx, s.userRepo); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	s.audit.Record(ctx, model.AuditActionUpdate, model.AuditResourceUser, strconv.FormatInt(user.ID, 10), &before, user)
+
+	// tokens carry the role, so sessions must not outlive a role change
+	if user.Role != oldRole || wasActive && user.IsActive != 1 {
+		if err := s.tokens.RevokeUser(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+	return user, nil
+}
+
+// UpdateRole 修改用户角色，不能修改自己的角色
+func (s *UserService) UpdateRole(ctx context.Context, actorID, id int64, role string) (*model.User, error) {
+	return s.UpdateUserFields(ctx, actorID, id, UserUpdate{Role: &role})
+}
+
+// ResetPassword 管理员重置用户密码，不需要旧密码
+func (s *UserService) ResetPassword(ctx context.Context, id int64, newPassword string) error {
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := utils.HashPassword(newPassword)
 	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user.Password = hashedPassword
+	if err := s.userRepo.Update(ctx, user); err != nil {
 		return err
 	}
+	s.audit.Record(ctx, model.AuditActionPasswordReset, model.AuditResourceUser, strconv.FormatInt(id, 10), nil, nil)
+
+	return s.tokens.RevokeUser(ctx, id)
+}
+
+// DeleteUser 删除用户，不能删除自己或最后一个启用的管理员
+func (s *UserService) DeleteUser(ctx context.Context, actorID, id int64) error {
+	if actorID == id {
+		return errors.New(errors.ErrCodeForbidden, "cannot delete your own account")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if isActiveAdmin(user) {
+		if err := ensureAnotherAdmin(ctx, s.userRepo); err != nil {
+			return err
+		}
+	}
+
+	if err := s.tokens.RevokeUser(ctx, id); err != nil {
+		return err
+	}
+	if err := s.userRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.audit.Record(ctx, model.AuditActionDelete, model.AuditResourceUser, strconv.FormatInt(id, 10), user, nil)
+
+	return nil
+}
+
+// ensureAnotherAdmin fails unless more than one active admin exists, so the
+// one about to be removed or demoted is not the last.
+func ensureAnotherAdmin(ctx context.Context, userRepo repository.UserRepository) error {
+	count, err := userRepo.CountActiveByRole(ctx, model.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return errors.New(errors.ErrCodeForbidden, "cannot remove the last active admin")
+	}
+	return nil
+}
+
+func isActiveAdmin(user *model.User) bool {
+	return user.Role == model.RoleAdmin && user.IsActive == 1
+}
+
+// ChangePassword 修改密码并撤销该用户的所有会话，返回给当前客户端的新会话
+func (s *UserService) ChangePassword(ctx context.Context, userID int64, oldPassword, newPassword, ip string) (*TokenPair, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	if !utils.CheckPassword(user.Password, oldPassword) {
-		return errors.New(errors.ErrCodeUnauthorized, "invalid old password")
+		return nil, errors.New(errors.ErrCodeUnauthorized, "invalid old password")
 	}
 
 	hashedPassword, err := utils.HashPassword(newPassword)
 	if err != nil {
-		return fmt.Errorf("failed to hash password: %w", err)
+		return nil, fmt.Errorf("failed to hash password: %w", err)
 	}
 
 	user.Password = hashedPassword
-	return s.userRepo.Update(ctx, user)
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+	s.audit.Record(ctx, model.AuditActionPasswordChange, model.AuditResourceUser, strconv.FormatInt(userID, 10), nil, nil)
+
+	if err := s.tokens.RevokeUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.tokens.Issue(ctx, user, ip)
 }
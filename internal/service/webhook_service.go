@@ -0,0 +1,224 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/epg-sync/epgsync/internal/model"
+	"github.com/epg-sync/epgsync/internal/repository"
+	"github.com/epg-sync/epgsync/pkg/errors"
+	"github.com/epg-sync/epgsync/pkg/logger"
+	"github.com/epg-sync/epgsync/pkg/utils"
+)
+
+const (
+	WebhookHeaderEvent     = "X-EPGSync-Event"
+	WebhookHeaderTimestamp = "X-EPGSync-Timestamp"
+	WebhookHeaderSignature = "X-EPGSync-Signature"
+
+	webhookMaxAttempts = 3
+	webhookTimeout     = 10 * time.Second
+)
+
+type WebhookService struct {
+	webhookRepo repository.WebhookRepository
+	client      *http.Client
+}
+
+func NewWebhookService(webhookRepo repository.WebhookRepository) *WebhookService {
+	return &WebhookService{
+		webhookRepo: webhookRepo,
+		client:      &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+func (s *WebhookService) CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	if webhook.Secret == "" {
+		secret, err := utils.GenerateRandomString(32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+		webhook.Secret = secret
+	}
+
+	if err := s.webhookRepo.Create(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (s *WebhookService) GetWebhook(ctx context.Context, id int64) (*model.Webhook, error) {
+	return s.webhookRepo.GetByID(ctx, id)
+}
+
+func (s *WebhookService) ListWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	return s.webhookRepo.List(ctx)
+}
+
+func (s *WebhookService) UpdateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	return s.webhookRepo.Update(ctx, webhook)
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, id int64) error {
+	return s.webhookRepo.Delete(ctx, id)
+}
+
+// Dispatch delivers the payload to every active webhook whose event and
+// channel filters match at least one change. Delivery happens in the
+// background so a slow receiver never holds up a sync.
+func (s *WebhookService) Dispatch(ctx context.Context, payload *model.WebhookPayload) {
+	webhooks, err := s.webhookRepo.ListActive(ctx)
+	if err != nil {
+		logger.Error("Failed to list webhooks", logger.Err(err))
+		return
+	}
+
+	for _, webhook := range webhooks {
+		changes := filterChangesForWebhook(webhook, payload.Changes)
+		if len(changes) == 0 {
+			continue
+		}
+
+		filtered := *payload
+		filtered.Changes = changes
+
+		go s.deliver(webhook, &filtered)
+	}
+}
+
+// TestWebhook sends a ping event synchronously and returns the receiver's status code.
+func (s *WebhookService) TestWebhook(ctx context.Context, id int64) (int, error) {
+	webhook, err := s.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	payload := &model.WebhookPayload{
+		Event:     "ping",
+		Changes:   []*model.ProgramChange{},
+		Timestamp: time.Now().Unix(),
+	}
+
+	status, err := s.send(ctx, webhook, payload)
+	s.recordDelivery(ctx, webhook.ID, status, err)
+
+	return status, err
+}
+
+func (s *WebhookService) deliver(webhook *model.Webhook, payload *model.WebhookPayload) {
+	ctx := context.Background()
+
+	var status int
+	var err error
+	for attempt := 1; attempt <= webhookMaxAttempts; attempt++ {
+		status, err = s.send(ctx, webhook, payload)
+		if err == nil {
+			break
+		}
+
+		logger.Warn("Webhook delivery failed",
+			logger.Int64("webhook_id", webhook.ID),
+			logger.Int("attempt", attempt),
+			logger.Int("status", status),
+			logger.Err(err),
+		)
+		if attempt < webhookMaxAttempts {
+			time.Sleep(time.Duration(attempt*attempt) * time.Second)
+		}
+	}
+
+	s.recordDelivery(ctx, webhook.ID, status, err)
+}
+
+func (s *WebhookService) send(ctx context.Context, webhook *model.Webhook, payload *model.WebhookPayload) (int, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, errors.HTTPRequestFailed(err, webhook.URL, 0, "failed to create request")
+	}
+
+	timestamp := strconv.FormatInt(payload.Timestamp, 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookHeaderEvent, payload.Event)
+	req.Header.Set(WebhookHeaderTimestamp, timestamp)
+	req.Header.Set(WebhookHeaderSignature, "sha256="+SignWebhookPayload(webhook.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, errors.HTTPRequestFailed(err, webhook.URL, 0, "failed to do request")
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, errors.HTTPRequestFailed(nil, webhook.URL, resp.StatusCode, "unexpected status code")
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (s *WebhookService) recordDelivery(ctx context.Context, id int64, status int, deliveryErr error) {
+	errMsg := ""
+	if deliveryErr != nil {
+		errMsg = deliveryErr.Error()
+	}
+	if err := s.webhookRepo.UpdateDeliveryStatus(ctx, id, status, errMsg, time.Now()); err != nil {
+		logger.Warn("Failed to record webhook delivery", logger.Int64("webhook_id", id), logger.Err(err))
+	}
+}
+
+// SignWebhookPayload computes the hex HMAC-SHA256 of "<timestamp>.<body>".
+// Receivers should recompute it with their secret and reject stale timestamps.
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func filterChangesForWebhook(webhook *model.Webhook, changes []*model.ProgramChange) []*model.ProgramChange {
+	events := splitList(webhook.Events)
+	channels := splitList(webhook.ChannelIDs)
+
+	var result []*model.ProgramChange
+	for _, c := range changes {
+		if len(events) > 0 && !slices.Contains(events, c.ChangeType) {
+			continue
+		}
+		if len(channels) > 0 && !slices.Contains(channels, c.ChannelID) {
+			continue
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+func splitList(value string) []string {
+	var items []string
+	for item := range strings.SplitSeq(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
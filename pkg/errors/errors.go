@@ -7,18 +7,20 @@ import (
 	"runtime"
 	"runtime/debug"
 	"strings"
+	"time"
 )
 
 type ErrorCode string
 
 const (
 	// common errors (1xxx)
-	ErrCodeUnknown       ErrorCode = "1000"
-	ErrCodeInvalidParam  ErrorCode = "1001"
-	ErrCodeNotFound      ErrorCode = "1002"
-	ErrCodeAlreadyExists ErrorCode = "1003"
-	ErrCodeUnauthorized  ErrorCode = "1004"
-	ErrCodeForbidden     ErrorCode = "1005"
+	ErrCodeUnknown         ErrorCode = "1000"
+	ErrCodeInvalidParam    ErrorCode = "1001"
+	ErrCodeNotFound        ErrorCode = "1002"
+	ErrCodeAlreadyExists   ErrorCode = "1003"
+	ErrCodeUnauthorized    ErrorCode = "1004"
+	ErrCodeForbidden       ErrorCode = "1005"
+	ErrCodeTooManyRequests ErrorCode = "1006"
 
 	// provider errors (2xxx)
 	ErrCodeProviderNotFound          ErrorCode = "2001"
@@ -159,6 +161,16 @@ func GetCode(err error) ErrorCode {
 	return ErrCodeUnknown
 }
 
+// GetMessage returns the message of an AppError without its code and
+// details, or err.Error() for any other error.
+func GetMessage(err error) string {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr.Message
+	}
+	return err.Error()
+}
+
 func GetDetails(err error) map[string]any {
 	var appErr *AppError
 	if errors.As(err, &appErr) {
@@ -310,6 +322,12 @@ func AlreadyExists(resource string, id string) *AppError {
 		WithDetail("id", id)
 }
 
+// TooManyRequests rejects a request until retryAfter has passed.
+func TooManyRequests(message string, retryAfter time.Duration) *AppError {
+	return New(ErrCodeTooManyRequests, message).
+		WithDetail("retry_after", int(retryAfter.Round(time.Second).Seconds()))
+}
+
 func HTTPStatus(err error) int {
 	code := GetCode(err)
 
@@ -331,6 +349,9 @@ func HTTPStatus(err error) int {
 	case ErrCodeForbidden:
 		return 403
 
+	case ErrCodeTooManyRequests:
+		return 429
+
 	case ErrCodeProviderTimeout, ErrCodeNetworkTimeout:
 		return 504
 
@@ -69,7 +69,7 @@ type Field = zap.Field
 type zapLogger struct {
 	logger *zap.Logger
 	sugar  *zap.SugaredLogger
-	level  Level
+	level  zap.AtomicLevel
 }
 
 type Config struct {
@@ -186,10 +186,11 @@ func NewLogger(cfg *Config) (Logger, error) {
 			writers = append(writers, zapcore.AddSync(fileWriter))
 		}
 	}
+	level := zap.NewAtomicLevelAt(parseLogLevel(cfg.Level))
 	core := zapcore.NewCore(
 		encoder,
 		zapcore.NewMultiWriteSyncer(writers...),
-		parseLogLevel(cfg.Level),
+		level,
 	)
 
 	opts := []zap.Option{
@@ -209,7 +210,7 @@ func NewLogger(cfg *Config) (Logger, error) {
 	return &zapLogger{
 		logger: zapLog,
 		sugar:  zapLog.Sugar(),
-		level:  parseLogLevel(cfg.Level),
+		level:  level,
 	}, nil
 }
 
@@ -312,6 +313,14 @@ func SetDefault(logger Logger) {
 	globalLogger = logger
 }
 
+// SetLevel changes the level of the default logger while it runs. Loggers
+// derived from it with With follow the change.
+func SetLevel(level string) {
+	if l, ok := Default().(*zapLogger); ok {
+		l.level.SetLevel(parseLogLevel(level))
+	}
+}
+
 func Debug(msg string, fields ...Field) {
 	Default().Debug(msg, fields...)
 }
@@ -386,7 +395,7 @@ func NewNoOpLogger() Logger {
 	return &zapLogger{
 		logger: zap.NewNop(),
 		sugar:  zap.NewNop().Sugar(),
-		level:  DebugLevel,
+		level:  zap.NewAtomicLevelAt(DebugLevel),
 	}
 }
 
@@ -400,10 +409,11 @@ func NewTestLogger(w io.Writer) Logger {
 		EncodeDuration: zapcore.StringDurationEncoder,
 	}
 
+	level := zap.NewAtomicLevelAt(DebugLevel)
 	core := zapcore.NewCore(
 		zapcore.NewConsoleEncoder(encoderConfig),
 		zapcore.AddSync(w),
-		DebugLevel,
+		level,
 	)
 
 	zapLog := zap.New(core)
@@ -411,7 +421,7 @@ func NewTestLogger(w io.Writer) Logger {
 	return &zapLogger{
 		logger: zapLog,
 		sugar:  zapLog.Sugar(),
-		level:  DebugLevel,
+		level:  level,
 	}
 }
 
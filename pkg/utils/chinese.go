@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var traditionalToSimplified = buildCharMap(traditionalChars, simplifiedChars)
+
+// ToHalfWidth converts full-width ASCII variants and the ideographic space to
+// their half-width forms, so "ＣＣＴＶ－１" becomes "CCTV-1".
+func ToHalfWidth(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '　':
+			return ' '
+		case r >= '！' && r <= '～':
+			return r - 0xFEE0
+		}
+		return r
+	}, s)
+}
+
+// ToSimplified converts Traditional Chinese characters to Simplified using a
+// character table that covers the vocabulary common in program titles. It is
+// a one-to-one mapping and does not attempt phrase level conversion.
+func ToSimplified(s string) string {
+	return strings.Map(func(r rune) rune {
+		if !unicode.Is(unicode.Han, r) {
+			return r
+		}
+		if simplified, ok := traditionalToSimplified[r]; ok {
+			return simplified
+		}
+		return r
+	}, s)
+}
+
+var chineseDigits = map[rune]int{
+	'零': 0, '〇': 0, '一': 1, '二': 2, '两': 2, '三': 3, '四': 4,
+	'五': 5, '六': 6, '七': 7, '八': 8, '九': 9,
+}
+
+// ParseChineseNumber parses Arabic digits or a Chinese numeral below one
+// thousand, such as "十五", "二十" or "一百零八".
+func ParseChineseNumber(s string) (int, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, false
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, true
+	}
+
+	total, current := 0, -1
+	for _, r := range s {
+		switch r {
+		case '百', '十':
+			unit := 10
+			if r == '百' {
+				unit = 100
+			}
+			if current < 0 {
+				current = 1
+			}
+			total += current * unit
+			current = -1
+		default:
+			d, ok := chineseDigits[r]
+			if !ok {
+				return 0, false
+			}
+			current = d
+		}
+	}
+	if current > 0 {
+		total += current
+	}
+	return total, true
+}
+
+func buildCharMap(from, to string) map[rune]rune {
+	src, dst := []rune(from), []rune(to)
+	m := make(map[rune]rune, len(src))
+	for i := 0; i < len(src) && i < len(dst); i++ {
+		m[src[i]] = dst[i]
+	}
+	return m
+}
+
+var traditionalChars = "亂亞來侖侶俠倉個們倫偉側偵偽傑傘傭傳傷僅僑價儀儂億優儼兒內兩冊凍凜凱別刪則剛剝創" +
+	"劃劇劉劍劑勁動務勛勝勞勢勳勵勸勻匯區協卻厭厲參吳呂員唄問啞啟喪喬單喲嗆嗎嗚嘆嘍嘔" +
+	"嘗嘩噴噸嚇嚕嚨嚴囂國圍園圓圖團執堅堯報場塊塵塹墊墜墮墳墾壇壓壘壞壟壩壺壽夠夢夾奧" +
+	"奪奮娛婁婦媽嫵嫻嬌嬰嬸孫學孿宮寢實寧審寫寬寵寶將專尋對導屆層屬岡島峽崗崢嵐嶄嶸嶺" +
+	"巒巔帥師帳帶幀幗幟幣幫幾庫廈廚廟廠廢廣廬廳張強彈彌彎彥後徑從徹悅惡惱愛態慘慚慣慫" +
+	"慶憂憊憐憑憫憲憶懇應懲懷懸懺懼懾戀戰戲戶拋掃掄掙掛揀揚換揮損搖搗搶摟摯摳摻撈撓撥" +
+	"撫撲撿擁擄擇擊擋擔據擠擬擰擱擲擴擺擾攏攔攙攜攝攤攪攬敘數斂斃斬斷於時晉晝暈暉暢暫" +
+	"曇曉曠曬書會東條棄棟棧棲楊楓業極構槍樁樂樓標樣樹樺橋機檔檢檯檸櫃欄權欖歲歷歸殘殞" +
+	"殯殺毀氈氣氫決沒況涼淚淨淪減測渾湊湯溝溫滄滲滾滿漁漚漢漣漲潔潤潰澀澆澇澗澤濁濃濕" +
+	"濘濟濤濫濱濺濾瀉瀏瀘瀝瀟瀾灑灕灘灣災為烏無煉煙煩燈燒燙營燭爐爛爭爺爾犧狀狹猶獄獅" +
+	"獎獨獲獵獸獻現瑣瑪環璽瓊產畝畢畫異當瘋療癢癥癱發皚盜盞盡監盤盧眾睜瞞確碼磚礙礦禍" +
+	"禪禮禿種稱積穩窮竊競筆節範築簡簽簾籃籌糧糾紀約紅紋納純紙級紛紡細終組結絕絡給統絲" +
+	"絹經綜綠維綱網緊緒線締緣編練縣縱總績織繩繪繼續纏纖罰罷羅義習聖聞聯聰聲職聽聾肅脅" +
+	"脹腎腦腫腳腸膚膠膽臉臘臟臨臺與興舉舊艙艦艱莊莖華萊萬葉蓋蓮蔣蕭薦薩藍藝藥蘆蘇蘋蘭" +
+	"蘿虛號虧蝕蝦螞蟲蠶蠻衆術衛衝裏補裝裡製複襲見規視親覺覽觀觸訂計訊討訓記訪設許評詞" +
+	"詠試詩話該詳誇認誠誤說誰課調談請論諸謀謎講謝證識譚譜譯議護讀變讓讚豈豐貓貝負財貢" +
+	"貧貨販貪責貴買費賀資賈賊賓賞賠賢賣賤賦質賬賭賴賺購賽贈贊贏贓贖趕趙跡踐蹌蹕蹤躉躍" +
+	"軀車軌軍軒軟軸較載輔輕輛輝輪輯輸輿轄轉轎轟辦辭辯農迴這連週進運過達違遙遜遞遠適遲" +
+	"遷選遺遼邁還邊邏郵鄉鄒鄧鄭鄰醜醞醫醬釀釋針釣鈔鈴鉛鉤銀銅銳銷鋒鋪鋼錄錢錦錫錯錶鍋" +
+	"鍛鍵鍾鎖鎮鏈鏡鏢鏽鐘鐮鐲鐳鐵鑑鑒鑰鑽長門閃閉開閒間閱闆闊闕關陣陰陳陸陽隊階際隨險" +
+	"隱雙雜雞離難雲電霧靈靚靜鞏韋韓韻響頁頂項順須頌預頗領頭頰頸頹頻顆題額顏願顛類顧顫" +
+	"顯風飄飛飯飲餅養餓餘館餵饑饒馬馮駁駐駕駛騎騙騰驅驕驗驚驟驢骯髒體髮鬆鬍鬥鬧鬱魚魯" +
+	"鮮鯉鯨鱷鳥鳩鳳鳴鴨鴻鴿鵝鵬鶴鷗鷹鸚鹹鹽麗麥麼黃點黨黴鼴齊齋齒齡龍龐龔龕龜"
+
+var simplifiedChars = "乱亚来仑侣侠仓个们伦伟侧侦伪杰伞佣传伤仅侨价仪侬亿优俨儿内两册冻凛凯别删则刚剥创" +
+	"划剧刘剑剂劲动务勋胜劳势勋励劝匀汇区协却厌厉参吴吕员呗问哑启丧乔单哟呛吗呜叹喽呕" +
+	"尝哗喷吨吓噜咙严嚣国围园圆图团执坚尧报场块尘堑垫坠堕坟垦坛压垒坏垄坝壶寿够梦夹奥" +
+	"夺奋娱娄妇妈妩娴娇婴婶孙学孪宫寝实宁审写宽宠宝将专寻对导届层属冈岛峡岗峥岚崭嵘岭" +
+	"峦巅帅师帐带帧帼帜币帮几库厦厨庙厂废广庐厅张强弹弥弯彦后径从彻悦恶恼爱态惨惭惯怂" +
+	"庆忧惫怜凭悯宪忆恳应惩怀悬忏惧慑恋战戏户抛扫抡挣挂拣扬换挥损摇捣抢搂挚抠掺捞挠拨" +
+	"抚扑捡拥掳择击挡担据挤拟拧搁掷扩摆扰拢拦搀携摄摊搅揽叙数敛毙斩断于时晋昼晕晖畅暂" +
+	"昙晓旷晒书会东条弃栋栈栖杨枫业极构枪桩乐楼标样树桦桥机档检台柠柜栏权榄岁历归残殒" +
+	"殡杀毁毡气氢决没况凉泪净沦减测浑凑汤沟温沧渗滚满渔沤汉涟涨洁润溃涩浇涝涧泽浊浓湿" +
+	"泞济涛滥滨溅滤泻浏泸沥潇澜洒漓滩湾灾为乌无炼烟烦灯烧烫营烛炉烂争爷尔牺状狭犹狱狮" +
+	"奖独获猎兽献现琐玛环玺琼产亩毕画异当疯疗痒症瘫发皑盗盏尽监盘卢众睁瞒确码砖碍矿祸" +
+	"禅礼秃种称积稳穷窃竞笔节范筑简签帘篮筹粮纠纪约红纹纳纯纸级纷纺细终组结绝络给统丝" +
+	"绢经综绿维纲网紧绪线缔缘编练县纵总绩织绳绘继续缠纤罚罢罗义习圣闻联聪声职听聋肃胁" +
+	"胀肾脑肿脚肠肤胶胆脸腊脏临台与兴举旧舱舰艰庄茎华莱万叶盖莲蒋萧荐萨蓝艺药芦苏苹兰" +
+	"萝虚号亏蚀虾蚂虫蚕蛮众术卫冲里补装里制复袭见规视亲觉览观触订计讯讨训记访设许评词" +
+	"咏试诗话该详夸认诚误说谁课调谈请论诸谋谜讲谢证识谭谱译议护读变让赞岂丰猫贝负财贡" +
+	"贫货贩贪责贵买费贺资贾贼宾赏赔贤卖贱赋质账赌赖赚购赛赠赞赢赃赎赶赵迹践跄跸踪趸跃" +
+	"躯车轨军轩软轴较载辅轻辆辉轮辑输舆辖转轿轰办辞辩农回这连周进运过达违遥逊递远适迟" +
+	"迁选遗辽迈还边逻邮乡邹邓郑邻丑酝医酱酿释针钓钞铃铅钩银铜锐销锋铺钢录钱锦锡错表锅" +
+	"锻键钟锁镇链镜镖锈钟镰镯镭铁鉴鉴钥钻长门闪闭开闲间阅板阔阙关阵阴陈陆阳队阶际随险" +
+	"隐双杂鸡离难云电雾灵靓静巩韦韩韵响页顶项顺须颂预颇领头颊颈颓频颗题额颜愿颠类顾颤" +
+	"显风飘飞饭饮饼养饿余馆喂饥饶马冯驳驻驾驶骑骗腾驱骄验惊骤驴肮脏体发松胡斗闹郁鱼鲁" +
+	"鲜鲤鲸鳄鸟鸠凤鸣鸭鸿鸽鹅鹏鹤鸥鹰鹦咸盐丽麦么黄点党霉鼹齐斋齿龄龙庞龚龛龟"
@@ -40,7 +40,6 @@ export default function ChannelsPage() {
     channel_id: "",
     display_name: "",
     is_active: 1,
-    regexp: "",
     category: "",
     area: "CN",
     logo_url: "",
@@ -138,13 +137,12 @@ export default function ChannelsPage() {
         const [
           channel_id,
           display_name,
-          regexp = "",
           category = "",
           area = "CN",
           logo_url = "",
           timezone = "Asia/Shanghai",
         ] = line.split(",").map((s) => s.trim())
-        return { channel_id, display_name, regexp, category, area, logo_url, timezone }
+        return { channel_id, display_name, category, area, logo_url, timezone }
       }) 
 
       await api.post("/admin/channels/batch", { channels })
@@ -171,7 +169,6 @@ export default function ChannelsPage() {
       is_active: channel.is_active,
       channel_id: channel.channel_id,
       display_name: channel.display_name,
-      regexp: channel.regexp || "",
       category: channel.category || "",
       area: channel.area || "CN",
       logo_url: channel.logo_url || "",
@@ -186,7 +183,6 @@ export default function ChannelsPage() {
       channel_id: "",
       display_name: "",
       category: "",
-      regexp: "",
       area: "CN",
       logo_url: "",
       timezone: "Asia/Shanghai",
@@ -221,7 +217,6 @@ export default function ChannelsPage() {
               <TableRow>
                 <TableHead>频道ID</TableHead>
                 <TableHead>名称</TableHead>
-                <TableHead>匹配正则</TableHead>
                 <TableHead>分类</TableHead>
                 <TableHead>地区</TableHead>
                 <TableHead>时区</TableHead>
@@ -238,9 +233,6 @@ export default function ChannelsPage() {
                   <TableCell className="font-medium">
                     {channel.display_name}
                   </TableCell>
-                  <TableCell className="font-medium">
-                    {channel.regexp || "-"}
-                  </TableCell>
                   <TableCell>{channel.category || "-"}</TableCell>
                   <TableCell>{channel.area || "-"}</TableCell>
                   <TableCell className="text-sm">
@@ -323,17 +315,6 @@ export default function ChannelsPage() {
                 placeholder="例如: CCTV-1综合"
               />
             </div>
-            <div className="space-y-2">
-              <Label htmlFor="regexp">匹配正则</Label>
-              <Input
-                id="regexp"
-                value={formData.regexp}
-                onChange={(e) =>
-                  setFormData({ ...formData, regexp: e.target.value })
-                }
-                placeholder="例如: ^CCTV-?1"
-              />
-            </div>
             <div className="space-y-2">
               <Label htmlFor="is_active">是否启用</Label>
               <Switch
@@ -411,14 +392,14 @@ export default function ChannelsPage() {
           <DialogHeader>
             <DialogTitle>批量导入频道</DialogTitle>
             <DialogDescription>
-              每行一个频道,格式: 频道ID,显示名称,匹配正则,分类,地区,Logo URL,时区
+              每行一个频道,格式: 频道ID,显示名称,分类,地区,Logo URL,时区
             </DialogDescription>
           </DialogHeader>
           <div>
             <Textarea
               value={batchData}
               onChange={(e) => setBatchData(e.target.value)}
-              placeholder="cctv1,CCTV-1综合,^cctv-?1(\s*综合)?,央视,CN,,Asia/Shanghai"
+              placeholder="cctv1,CCTV-1综合,央视,CN,,Asia/Shanghai"
               rows={10}
               className="font-mono text-sm"
             />
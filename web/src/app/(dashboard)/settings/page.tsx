@@ -58,7 +58,10 @@ export default function SettingsPage() {
 
     try {
       setLoading(true)
-      await authApi.changePassword(formData.oldPassword, formData.newPassword)
+      // other sessions are signed out; this one continues with the new tokens
+      const response = await authApi.changePassword(formData.oldPassword, formData.newPassword)
+      const { token, refresh_token } = response.data
+      useAuthStore.getState().setTokens(token, refresh_token)
       toast({
         title: "修改成功",
         description: "密码已成功修改",
@@ -1,6 +1,6 @@
 "use client"
 
-import { useState } from "react"
+import { useEffect, useState } from "react"
 import { useRouter } from "next/navigation"
 import { Button } from "@/components/ui/button"
 import { Input } from "@/components/ui/input"
@@ -14,17 +14,63 @@ import {
 } from "@/components/ui/card"
 import { useToast } from "@/hooks/use-toast"
 import { useAuthStore } from "@/store/authStore"
+import api from "@/lib/api"
 
 export default function LoginPage() {
   const router = useRouter()
   const { toast } = useToast()
   const login = useAuthStore((state) => state.login)
+  const loginWithTicket = useAuthStore((state) => state.loginWithTicket)
   const [loading, setLoading] = useState(false)
+  const [ssoEnabled, setSsoEnabled] = useState(false)
   const [formData, setFormData] = useState({
     username: "",
     password: "",
   })
 
+  useEffect(() => {
+    api
+      .get("/auth/oidc")
+      .then((response) => setSsoEnabled(Boolean(response.data?.enabled)))
+      .catch(() => setSsoEnabled(false))
+
+    // back from the OIDC provider
+    const params = new URLSearchParams(window.location.search)
+    const ticket = params.get("oidc_ticket")
+    const ssoError = params.get("oidc_error")
+    if (!ticket && !ssoError) {
+      return
+    }
+    window.history.replaceState(null, "", window.location.pathname)
+
+    if (ssoError) {
+      toast({
+        variant: "destructive",
+        title: "单点登录失败",
+        description: ssoError,
+      })
+      return
+    }
+
+    setLoading(true)
+    loginWithTicket(ticket!)
+      .then(() => {
+        toast({
+          title: "登录成功",
+          description: "欢迎回来!",
+        })
+        router.push("/channels")
+      })
+      .catch((error: unknown) => {
+        toast({
+          variant: "destructive",
+          title: "单点登录失败",
+          description: error instanceof Error ? error.message : "登录已过期，请重试",
+        })
+      })
+      .finally(() => setLoading(false))
+  }, [loginWithTicket, router, toast])
+
   const handleSubmit = async (e: React.FormEvent) => {
     e.preventDefault()
 
@@ -102,6 +148,18 @@ export default function LoginPage() {
               {loading ? "登录中..." : "登录"}
             </Button>
           </form>
+          {ssoEnabled && (
+            <Button
+              variant="outline"
+              className="w-full mt-4"
+              disabled={loading}
+              onClick={() => {
+                window.location.href = `${api.defaults.baseURL ?? ""}/auth/oidc/login`
+              }}
+            >
+              单点登录 (SSO)
+            </Button>
+          )}
         </CardContent>
       </Card>
     </div>
@@ -21,9 +21,42 @@ api.interceptors.request.use(
   }
 )
 
+// set by the auth store; resolves to a new access token, or null when the
+// session cannot be refreshed
+let sessionRefresher: (() => Promise<string | null>) | null = null
+let pendingRefresh: Promise<string | null> | null = null
+
+export function setSessionRefresher(refresher: () => Promise<string | null>) {
+  sessionRefresher = refresher
+}
+
+// a refresh token is spent on use, so concurrent 401s share one refresh
+function refreshSession() {
+  if (!pendingRefresh && sessionRefresher) {
+    pendingRefresh = sessionRefresher().finally(() => {
+      pendingRefresh = null
+    })
+  }
+  return pendingRefresh
+}
+
 api.interceptors.response.use(
   (response) => response.data,
-  (error) => {
+  async (error) => {
+    const config = error.config
+    if (
+      error.response?.status === 401 &&
+      config &&
+      !config._retried &&
+      !config.url?.startsWith("/auth/")
+    ) {
+      config._retried = true
+      const token = await refreshSession()
+      if (token) {
+        config.headers.Authorization = `Bearer ${token}`
+        return api(config)
+      }
+    }
     if (error.response?.status === 401) {
       localStorage.removeItem("token")
       localStorage.removeItem("user")
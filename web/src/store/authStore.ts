@@ -1,6 +1,6 @@
 import { create } from 'zustand';
 import { persist, createJSONStorage } from 'zustand/middleware';
-import api from '@/lib/api';
+import api, { setSessionRefresher } from '@/lib/api';
 
 interface User {
   id: number;
@@ -12,8 +12,11 @@ interface User {
 interface AuthState {
   user: User | null;
   token: string | null;
+  refreshToken: string | null;
   isHydrated: boolean;
   login: (username: string, password: string) => Promise<void>;
+  loginWithTicket: (ticket: string) => Promise<void>;
+  setTokens: (token: string, refreshToken: string) => void;
   logout: () => void;
   setHydrated: () => void;
 }
@@ -23,6 +26,7 @@ export const useAuthStore = create<AuthState>()(
     (set) => ({
       user: null,
       token: null,
+      refreshToken: null,
       isHydrated: false,
 
       login: async (username: string, password: string) => {
@@ -31,17 +35,35 @@ export const useAuthStore = create<AuthState>()(
           password,
         });
 
-        const { token, user } = response.data;
+        const { token, refresh_token, user } = response.data;
 
         
         api.defaults.headers.common['Authorization'] = `Bearer ${token}`;
 
-        set({ token, user });
+        set({ token, refreshToken: refresh_token, user });
+      },
+
+      // the OIDC callback sends the browser back with a one-time ticket
+      loginWithTicket: async (ticket: string) => {
+        const response = await api.post('/auth/oidc/token', { ticket });
+
+        const { token, refresh_token, user } = response.data;
+
+        api.defaults.headers.common['Authorization'] = `Bearer ${token}`;
+
+        set({ token, refreshToken: refresh_token, user });
+      },
+
+      setTokens: (token: string, refreshToken: string) => {
+        api.defaults.headers.common['Authorization'] = `Bearer ${token}`;
+        set({ token, refreshToken });
       },
 
       logout: () => {
+        // end the session on the server too; the local state goes either way
+        api.post('/auth/logout').catch(() => {});
         delete api.defaults.headers.common['Authorization'];
-        set({ token: null, user: null });
+        set({ token: null, refreshToken: null, user: null });
       },
 
       setHydrated: () => {
@@ -60,3 +82,18 @@ export const useAuthStore = create<AuthState>()(
     }
   )
 );
+
+setSessionRefresher(async () => {
+  const { refreshToken, setTokens } = useAuthStore.getState();
+  if (!refreshToken) {
+    return null;
+  }
+  try {
+    const response = await api.post('/auth/refresh', { refresh_token: refreshToken });
+    const { token, refresh_token } = response.data;
+    setTokens(token, refresh_token);
+    return token;
+  } catch {
+    return null;
+  }
+});
@@ -3,7 +3,6 @@ export interface Channel {
   channel_id: string;
   display_name: string;
   category?: string;
-  regexp?: string;
   area?: string;
   logo_url?: string;
   timezone?: string;
@@ -53,6 +52,9 @@ export interface LoginResponse {
   message: string;
   data: {
     token: string;
+    expires_at: string;
+    refresh_token: string;
+    refresh_expires_at: string;
     user: User;
   };
 }